@@ -4,7 +4,12 @@
 package main
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -59,6 +64,24 @@ func TestGetEndpointPath(t *testing.T) {
 	assert.Equal(t, "/api/v1/terraform-mcp", path, "Endpoint path should be the custom value set in MCP_ENDPOINT")
 }
 
+func TestGetHealthPath(t *testing.T) {
+	// Save original env var to restore later
+	origPath := os.Getenv("MCP_HEALTH_ENDPOINT")
+	defer func() {
+		os.Setenv("MCP_HEALTH_ENDPOINT", origPath)
+	}()
+
+	// Test case: When MCP_HEALTH_ENDPOINT is not set, default value should be used
+	os.Unsetenv("MCP_HEALTH_ENDPOINT")
+	path := getHealthPath(nil)
+	assert.Equal(t, "/health", path, "Default health path should be /health when MCP_HEALTH_ENDPOINT is not set")
+
+	// Test case: When MCP_HEALTH_ENDPOINT is set, its value should be used
+	os.Setenv("MCP_HEALTH_ENDPOINT", "/terraform/healthz")
+	path = getHealthPath(nil)
+	assert.Equal(t, "/terraform/healthz", path, "Health path should be the value of MCP_HEALTH_ENDPOINT when it is set")
+}
+
 func TestGetHTTPPort(t *testing.T) {
 	// Save original env var to restore later
 	origPort := os.Getenv("TRANSPORT_PORT")
@@ -122,6 +145,27 @@ func TestShouldUseStreamableHTTPMode(t *testing.T) {
 	assert.True(t, shouldUseStreamableHTTPMode(), "HTTP mode should be used when MCP_ENDPOINT is set")
 }
 
+func TestShouldUseSSEMode(t *testing.T) {
+	// Save original env var to restore later
+	origMode := os.Getenv("TRANSPORT_MODE")
+	defer func() {
+		os.Setenv("TRANSPORT_MODE", origMode)
+	}()
+
+	// Test case: When TRANSPORT_MODE is not set, SSE mode should not be used
+	os.Unsetenv("TRANSPORT_MODE")
+	assert.False(t, shouldUseSSEMode(), "SSE mode should not be used when TRANSPORT_MODE is not set")
+
+	// Test case: When TRANSPORT_MODE is set to "streamable-http", SSE mode should not be used
+	os.Setenv("TRANSPORT_MODE", "streamable-http")
+	assert.False(t, shouldUseSSEMode(), "SSE mode should not be used when TRANSPORT_MODE is set to 'streamable-http'")
+
+	// Test case: When TRANSPORT_MODE is set to "sse", SSE mode should be used
+	os.Setenv("TRANSPORT_MODE", "sse")
+	assert.True(t, shouldUseSSEMode(), "SSE mode should be used when TRANSPORT_MODE is set to 'sse'")
+	assert.False(t, shouldUseStreamableHTTPMode(), "Streamable HTTP mode should not be selected by TRANSPORT_MODE 'sse'")
+}
+
 func TestShouldUseStatelessMode(t *testing.T) {
 	// Save original env var to restore later
 	origMode := os.Getenv("MCP_SESSION_MODE")
@@ -182,6 +226,81 @@ func TestGetHeartbeatInterval(t *testing.T) {
 	assert.Equal(t, time.Duration(0), heartbeat, "Heartbeat interval should be 0 when MCP_HEARTBEAT_INTERVAL is set to an invalid value")
 }
 
+func TestGetShutdownGracePeriod(t *testing.T) {
+	logger := log.New()
+	t.Setenv("MCP_SHUTDOWN_GRACE_PERIOD", "")
+	assert.Equal(t, defaultShutdownGracePeriod, getShutdownGracePeriod(logger), "Default grace period should be used when MCP_SHUTDOWN_GRACE_PERIOD is not set")
+
+	t.Setenv("MCP_SHUTDOWN_GRACE_PERIOD", "25s")
+	assert.Equal(t, 25*time.Second, getShutdownGracePeriod(logger))
+
+	t.Setenv("MCP_SHUTDOWN_GRACE_PERIOD", "invalid")
+	assert.Equal(t, defaultShutdownGracePeriod, getShutdownGracePeriod(logger), "Invalid values should fall back to the default")
+
+	t.Setenv("MCP_SHUTDOWN_GRACE_PERIOD", "-1s")
+	assert.Equal(t, defaultShutdownGracePeriod, getShutdownGracePeriod(logger), "Non-positive values should fall back to the default")
+}
+
+func TestGetStdioHeartbeatInterval(t *testing.T) {
+	logger := log.New()
+	t.Setenv("MCP_STDIO_HEARTBEAT_INTERVAL", "")
+	assert.Equal(t, time.Duration(0), getStdioHeartbeatInterval(logger), "The stdio heartbeat should be disabled by default")
+
+	t.Setenv("MCP_STDIO_HEARTBEAT_INTERVAL", "45s")
+	assert.Equal(t, 45*time.Second, getStdioHeartbeatInterval(logger))
+
+	t.Setenv("MCP_STDIO_HEARTBEAT_INTERVAL", "invalid")
+	assert.Equal(t, time.Duration(0), getStdioHeartbeatInterval(logger), "Invalid values should disable the stdio heartbeat")
+
+	t.Setenv("MCP_STDIO_HEARTBEAT_INTERVAL", "-1s")
+	assert.Equal(t, time.Duration(0), getStdioHeartbeatInterval(logger), "Negative values should disable the stdio heartbeat")
+}
+
+func TestSendStdioHeartbeatsSendsPingRequests(t *testing.T) {
+	reader, writer := io.Pipe()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go sendStdioHeartbeats(ctx, writer, 10*time.Millisecond)
+
+	line, err := bufio.NewReader(reader).ReadBytes('\n')
+	assert.NoError(t, err)
+	var ping struct {
+		JSONRPC string `json:"jsonrpc"`
+		ID      string `json:"id"`
+		Method  string `json:"method"`
+	}
+	assert.NoError(t, json.Unmarshal(line, &ping))
+	assert.Equal(t, "2.0", ping.JSONRPC)
+	assert.Equal(t, "heartbeat-1", ping.ID, "A heartbeat should be a request the client answers")
+	assert.Equal(t, "ping", ping.Method)
+	cancel()
+	_ = reader.Close()
+}
+
+func TestStdioWriterReportsBrokenPipe(t *testing.T) {
+	reader, writer, err := os.Pipe()
+	assert.NoError(t, err)
+	out := newStdioWriter(writer)
+
+	_, err = out.Write([]byte("{}\n"))
+	assert.NoError(t, err)
+	assert.Empty(t, out.broken, "A successful write should not be reported")
+
+	assert.NoError(t, reader.Close())
+	assert.NoError(t, writer.Close())
+	_, err = out.Write([]byte("{}\n"))
+	assert.Error(t, err)
+	_, _ = out.Write([]byte("{}\n"))
+
+	select {
+	case reported := <-out.broken:
+		assert.Equal(t, err, reported, "The first failed write should be reported")
+	default:
+		t.Fatal("A failed write should be reported")
+	}
+	assert.Empty(t, out.broken, "Only the first failed write should be reported")
+}
+
 func TestGetLogLevel(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -319,6 +438,35 @@ func TestInitLoggerWithLevel(t *testing.T) {
 	}
 }
 
+func TestInitLoggerConfiguresStandardLogger(t *testing.T) {
+	originalLevel, originalFormatter, originalOut := log.GetLevel(), log.StandardLogger().Formatter, log.StandardLogger().Out
+	defer func() {
+		log.SetLevel(originalLevel)
+		log.SetFormatter(originalFormatter)
+		log.SetOutput(originalOut)
+	}()
+
+	logFile := filepath.Join(t.TempDir(), "server.log")
+	logger, err := initLogger(logFile, log.TraceLevel, "json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, log.TraceLevel, log.GetLevel(), "the standard logger should use the configured level")
+	assert.Equal(t, logger.Formatter, log.StandardLogger().Formatter, "the standard logger should use the configured format")
+
+	log.Tracef("trace from the standard logger")
+	content, err := os.ReadFile(logFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Contains(t, string(content), "trace from the standard logger")
+}
+
+func TestGetLogLevelTrimsEnvValue(t *testing.T) {
+	t.Setenv("LOG_LEVEL", " WARN ")
+	assert.Equal(t, log.WarnLevel, getLogLevel(nil))
+}
+
 func TestInitLoggerWithFormat(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -525,3 +673,27 @@ func TestGetLogFormatWithNilCommand(t *testing.T) {
 		t.Errorf("expected default text format with invalid env var and nil command, got %q", format)
 	}
 }
+
+func TestCheckReservedPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		health   string
+		reserved string
+		conflict bool
+	}{
+		{name: "defaults", endpoint: "/mcp", health: "/health", reserved: "/metrics"},
+		{name: "endpoint on the reserved path", endpoint: "/metrics", health: "/health", reserved: "/metrics", conflict: true},
+		{name: "health on the reserved path", endpoint: "/mcp", health: "/readyz", reserved: "/readyz", conflict: true},
+		{name: "reserved path below the endpoint", endpoint: "/api", health: "/health", reserved: "/api/metrics", conflict: true},
+		{name: "endpoint on a reserved prefix", endpoint: "/downloads", health: "/health", reserved: "/downloads/", conflict: true},
+		{name: "health below a reserved prefix", endpoint: "/mcp", health: "/downloads/health", reserved: "/downloads/", conflict: true},
+		{name: "similar name", endpoint: "/mcp", health: "/downloadsz", reserved: "/downloads/"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkReservedPath(tt.endpoint, tt.health, tt.reserved, "testing")
+			assert.Equal(t, tt.conflict, err != nil, "unexpected result %v", err)
+		})
+	}
+}
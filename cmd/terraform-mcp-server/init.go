@@ -5,13 +5,18 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"io"
 	stdlog "log"
 	"net/http"
 	"os"
+	"os/signal"
 	"path"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
@@ -19,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-mcp-server/pkg/tools"
 	"github.com/hashicorp/terraform-mcp-server/pkg/toolsets"
 	"github.com/hashicorp/terraform-mcp-server/version"
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -64,44 +70,16 @@ var (
 		Short: "Start StreamableHTTP server",
 		Long:  `Start a server that communicates via StreamableHTTP transport on port 8080 at /mcp endpoint.`,
 		Run: func(cmd *cobra.Command, _ []string) {
-			logFile, err := rootCmd.PersistentFlags().GetString("log-file")
-			if err != nil {
-				stdlog.Fatal("Failed to get log file:", err)
-			}
-			logLevel := getLogLevel(cmd.Root())
-			logFormat := getLogFormat(cmd)
-			logger, err := initLogger(logFile, logLevel, logFormat)
-			if err != nil {
-				stdlog.Fatal("Failed to initialize logger:", err)
-			}
-
-			port, err := cmd.Flags().GetString("transport-port")
-			if err != nil {
-				stdlog.Fatal("Failed to get streamableHTTP port:", err)
-			}
-			host, err := cmd.Flags().GetString("transport-host")
-			if err != nil {
-				stdlog.Fatal("Failed to get streamableHTTP host:", err)
-			}
-
-			endpointPath, err := cmd.Flags().GetString("mcp-endpoint")
-			if err != nil {
-				stdlog.Fatal("Failed to get endpoint path:", err)
-			}
-
-			heartbeatInterval, err := cmd.Flags().GetDuration("heartbeat-interval")
-			if err != nil {
-				stdlog.Fatal("Failed to get heartbeat-interval:", err)
-			}
-
-			enabledToolsets := getToolsetsFromCmd(cmd.Root(), logger)
-			stdlog.Printf("Starting StreamableHTTP server with host: %s, port: %s, endpoint: %s, heartbeatInterval: %v, enabledToolsets: %v", host, port, endpointPath, heartbeatInterval, enabledToolsets)
-			metricsConfig, shutdownMetrics := setupMetrics(logger)
-			defer shutdownMetrics()
+			runHTTPCommand(cmd, transportStreamableHTTP)
+		},
+	}
 
-			if err := runHTTPServer(logger, host, port, endpointPath, heartbeatInterval, enabledToolsets, metricsConfig); err != nil {
-				stdlog.Fatal("failed to run streamableHTTP server:", err)
-			}
+	sseCmd = &cobra.Command{
+		Use:   "sse",
+		Short: "Start SSE server",
+		Long:  `Start a server that communicates via the legacy Server-Sent Events transport on port 8080, with the event stream at /mcp/sse and messages posted to /mcp/message.`,
+		Run: func(cmd *cobra.Command, _ []string) {
+			runHTTPCommand(cmd, transportSSE)
 		},
 	}
 
@@ -132,18 +110,68 @@ func init() {
 	streamableHTTPCmd.Flags().StringP("transport-port", "p", "8080", "Port to listen on")
 	streamableHTTPCmd.Flags().Duration("heartbeat-interval", 0, "Heartbeat interval for HTTP connections (e.g., 30s). 0 to disable")
 	streamableHTTPCmd.Flags().String("mcp-endpoint", "/mcp", "Path for streamable HTTP endpoint")
+	streamableHTTPCmd.Flags().String("health-endpoint", "/health", "Path for the health check endpoint")
+
+	// Add SSE command flags
+	sseCmd.Flags().String("transport-host", "127.0.0.1", "Host to bind to")
+	sseCmd.Flags().StringP("transport-port", "p", "8080", "Port to listen on")
+	sseCmd.Flags().Duration("heartbeat-interval", 0, "Keep-alive interval for SSE streams (e.g., 30s). 0 to disable")
+	sseCmd.Flags().String("mcp-endpoint", "/mcp", "Base path for the SSE endpoints (<path>/sse and <path>/message)")
+	sseCmd.Flags().String("health-endpoint", "/health", "Path for the health check endpoint")
 
 	// Add the same flags to the alias command for backward compatibility
 	httpCmdAlias.Flags().String("transport-host", "127.0.0.1", "Host to bind to")
 	httpCmdAlias.Flags().StringP("transport-port", "p", "8080", "Port to listen on")
 	httpCmdAlias.Flags().String("mcp-endpoint", "/mcp", "Path for streamable HTTP endpoint")
+	httpCmdAlias.Flags().String("health-endpoint", "/health", "Path for the health check endpoint")
 	httpCmdAlias.Flags().Duration("heartbeat-interval", 0, "Heartbeat interval for HTTP connections (e.g., 30s). 0 to disable")
 
 	rootCmd.AddCommand(stdioCmd)
 	rootCmd.AddCommand(streamableHTTPCmd)
+	rootCmd.AddCommand(sseCmd)
 	rootCmd.AddCommand(httpCmdAlias) // Add the alias for backward compatibility
 }
 
+// runHTTPCommand starts the server over one of the HTTP based transports using the command's flags
+func runHTTPCommand(cmd *cobra.Command, transport string) {
+	logFile, err := rootCmd.PersistentFlags().GetString("log-file")
+	if err != nil {
+		stdlog.Fatal("Failed to get log file:", err)
+	}
+	logLevel := getLogLevel(cmd.Root())
+	logFormat := getLogFormat(cmd)
+	logger, err := initLogger(logFile, logLevel, logFormat)
+	if err != nil {
+		stdlog.Fatal("Failed to initialize logger:", err)
+	}
+
+	port, err := cmd.Flags().GetString("transport-port")
+	if err != nil {
+		stdlog.Fatalf("Failed to get %s port: %v", transport, err)
+	}
+	host, err := cmd.Flags().GetString("transport-host")
+	if err != nil {
+		stdlog.Fatalf("Failed to get %s host: %v", transport, err)
+	}
+
+	endpointPath := getEndpointPath(cmd)
+	healthPath := getHealthPath(cmd)
+
+	heartbeatInterval, err := cmd.Flags().GetDuration("heartbeat-interval")
+	if err != nil {
+		stdlog.Fatal("Failed to get heartbeat-interval:", err)
+	}
+
+	enabledToolsets := getToolsetsFromCmd(cmd.Root(), logger)
+	stdlog.Printf("Starting %s server with host: %s, port: %s, endpoint: %s, heartbeatInterval: %v, enabledToolsets: %v", transportDisplayName(transport), host, port, endpointPath, heartbeatInterval, enabledToolsets)
+	metricsConfig, shutdownMetrics := setupMetrics(logger)
+	defer shutdownMetrics()
+
+	if err := runHTTPServer(logger, transport, host, port, endpointPath, healthPath, heartbeatInterval, enabledToolsets, metricsConfig); err != nil {
+		stdlog.Fatalf("failed to run %s server: %v", transportDisplayName(transport), err)
+	}
+}
+
 func initConfig() {
 	viper.AutomaticEnv()
 }
@@ -151,7 +179,7 @@ func initConfig() {
 // getLogLevel determines the log level from environment variable or CLI flag
 func getLogLevel(cmd *cobra.Command) log.Level {
 	// Check environment variable first
-	if envLevel := os.Getenv("LOG_LEVEL"); envLevel != "" {
+	if envLevel := strings.TrimSpace(os.Getenv("LOG_LEVEL")); envLevel != "" {
 		level, err := log.ParseLevel(envLevel)
 		if err != nil {
 			stdlog.Printf("Warning: %v, using default 'info' level\n", err)
@@ -216,16 +244,18 @@ func initLogger(outPath string, level log.Level, format string) (*log.Logger, er
 		})
 	}
 
-	if outPath == "" {
-		return logger, nil
-	}
-
-	file, err := os.OpenFile(outPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o666)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open log file: %w", err)
+	if outPath != "" {
+		file, err := os.OpenFile(outPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o666)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open log file: %w", err)
+		}
+		logger.SetOutput(file)
 	}
 
-	logger.SetOutput(file)
+	// Some packages log through the standard logrus logger, keep it in line with the configured one
+	log.SetLevel(logger.GetLevel())
+	log.SetFormatter(logger.Formatter)
+	log.SetOutput(logger.Out)
 
 	return logger, nil
 }
@@ -237,24 +267,62 @@ func registerToolsAndResources(hcServer *server.MCPServer, logger *log.Logger, e
 	resources.RegisterResourceTemplates(hcServer, logger)
 }
 
-func serverInit(ctx context.Context, hcServer *server.MCPServer, logger *log.Logger) error {
+// stdioHeartbeatIDPrefix prefixes the IDs of the ping requests sent to keep an idle stdio pipe active, keeping them
+// apart from the IDs of the other requests the server sends
+const stdioHeartbeatIDPrefix = "heartbeat-"
+
+// stdioWriter reports the first failed write to the client, the pipe is then broken and the server cannot answer.
+// Writes are serialized so heartbeats are not interleaved with the messages of the stdio server.
+type stdioWriter struct {
+	mu     sync.Mutex
+	out    io.Writer
+	once   sync.Once
+	broken chan error
+}
+
+func newStdioWriter(out io.Writer) *stdioWriter {
+	return &stdioWriter{out: out, broken: make(chan error, 1)}
+}
+
+func (w *stdioWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	n, err := w.out.Write(p)
+	if err != nil {
+		w.once.Do(func() { w.broken <- err })
+	}
+	return n, err
+}
+
+func serverInit(ctx context.Context, hcServer *server.MCPServer, logger *log.Logger, heartbeatInterval time.Duration) error {
 	stdioServer := server.NewStdioServer(hcServer)
 	stdLogger := stdlog.New(logger.Writer(), "stdioserver", 0)
 	stdioServer.SetErrorLogger(stdLogger)
 
+	// Without a SIGPIPE handler, a write to a closed stdout kills the process silently, with one the write fails
+	// with EPIPE and is reported by the writer
+	signal.Notify(make(chan os.Signal, 1), syscall.SIGPIPE)
+	out := newStdioWriter(os.Stdout)
+
 	// Start listening for messages
 	errC := make(chan error, 1)
 	go func() {
-		in, out := io.Reader(os.Stdin), io.Writer(os.Stdout)
-		errC <- stdioServer.Listen(ctx, in, out)
+		errC <- stdioServer.Listen(ctx, io.Reader(os.Stdin), out)
 	}()
 
+	if heartbeatInterval > 0 {
+		go sendStdioHeartbeats(ctx, out, heartbeatInterval)
+		logger.Infof("stdio heartbeat enabled with interval: %v", heartbeatInterval)
+	}
+
 	_, _ = fmt.Fprintf(os.Stderr, "Terraform MCP Server running on stdio\n")
 
 	// Wait for shutdown signal
 	select {
 	case <-ctx.Done():
 		logger.Infof("shutting down server...")
+	case err := <-out.broken:
+		logger.Warnf("stdout is no longer writable, the client is gone, shutting down: %v", err)
 	case err := <-errC:
 		if err != nil {
 			return fmt.Errorf("error running server: %w", err)
@@ -264,41 +332,122 @@ func serverInit(ctx context.Context, hcServer *server.MCPServer, logger *log.Log
 	return nil
 }
 
-func streamableHTTPServerInit(ctx context.Context, hcServer *server.MCPServer, logger *log.Logger, host string, port string, endpointPath string, heartbeatInterval time.Duration) error {
-	// Ensure endpoint path starts with /
+// sendStdioHeartbeats sends an MCP ping request to the client at each interval until ctx is done or the pipe is
+// broken. Clients must answer pings at any time, the server ignores their responses.
+func sendStdioHeartbeats(ctx context.Context, out io.Writer, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for id := 1; ; id++ {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			message, err := json.Marshal(mcp.JSONRPCRequest{
+				JSONRPC: mcp.JSONRPC_VERSION,
+				ID:      mcp.NewRequestId(fmt.Sprintf("%s%d", stdioHeartbeatIDPrefix, id)),
+				Request: mcp.Request{Method: string(mcp.MethodPing)},
+			})
+			if err != nil {
+				return
+			}
+			// A failed write is reported by the stdioWriter, which shuts the server down
+			if _, err := fmt.Fprintf(out, "%s\n", message); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// checkReservedPath fails when the MCP endpoint or the health check is served on a path the server reserves, or
+// when the MCP endpoint covers it. A reserved path ending with a slash reserves everything below it.
+func checkReservedPath(endpointPath string, healthPath string, reserved string, purpose string) error {
+	reservedPath := strings.TrimSuffix(reserved, "/")
+	for _, configured := range []string{endpointPath, healthPath} {
+		if configured == reservedPath || (strings.HasSuffix(reserved, "/") && strings.HasPrefix(configured, reserved)) {
+			return fmt.Errorf("endpoint %s is reserved for %s", reserved, purpose)
+		}
+	}
+	if strings.HasPrefix(reservedPath, endpointPath+"/") {
+		return fmt.Errorf("endpoint %s is reserved for %s", reserved, purpose)
+	}
+	return nil
+}
+
+// toolCallWriteMargin is the time a tool call response may take to be written after the tool call timeout passed
+const toolCallWriteMargin = 5 * time.Second
+
+func streamableHTTPServerInit(ctx context.Context, hcServer *server.MCPServer, logger *log.Logger, transport string, host string, port string, endpointPath string, healthPath string, heartbeatInterval time.Duration, resultDownloads *client.ResultDownloadStore, toolCallLimiter *client.ToolCallLimiter, toolCallTimeout client.ToolCallTimeoutConfig) error {
+	// Ensure endpoint paths start with /
 	endpointPath = path.Join("/", endpointPath)
-	var handler http.Handler
-	// Create StreamableHTTP server which implements the new streamable-http transport
-	// This is the modern MCP transport that supports both direct HTTP responses and SSE streams
-	opts := []server.StreamableHTTPOption{
-		server.WithEndpointPath(endpointPath), // Default MCP endpoint path
-		server.WithLogger(logger),
+	healthPath = path.Join("/", healthPath)
+	if healthPath == endpointPath || strings.HasPrefix(healthPath, endpointPath+"/") {
+		return fmt.Errorf("health endpoint %s conflicts with the MCP endpoint %s", healthPath, endpointPath)
 	}
+	if err := checkReservedPath(endpointPath, healthPath, client.ReadinessPath, "the readiness check"); err != nil {
+		return err
+	}
+	if resultDownloads != nil {
+		if err := checkReservedPath(endpointPath, healthPath, client.ResultDownloadPath, "result downloads"); err != nil {
+			return err
+		}
+	}
+	if client.IsPrometheusMetricsEnabled() {
+		if err := checkReservedPath(endpointPath, healthPath, client.PrometheusMetricsPath, "Prometheus metrics"); err != nil {
+			return err
+		}
+	}
+	var handler http.Handler
 
 	// Load TLS configuration
 	tlsConfig, err := client.GetTLSConfigFromEnv()
 	if err != nil {
 		return fmt.Errorf("TLS configuration error: %w", err)
 	}
-	if tlsConfig != nil {
-		opts = append(opts, server.WithTLSCert(tlsConfig.CertFile, tlsConfig.KeyFile))
-	}
 
 	// Log the endpoint path being used
 	logger.Infof("Using endpoint path: %s", endpointPath)
 
-	// Check if stateless mode is enabled
-	isStateless := shouldUseStatelessMode()
-	opts = append(opts, server.WithStateLess(isStateless))
-	logger.Infof("Running with stateless mode: %v", isStateless)
+	var baseMCPServer http.Handler
+	var sseServer *server.SSEServer
+	var baseStreamableServer *server.StreamableHTTPServer
+	if transport == transportSSE {
+		// Create SSE server which implements the legacy HTTP+SSE transport. Clients open the
+		// event stream at <endpoint>/sse and post messages to <endpoint>/message.
+		sseOpts := []server.SSEOption{
+			server.WithStaticBasePath(endpointPath),
+		}
+		if heartbeatInterval > 0 {
+			sseOpts = append(sseOpts, server.WithKeepAliveInterval(heartbeatInterval))
+			logger.Infof("SSE keep-alive enabled with interval: %v", heartbeatInterval)
+		}
+		sseServer = server.NewSSEServer(hcServer, sseOpts...)
+		logger.Infof("SSE stream path: %s, message path: %s", sseServer.CompleteSsePath(), sseServer.CompleteMessagePath())
+		baseMCPServer = sseServer
+	} else {
+		// Create StreamableHTTP server which implements the new streamable-http transport
+		// This is the modern MCP transport that supports both direct HTTP responses and SSE streams
+		opts := []server.StreamableHTTPOption{
+			server.WithEndpointPath(endpointPath), // Default MCP endpoint path
+			server.WithLogger(logger),
+		}
+		if tlsConfig != nil {
+			opts = append(opts, server.WithTLSCert(tlsConfig.CertFile, tlsConfig.KeyFile))
+		}
 
-	// Configure heartbeat interval if enabled
-	if heartbeatInterval > 0 {
-		opts = append(opts, server.WithHeartbeatInterval(heartbeatInterval))
-		logger.Infof("HTTP heartbeat enabled with interval: %v", heartbeatInterval)
-	}
+		// Check if stateless mode is enabled
+		isStateless := shouldUseStatelessMode()
+		opts = append(opts, server.WithStateLess(isStateless))
+		logger.Infof("Running with stateless mode: %v", isStateless)
+
+		// Configure heartbeat interval if enabled
+		if heartbeatInterval > 0 {
+			opts = append(opts, server.WithHeartbeatInterval(heartbeatInterval))
+			logger.Infof("HTTP heartbeat enabled with interval: %v", heartbeatInterval)
+		}
 
-	baseStreamableServer := server.NewStreamableHTTPServer(hcServer, opts...)
+		baseStreamableServer = server.NewStreamableHTTPServer(hcServer, opts...)
+		baseMCPServer = baseStreamableServer
+	}
 
 	// Load CORS configuration
 	corsConfig := client.LoadCORSConfigFromEnv()
@@ -314,27 +463,60 @@ func streamableHTTPServerInit(ctx context.Context, hcServer *server.MCPServer, l
 	} else if corsConfig.Mode == "disabled" {
 		logger.Warnf("CORS validation is disabled. This is not recommended for production.")
 	}
+	logger.Debugf("CORS allowed methods: %s", strings.Join(corsConfig.AllowedMethods, ", "))
+	logger.Debugf("CORS allowed headers: %s", strings.Join(corsConfig.AllowedHeaders, ", "))
 
-	// Create a security wrapper around the streamable server
-	streamableServer := client.NewSecurityHandler(baseStreamableServer, corsConfig.AllowedOrigins, corsConfig.Mode, logger)
+	// Create a security wrapper around the MCP transport server
+	streamableServer := client.NewSecurityHandlerWithConfig(baseMCPServer, corsConfig, logger)
 
 	mux := http.NewServeMux()
 
 	// Apply middleware
 	streamableServer = client.TerraformContextMiddleware(logger)(streamableServer)
+	if toolCallLimiter != nil {
+		// Inside the body size limit, the limiter reads the body to find tool calls
+		streamableServer = toolCallLimiter.HTTPMiddleware()(streamableServer)
+	}
+	if cacheControl := client.LoadCacheControlFromEnv(logger); cacheControl != "" {
+		logger.Infof("Results of cacheable tools will be sent with Cache-Control %q", cacheControl)
+		streamableServer = client.CacheControlMiddleware(cacheControl, tools.IsCacheableTool, logger)(streamableServer)
+	}
+	maxRequestBodyBytes := client.LoadMaxRequestBodyBytesFromEnv(logger)
+	if maxRequestBodyBytes > 0 {
+		logger.Infof("MCP request bodies limited to %d bytes", maxRequestBodyBytes)
+	}
+	streamableServer = client.RequestBodyLimitMiddleware(maxRequestBodyBytes, logger)(streamableServer)
+	streamableServer = client.RequestIDMiddleware(logger)(streamableServer)
+	if client.IsTracingEnabled() {
+		streamableServer = client.TraceContextMiddleware()(streamableServer)
+	}
 
-	// Handle the /mcp endpoint with the streamable server (with security wrapper)
+	// Handle the /mcp endpoint with the transport server (with security wrapper)
 	mux.Handle(endpointPath, streamableServer)
 	mux.Handle(endpointPath+"/", streamableServer)
 
 	// Add health check endpoint
-	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+	logger.Infof("Using health check path: %s", healthPath)
+	mux.HandleFunc(healthPath, func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
-		response := fmt.Sprintf(`{"status":"ok","service":"terraform-mcp-server","transport":"streamable-http","endpoint":"%s"}`, endpointPath)
+		response := fmt.Sprintf(`{"status":"ok","service":"terraform-mcp-server","transport":"%s","endpoint":"%s"}`, transport, endpointPath)
 		w.Write([]byte(response))
 	})
 
+	// Add readiness endpoint, unlike the health check it fails while the registry cannot be reached
+	mux.Handle(client.ReadinessPath, client.NewRegistryReadinessHandler(logger))
+
+	// Serve offloaded tool results, the unguessable token in the path authorizes the download
+	if resultDownloads != nil {
+		mux.Handle(client.ResultDownloadPath, resultDownloads)
+	}
+
+	// Expose Prometheus metrics for monitoring
+	if client.IsPrometheusMetricsEnabled() {
+		mux.Handle(client.PrometheusMetricsPath, client.PrometheusMetricsHandler())
+	}
+
 	addr := fmt.Sprintf("%s:%s", host, port)
 	if enableOtelMetrics := os.Getenv("OTEL_METRICS_ENABLED"); enableOtelMetrics == "true" {
 		// Add http server instrumentation for standard server metrics
@@ -351,34 +533,71 @@ func streamableHTTPServerInit(ctx context.Context, hcServer *server.MCPServer, l
 		WriteTimeout:      30 * time.Second,
 		IdleTimeout:       60 * time.Second,
 	}
+	if sseServer != nil {
+		// The SSE event stream is a single long-lived response
+		httpServer.WriteTimeout = 0
+	} else if longest, bounded := toolCallTimeout.Longest(); !bounded {
+		// Tool calls without a timeout answer whenever they finish
+		httpServer.WriteTimeout = 0
+	} else if longest+toolCallWriteMargin > httpServer.WriteTimeout {
+		// Leave tool calls their full timeout to answer, including the TIMEOUT error sent when it passes
+		httpServer.WriteTimeout = longest + toolCallWriteMargin
+	}
 
 	if tlsConfig != nil {
 		httpServer.TLSConfig = tlsConfig.Config
-		logger.Infof("TLS enabled with certificate: %s", tlsConfig.CertFile)
+		logger.Infof("TLS enabled with certificate: %s (minimum version: %s)", tlsConfig.CertFile, tls.VersionName(tlsConfig.Config.MinVersion))
 	} else {
 		if !client.IsLocalHost(host) {
 			return fmt.Errorf("TLS is required for non-localhost binding (%s). Set MCP_TLS_CERT_FILE and MCP_TLS_KEY_FILE environment variables", host)
 		}
-		logger.Warnf("TLS is disabled on StreamableHTTP server; this is not recommended for production")
+		logger.Warnf("TLS is disabled on %s server; this is not recommended for production", transportDisplayName(transport))
 	}
 
 	// Start server in goroutine
 	errC := make(chan error, 1)
 	go func() {
-		logger.Infof("Starting StreamableHTTP server on %s%s", addr, endpointPath)
+		logger.Infof("Starting %s server on %s%s", transportDisplayName(transport), addr, endpointPath)
+		if tlsConfig != nil {
+			errC <- httpServer.ListenAndServeTLS(tlsConfig.CertFile, tlsConfig.KeyFile)
+			return
+		}
 		errC <- httpServer.ListenAndServe()
 	}()
 
 	// Wait for shutdown signal
 	select {
 	case <-ctx.Done():
-		logger.Infof("Shutting down StreamableHTTP server...")
-		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		gracePeriod := getShutdownGracePeriod(logger)
+		logger.Infof("Shutdown signal received, no longer accepting new connections. Draining in-flight requests for up to %v...", gracePeriod)
+		// Each shutdown step gets a context created when it starts, so a step never inherits a grace period the
+		// steps before it already used up
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), gracePeriod)
 		defer cancel()
-		return httpServer.Shutdown(shutdownCtx)
+
+		// Shutdown closes the listeners first, then waits for active requests to complete
+		if sseServer != nil {
+			// Event streams never finish on their own, so close them to let the requests drain
+			sseServer.CloseSessions()
+		}
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			logger.Warnf("Grace period of %v elapsed with requests still in flight, closing remaining connections: %v", gracePeriod, err)
+			if closeErr := httpServer.Close(); closeErr != nil {
+				logger.Errorf("Failed to close remaining connections: %v", closeErr)
+			}
+		}
+		if baseStreamableServer != nil {
+			cleanupCtx, cleanupCancel := context.WithTimeout(context.Background(), gracePeriod)
+			defer cleanupCancel()
+			if err := baseStreamableServer.Shutdown(cleanupCtx); err != nil {
+				logger.Debugf("StreamableHTTP server cleanup: %v", err)
+			}
+		}
+		logger.Infof("%s server stopped", transportDisplayName(transport))
+		return nil
 	case err := <-errC:
 		if err != nil && err != http.ErrServerClosed {
-			return fmt.Errorf("StreamableHTTP server error: %w", err)
+			return fmt.Errorf("%s server error: %w", transportDisplayName(transport), err)
 		}
 	}
 
@@ -16,6 +16,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/tools"
+	registryTools "github.com/hashicorp/terraform-mcp-server/pkg/tools/registry"
 	"github.com/hashicorp/terraform-mcp-server/pkg/toolsets"
 	"github.com/hashicorp/terraform-mcp-server/version"
 	"go.opentelemetry.io/otel"
@@ -36,9 +38,44 @@ import (
 var instructions string
 var sessionClientInfo sync.Map // map[string]client.ClientInfo
 
-func runHTTPServer(logger *log.Logger, host string, port string, endpointPath string, heartbeatInterval time.Duration, enabledToolsets []string, metricsConfig client.MetricsConfig) error {
+// defaultShutdownGracePeriod is used when MCP_SHUTDOWN_GRACE_PERIOD is not set
+const defaultShutdownGracePeriod = 5 * time.Second
+
+const (
+	transportStreamableHTTP = "streamable-http"
+	transportSSE            = "sse"
+)
+
+// transportDisplayName returns the name of an HTTP based transport used in log messages
+func transportDisplayName(transport string) string {
+	if transport == transportSSE {
+		return "SSE"
+	}
+	return "StreamableHTTP"
+}
+
+// loadRegistryAPIVersions applies TERRAFORM_REGISTRY_API_VERSIONS, before the startup check and the cache preload
+// call the registry
+func loadRegistryAPIVersions(logger *log.Logger) {
+	if apiVersions := client.LoadRegistryAPIVersionsFromEnv(logger); apiVersions != client.DefaultRegistryAPIVersions {
+		logger.Infof("Registry API versions: %+v", apiVersions)
+	}
+}
+
+func runHTTPServer(logger *log.Logger, transport string, host string, port string, endpointPath string, healthPath string, heartbeatInterval time.Duration, enabledToolsets []string, metricsConfig client.MetricsConfig) error {
+	if err := client.InitRegistryCredentials(logger); err != nil {
+		return fmt.Errorf("invalid registry credentials: %w", err)
+	}
+	loadRegistryAPIVersions(logger)
+	if err := client.CheckRegistryAtStartup(client.LoadRegistryStartupCheckFromEnv(logger), logger); err != nil {
+		return err
+	}
+	shutdownTracing := setupTracing(logger)
+	defer shutdownTracing()
+
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
+	client.PreloadRegistryProviders(ctx, client.LoadPreloadProvidersFromEnv(registryTools.DefaultProviderNamespace(), logger), logger)
 
 	// Create hooks for session management
 	hooks := &server.Hooks{}
@@ -68,13 +105,35 @@ func runHTTPServer(logger *log.Logger, host string, port string, endpointPath st
 		}
 	})
 	attachMetricsHooks(hooks, metricsConfig, logger)
+	attachPrometheusHooks(hooks, logger)
 
 	opts := []server.ServerOption{server.WithHooks(hooks)}
 
+	// Optionally offload large tool results to a download endpoint
+	var resultDownloads *client.ResultDownloadStore
+	if downloadConfig := client.LoadResultDownloadConfigFromEnv(logger); downloadConfig.Enabled {
+		logger.Infof("Tool results over %d characters will be offered as downloads valid for %v", downloadConfig.Threshold, downloadConfig.TTL)
+		resultDownloads = client.NewResultDownloadStore(downloadConfig)
+		opts = append(opts, server.WithToolHandlerMiddleware(resultDownloads.Middleware(logger)))
+	}
+
+	// Bound the time a tool call may take, the write timeout of the HTTP server is extended to match
+	toolCallTimeout := client.LoadToolCallTimeoutConfigFromEnv(logger)
+	opts = append(opts, toolCallTimeoutOptions(toolCallTimeout, logger)...)
+
+	// Bound the number of tool calls in flight, excess calls are rejected with 429. The limiter runs inside the
+	// timeout, so the slot of a timed out call is only released once its handler has returned.
+	var toolCallLimiter *client.ToolCallLimiter
+	if maxToolCalls := client.LoadMaxConcurrentToolCallsFromEnv(logger); maxToolCalls > 0 {
+		logger.Infof("Concurrent tool calls limited to %d", maxToolCalls)
+		toolCallLimiter = client.NewToolCallLimiter(maxToolCalls, logger)
+		opts = append(opts, server.WithToolHandlerMiddleware(toolCallLimiter.Middleware()))
+	}
+
 	hcServer := NewServer(version.Version, logger, enabledToolsets, opts...)
 	registerToolsAndResources(hcServer, logger, enabledToolsets)
 
-	return streamableHTTPServerInit(ctx, hcServer, logger, host, port, endpointPath, heartbeatInterval)
+	return streamableHTTPServerInit(ctx, hcServer, logger, transport, host, port, endpointPath, healthPath, heartbeatInterval, resultDownloads, toolCallLimiter, toolCallTimeout)
 }
 
 func attachMetricsHooks(hooks *server.Hooks, metricsConfig client.MetricsConfig, logger *log.Logger) {
@@ -143,9 +202,38 @@ func attachMetricsHooks(hooks *server.Hooks, metricsConfig client.MetricsConfig,
 	})
 }
 
+// attachPrometheusHooks counts tool calls and errors for the Prometheus metrics endpoint
+func attachPrometheusHooks(hooks *server.Hooks, logger *log.Logger) {
+	if !client.IsPrometheusMetricsEnabled() {
+		return
+	}
+	logger.Infof("Prometheus metrics enabled on %s", client.PrometheusMetricsPath)
+	hooks.AddAfterCallTool(func(ctx context.Context, id any, message *mcp.CallToolRequest, result any) {
+		res, ok := result.(*mcp.CallToolResult)
+		client.RecordPrometheusToolCall(ctx, message, ok && res.IsError)
+	})
+	hooks.AddOnError(func(ctx context.Context, id any, method mcp.MCPMethod, message any, err error) {
+		// Tool handlers that return an error never reach the AfterCallTool hook
+		if request, ok := message.(*mcp.CallToolRequest); ok && method == mcp.MethodToolsCall {
+			client.RecordPrometheusToolCall(ctx, request, true)
+		}
+	})
+}
+
 func runStdioServer(logger *log.Logger, enabledToolsets []string) error {
+	if err := client.InitRegistryCredentials(logger); err != nil {
+		return fmt.Errorf("invalid registry credentials: %w", err)
+	}
+	loadRegistryAPIVersions(logger)
+	if err := client.CheckRegistryAtStartup(client.LoadRegistryStartupCheckFromEnv(logger), logger); err != nil {
+		return err
+	}
+	shutdownTracing := setupTracing(logger)
+	defer shutdownTracing()
+
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
+	client.PreloadRegistryProviders(ctx, client.LoadPreloadProvidersFromEnv(registryTools.DefaultProviderNamespace(), logger), logger)
 
 	// Create hooks for session management
 	hooks := &server.Hooks{}
@@ -156,10 +244,13 @@ func runStdioServer(logger *log.Logger, enabledToolsets []string) error {
 		client.EndSessionHandler(ctx, session, logger)
 	})
 
-	hcServer := NewServer(version.Version, logger, enabledToolsets, server.WithHooks(hooks))
+	opts := []server.ServerOption{server.WithHooks(hooks)}
+	opts = append(opts, toolCallTimeoutOptions(client.LoadToolCallTimeoutConfigFromEnv(logger), logger)...)
+
+	hcServer := NewServer(version.Version, logger, enabledToolsets, opts...)
 	registerToolsAndResources(hcServer, logger, enabledToolsets)
 
-	return serverInit(ctx, hcServer, logger)
+	return serverInit(ctx, hcServer, logger, getStdioHeartbeatInterval(logger))
 }
 
 func NewServer(version string, logger *log.Logger, enabledToolsets []string, opts ...server.ServerOption) *server.MCPServer {
@@ -172,11 +263,54 @@ func NewServer(version string, logger *log.Logger, enabledToolsets []string, opt
 		server.WithToolCapabilities(true),
 		server.WithResourceCapabilities(true, true),
 		server.WithInstructions(instructions),
+	}
+
+	// Trace tool calls first, so the span covers the other middlewares as well
+	if client.IsTracingEnabled() {
+		defaultOpts = append(defaultOpts, server.WithToolHandlerMiddleware(client.ToolTracingMiddleware()))
+	}
+	defaultOpts = append(defaultOpts,
+		server.WithToolHandlerMiddleware(client.ToolCallLoggingMiddleware(logger)),
+	)
+
+	// Encode results as JSON outside of the other middlewares, so their error results and truncated text are encoded too
+	outputFormat := client.LoadOutputFormatFromEnv(logger)
+	if outputFormat == client.OutputFormatJSON {
+		logger.Infof("Tool results will be returned as JSON")
+	}
+	defaultOpts = append(defaultOpts,
+		server.WithToolHandlerMiddleware(client.OutputFormatMiddleware(outputFormat, logger)),
 		server.WithToolHandlerMiddleware(rateLimitMiddleware.Middleware()),
 		server.WithElicitation(),
+	)
+
+	// Optionally truncate tool results, after large results have been offloaded to downloads
+	if maxResponseBytes := client.LoadMaxResponseBytesFromEnv(logger); maxResponseBytes > 0 {
+		logger.Infof("Tool results larger than %d bytes will be truncated", maxResponseBytes)
+		defaultOpts = append(defaultOpts, server.WithToolHandlerMiddleware(client.ResponseSizeMiddleware(maxResponseBytes, logger)))
+	}
+
+	// Optionally surface the registry rate-limit status in tool result metadata
+	if client.IsRateLimitMetadataEnabled() {
+		logger.Infof("Registry rate-limit status will be included in tool result metadata")
+		defaultOpts = append(defaultOpts, server.WithToolHandlerMiddleware(client.RegistryRateLimitMetadataMiddleware(logger)))
 	}
+
+	// Optionally cap the number of registry requests a single tool invocation may make
+	if budgetConfig := client.LoadRegistryCallBudgetConfigFromEnv(logger); budgetConfig.Enabled() {
+		logger.Infof("Registry calls per tool invocation limited to %d (per-tool overrides: %v)", budgetConfig.GlobalLimit, budgetConfig.ToolLimits)
+		defaultOpts = append(defaultOpts, server.WithToolHandlerMiddleware(client.RegistryCallBudgetMiddleware(budgetConfig, logger)))
+	}
+
 	opts = append(defaultOpts, opts...)
 
+	// Optionally reuse the results of cacheable tools, innermost, after the middlewares passed in opts such as the
+	// result downloads, so cache hits still go through all the other middlewares
+	if ttl := client.LoadToolResultCacheTTLFromEnv(logger); ttl > 0 {
+		logger.Infof("Results of cacheable tools will be reused for identical calls for %v", ttl)
+		opts = append(opts, server.WithToolHandlerMiddleware(client.ToolResultCacheMiddleware(ttl, tools.IsCacheableTool, logger)))
+	}
+
 	// Create a new MCP server
 	s := server.NewMCPServer(
 		"terraform-mcp-server",
@@ -186,6 +320,15 @@ func NewServer(version string, logger *log.Logger, enabledToolsets []string, opt
 	return s
 }
 
+// toolCallTimeoutOptions bounds the duration of tool calls when a timeout is configured
+func toolCallTimeoutOptions(config client.ToolCallTimeoutConfig, logger *log.Logger) []server.ServerOption {
+	if !config.Enabled() {
+		return nil
+	}
+	logger.Infof("Tool calls time out after %v (per-tool overrides: %v)", config.GlobalTimeout, config.ToolTimeouts)
+	return []server.ServerOption{server.WithToolHandlerMiddleware(client.ToolCallTimeoutMiddleware(config, logger))}
+}
+
 // parseToolsets parses and validates the toolsets flag value
 func parseToolsets(toolsetsFlag string, logger *log.Logger) []string {
 	rawToolsets := strings.Split(toolsetsFlag, ",")
@@ -283,8 +426,12 @@ func main() {
 	if err != nil {
 		stdlog.Fatal("Failed to initialize logger:", err)
 	}
-	if shouldUseStreamableHTTPMode() {
-		logger.Info("Starting in Streamable HTTP mode based on environment configuration")
+	if shouldUseSSEMode() || shouldUseStreamableHTTPMode() {
+		transport := transportStreamableHTTP
+		if shouldUseSSEMode() {
+			transport = transportSSE
+		}
+		logger.Infof("Starting in %s mode based on environment configuration", transportDisplayName(transport))
 
 		metricsConfig, shutdownMetrics := setupMetrics(logger)
 		defer shutdownMetrics()
@@ -292,10 +439,11 @@ func main() {
 		port := getHTTPPort()
 		host := getHTTPHost()
 		endpointPath := getEndpointPath(nil)
+		healthPath := getHealthPath(nil)
 		enabledToolsets := getToolsetsFromCmd(rootCmd, logger)
 		heartbeatInterval := getHeartbeatInterval()
-		if err := runHTTPServer(logger, host, port, endpointPath, heartbeatInterval, enabledToolsets, metricsConfig); err != nil {
-			stdlog.Fatal("failed to run StreamableHTTP server:", err)
+		if err := runHTTPServer(logger, transport, host, port, endpointPath, healthPath, heartbeatInterval, enabledToolsets, metricsConfig); err != nil {
+			stdlog.Fatalf("failed to run %s server: %v", transportDisplayName(transport), err)
 		}
 		return
 	}
@@ -316,6 +464,11 @@ func shouldUseStreamableHTTPMode() bool {
 		os.Getenv("MCP_ENDPOINT") != ""
 }
 
+// shouldUseSSEMode checks if the TRANSPORT_MODE environment variable selects the SSE transport
+func shouldUseSSEMode() bool {
+	return os.Getenv("TRANSPORT_MODE") == transportSSE
+}
+
 // getHTTPPort returns the port from environment variables or default
 func getHTTPPort() string {
 	if port := os.Getenv("TRANSPORT_PORT"); port != "" {
@@ -362,6 +515,21 @@ func getEndpointPath(cmd *cobra.Command) string {
 	return "/mcp"
 }
 
+// getHealthPath returns the health check path from the MCP_HEALTH_ENDPOINT environment variable or flag
+func getHealthPath(cmd *cobra.Command) string {
+	if envPath := os.Getenv("MCP_HEALTH_ENDPOINT"); envPath != "" {
+		return envPath
+	}
+
+	if cmd != nil {
+		if path, err := cmd.Flags().GetString("health-endpoint"); err == nil && path != "" {
+			return path
+		}
+	}
+
+	return "/health"
+}
+
 // getHeartbeatInterval returns the heartbeat interval duration from the env var or default
 func getHeartbeatInterval() time.Duration {
 	if val := os.Getenv("MCP_HEARTBEAT_INTERVAL"); val != "" {
@@ -373,6 +541,30 @@ func getHeartbeatInterval() time.Duration {
 	return 0
 }
 
+// getShutdownGracePeriod returns how long in-flight HTTP requests may take to complete after a shutdown signal
+func getShutdownGracePeriod(logger *log.Logger) time.Duration {
+	if val := os.Getenv("MCP_SHUTDOWN_GRACE_PERIOD"); val != "" {
+		duration, err := time.ParseDuration(val)
+		if err == nil && duration > 0 {
+			return duration
+		}
+		logger.Warnf("Invalid MCP_SHUTDOWN_GRACE_PERIOD value %q, using default %v", val, defaultShutdownGracePeriod)
+	}
+	return defaultShutdownGracePeriod
+}
+
+// getStdioHeartbeatInterval returns the interval of the heartbeat notifications sent on stdio, 0 when disabled
+func getStdioHeartbeatInterval(logger *log.Logger) time.Duration {
+	if val := os.Getenv("MCP_STDIO_HEARTBEAT_INTERVAL"); val != "" {
+		duration, err := time.ParseDuration(val)
+		if err == nil && duration >= 0 {
+			return duration
+		}
+		logger.Warnf("Invalid MCP_STDIO_HEARTBEAT_INTERVAL value %q, stdio heartbeat is disabled", val)
+	}
+	return 0
+}
+
 func setupMetrics(logger *log.Logger) (client.MetricsConfig, func()) {
 	metricsConfig := client.LoadMetricsConfigFromEnv()
 	logger.Infof("Metrics enabled: %t endpoint: %s exportInterval: %s", metricsConfig.Enabled, metricsConfig.Endpoint, metricsConfig.ExportInterval)
@@ -395,6 +587,20 @@ func setupMetrics(logger *log.Logger) (client.MetricsConfig, func()) {
 	return metricsConfig, shutdown
 }
 
+// setupTracing exports tool call and registry request spans when an OTLP endpoint is configured
+func setupTracing(logger *log.Logger) func() {
+	if !client.IsTracingEnabled() {
+		return func() {}
+	}
+	shutdown, err := client.InitTracing(context.Background(), logger)
+	if err != nil {
+		logger.Errorf("Failed to initialize tracing: %v", err)
+		return func() {}
+	}
+	logger.Infof("Tracing enabled, exporting spans over OTLP")
+	return shutdown
+}
+
 func initMetrics(ctx context.Context, config *client.MetricsConfig, logger *log.Logger) (func(), error) {
 	logger.Infof("Initializing exporter and meter provider for OTel metrics...")
 	// Create the Exporter (Sends data to the Collector)
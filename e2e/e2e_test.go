@@ -106,6 +106,7 @@ func runTestSuite(t *testing.T, client mcpClient.MCPClient, transportName string
 			if testCase.TestShouldFail {
 				require.NoError(t, err)
 				require.True(t, response.IsError, "expected to call 'search_providers' tool with error")
+				requireInvalidArgument(t, response, testCase.TestInvalidArgument)
 			} else {
 				require.NoError(t, err, "expected to call 'search_providers' tool successfully")
 				require.False(t, response.IsError, "expected result not to be an error")
@@ -128,6 +129,8 @@ func runTestSuite(t *testing.T, client mcpClient.MCPClient, transportName string
 					require.Contains(t, textContent.Text, "actions", "expected content to contain actions")
 				case CONST_TYPE_LIST_RESOURCES:
 					require.Contains(t, textContent.Text, "list-resources", "expected content to contain list-resources")
+				case CONST_TYPE_EPHEMERAL:
+					require.Contains(t, textContent.Text, "Category: ephemeral-resources", "expected content to contain ephemeral-resources")
 				}
 			}
 		})
@@ -150,6 +153,7 @@ func runTestSuite(t *testing.T, client mcpClient.MCPClient, transportName string
 			if testCase.TestShouldFail {
 				require.NoError(t, err)
 				require.True(t, response.IsError, "expected to call 'get_provider_details' tool with error")
+				requireInvalidArgument(t, response, testCase.TestInvalidArgument)
 			} else {
 				require.NoError(t, err, "expected to call 'get_provider_details' tool successfully")
 				require.False(t, response.IsError, "expected result not to be an error")
@@ -181,6 +185,7 @@ func runTestSuite(t *testing.T, client mcpClient.MCPClient, transportName string
 			if testCase.TestShouldFail {
 				require.NoError(t, err)
 				require.True(t, response.IsError, "expected to call 'search_modules' tool with error")
+				requireInvalidArgument(t, response, testCase.TestInvalidArgument)
 			} else {
 				require.NoError(t, err, "expected to call 'search_modules' tool successfully")
 				require.False(t, response.IsError, "expected result not to be an error")
@@ -188,6 +193,17 @@ func runTestSuite(t *testing.T, client mcpClient.MCPClient, transportName string
 					textContent, ok := response.Content[0].(mcp.TextContent)
 					require.True(t, ok, "expected content to be of type TextContent")
 					t.Logf("Content length: %d", len(textContent.Text))
+
+					require.Contains(t, textContent.Text, "- returned: ", "expected the number of returned modules")
+					require.Contains(t, textContent.Text, "- total_matches: ", "expected the total match count")
+					if strings.Contains(textContent.Text, "- truncated: true") {
+						require.Contains(t, textContent.Text, "- next_offset: ", "expected the offset of the next page when truncated")
+					} else {
+						require.Contains(t, textContent.Text, "- truncated: false", "expected the truncated flag")
+					}
+					if testCase.TestName == "aws_query_no_offset" {
+						require.Contains(t, textContent.Text, "- truncated: true", "expected more than one page of aws modules")
+					}
 				} else {
 					t.Log("Response content is empty for successful call.")
 				}
@@ -195,6 +211,28 @@ func runTestSuite(t *testing.T, client mcpClient.MCPClient, transportName string
 		})
 	}
 
+	t.Run(fmt.Sprintf("%s_search_modules/sort_modes", transportName), func(t *testing.T) {
+		ensureClientInitialized(t, client)
+		orders := make(map[string][]string)
+		for _, sortBy := range []string{"downloads", "published", "relevance"} {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			request := mcp.CallToolRequest{}
+			request.Params.Name = "search_modules"
+			request.Params.Arguments = map[string]interface{}{"module_query": "aws", "sort": sortBy}
+			response, err := client.CallTool(ctx, request)
+			cancel()
+			require.NoError(t, err, "expected to call 'search_modules' tool successfully")
+			require.False(t, response.IsError, "expected result not to be an error")
+			textContent, ok := response.Content[0].(mcp.TextContent)
+			require.True(t, ok, "expected content to be of type TextContent")
+			require.Contains(t, textContent.Text, "sorted by "+sortBy)
+			orders[sortBy] = moduleIDs(textContent.Text)
+			require.NotEmpty(t, orders[sortBy], "expected modules sorted by %s", sortBy)
+		}
+		require.ElementsMatch(t, orders["downloads"], orders["published"], "expected the same page of modules in every sort mode")
+		require.NotEqual(t, orders["downloads"], orders["published"], "expected downloads and published sort orders to differ")
+	})
+
 	for _, testCase := range moduleDetailsTestCases {
 		t.Run(fmt.Sprintf("%s_get_module_details/%s", transportName, testCase.TestName), func(t *testing.T) {
 			ensureClientInitialized(t, client)
@@ -212,6 +250,7 @@ func runTestSuite(t *testing.T, client mcpClient.MCPClient, transportName string
 			if testCase.TestShouldFail {
 				require.NoError(t, err)
 				require.True(t, response.IsError, "expected to call 'get_module_details' tool with error")
+				requireInvalidArgument(t, response, testCase.TestInvalidArgument)
 			} else {
 				require.NoError(t, err, "expected to call 'get_module_details' tool successfully")
 				require.False(t, response.IsError, "expected result not to be an error")
@@ -248,6 +287,7 @@ func runTestSuite(t *testing.T, client mcpClient.MCPClient, transportName string
 			if testCase.TestShouldFail {
 				require.NoError(t, err)
 				require.True(t, response.IsError, "expected to call 'search_policies' tool with error")
+				requireInvalidArgument(t, response, testCase.TestInvalidArgument)
 			} else {
 				require.NoError(t, err, "expected to call 'search_policies' tool successfully")
 				require.False(t, response.IsError, "expected result not to be an error")
@@ -285,6 +325,7 @@ func runTestSuite(t *testing.T, client mcpClient.MCPClient, transportName string
 			if testCase.TestShouldFail {
 				require.NoError(t, err)
 				require.True(t, response.IsError, "expected to call 'get_policy_details' tool with error")
+				requireInvalidArgument(t, response, testCase.TestInvalidArgument)
 			} else {
 				require.NoError(t, err, "expected to call 'get_policy_details' tool successfully")
 				require.False(t, response.IsError, "expected result not to be an error")
@@ -318,6 +359,7 @@ func runTestSuite(t *testing.T, client mcpClient.MCPClient, transportName string
 			if testCase.TestShouldFail {
 				require.NoError(t, err)
 				require.True(t, response.IsError, "expected to call 'get_latest_module_version' tool with error")
+				requireInvalidArgument(t, response, testCase.TestInvalidArgument)
 			} else {
 				require.NoError(t, err, "expected to call 'get_latest_module_version' tool successfully")
 				require.False(t, response.IsError, "expected result not to be an error")
@@ -352,6 +394,7 @@ func runTestSuite(t *testing.T, client mcpClient.MCPClient, transportName string
 			if testCase.TestShouldFail {
 				require.NoError(t, err)
 				require.True(t, response.IsError, "expected to call 'get_latest_provider_version' tool with error")
+				requireInvalidArgument(t, response, testCase.TestInvalidArgument)
 			} else {
 				require.NoError(t, err, "expected to call 'get_latest_provider_version' tool successfully")
 				require.False(t, response.IsError, "expected result not to be an error")
@@ -513,6 +556,40 @@ func cleanupAllTestContainers(t *testing.T) {
 	}
 }
 
+// requireInvalidArgument checks that a call was rejected with a structured invalid_arguments error naming field,
+// when the test case expects an argument error
+func requireInvalidArgument(t *testing.T, response *mcp.CallToolResult, field string) {
+	t.Helper()
+	if field == "" {
+		return
+	}
+	structured, ok := response.StructuredContent.(map[string]any)
+	require.True(t, ok, "expected structured content for invalid arguments, got %T", response.StructuredContent)
+	require.Equal(t, "invalid_arguments", structured["error"])
+
+	arguments, ok := structured["arguments"].([]any)
+	require.True(t, ok, "expected a list of invalid arguments")
+	var fields []string
+	for _, argument := range arguments {
+		argumentError, ok := argument.(map[string]any)
+		require.True(t, ok, "expected each invalid argument to be an object")
+		require.NotEmpty(t, argumentError["reason"], "expected a reason for the invalid argument")
+		fields = append(fields, fmt.Sprint(argumentError["field"]))
+	}
+	require.Contains(t, fields, field, "expected argument '%s' to be rejected", field)
+}
+
+// moduleIDs returns the module IDs of a search_modules result, in the order they are listed
+func moduleIDs(text string) []string {
+	var ids []string
+	for _, line := range strings.Split(text, "\n") {
+		if id, ok := strings.CutPrefix(line, "- module_id: "); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
 // getTestPort returns the test port from environment variable or default
 func getTestPort() string {
 	if port := os.Getenv("E2E_TEST_PORT"); port != "" {
@@ -13,6 +13,7 @@ const (
 	CONST_TYPE_OVERVIEW       ContentType = "overview"
 	CONST_TYPE_ACTIONS        ContentType = "actions"
 	CONST_TYPE_LIST_RESOURCES ContentType = "list-resources"
+	CONST_TYPE_EPHEMERAL      ContentType = "ephemeral-resources"
 )
 
 type RegistryTestCase struct {
@@ -21,20 +22,24 @@ type RegistryTestCase struct {
 	TestDescription string                 `json:"testDescription"`
 	TestContentType ContentType            `json:"testContentType,omitempty"`
 	TestPayload     map[string]interface{} `json:"testPayload,omitempty"`
+	// TestInvalidArgument is the field a failing call must be rejected for with a structured invalid_arguments error
+	TestInvalidArgument string `json:"testInvalidArgument,omitempty"`
 }
 
 var searchProviderTestCases = []RegistryTestCase{
 	{
-		TestName:        "empty_payload",
-		TestShouldFail:  true,
-		TestDescription: "Testing search_providers with empty payload",
-		TestPayload:     map[string]interface{}{},
+		TestName:            "empty_payload",
+		TestShouldFail:      true,
+		TestDescription:     "Testing search_providers with empty payload",
+		TestInvalidArgument: "provider_name",
+		TestPayload:         map[string]interface{}{},
 	},
 	{
-		TestName:        "missing_namespace_and_version",
-		TestShouldFail:  true,
-		TestDescription: "Testing search_providers without provider_namespace and provider_version",
-		TestPayload:     map[string]interface{}{"provider_name": "google"},
+		TestName:            "missing_namespace_and_version",
+		TestShouldFail:      true,
+		TestDescription:     "Testing search_providers without provider_namespace and provider_version",
+		TestInvalidArgument: "service_slug",
+		TestPayload:         map[string]interface{}{"provider_name": "google"},
 	},
 	{
 		TestName:        "without_version",
@@ -125,9 +130,10 @@ var searchProviderTestCases = []RegistryTestCase{
 		},
 	},
 	{
-		TestName:        "malformed_provider_name",
-		TestShouldFail:  true,
-		TestDescription: "Testing search_providers payload with malformed provider_name",
+		TestName:            "malformed_provider_name",
+		TestShouldFail:      true,
+		TestDescription:     "Testing search_providers payload with malformed provider_name",
+		TestInvalidArgument: "service_slug",
 		TestPayload: map[string]interface{}{
 			"provider_name":      "vaults",
 			"provider_namespace": "hashicorp",
@@ -199,27 +205,55 @@ var searchProviderTestCases = []RegistryTestCase{
 			"service_slug":           "instance",
 		},
 	},
+	{
+		TestName:        "ephemeral_resources_documentation",
+		TestShouldFail:  false,
+		TestDescription: "Testing search_providers ephemeral-resources documentation with v2 API",
+		TestContentType: CONST_TYPE_EPHEMERAL,
+		TestPayload: map[string]interface{}{
+			"provider_name":          "aws",
+			"provider_namespace":     "hashicorp",
+			"provider_version":       "latest",
+			"provider_document_type": "ephemeral-resources",
+			"service_slug":           "secretsmanager_secret_version",
+		},
+	},
+	{
+		TestName:        "ephemeral_resources_third_party",
+		TestShouldFail:  false,
+		TestDescription: "Testing search_providers ephemeral-resources documentation for the random provider",
+		TestContentType: CONST_TYPE_EPHEMERAL,
+		TestPayload: map[string]interface{}{
+			"provider_name":          "random",
+			"provider_namespace":     "hashicorp",
+			"provider_document_type": "ephemeral-resources",
+			"service_slug":           "password",
+		},
+	},
 }
 
 var providerDetailsTestCases = []RegistryTestCase{
 	{
-		TestName:        "empty_payload",
-		TestShouldFail:  true,
-		TestDescription: "Testing get_provider_details with empty payload",
-		TestPayload:     map[string]interface{}{},
+		TestName:            "empty_payload",
+		TestShouldFail:      true,
+		TestDescription:     "Testing get_provider_details with empty payload",
+		TestInvalidArgument: "provider_doc_id",
+		TestPayload:         map[string]interface{}{},
 	},
 	{
-		TestName:        "empty_doc_id",
-		TestShouldFail:  true,
-		TestDescription: "Testing get_provider_details with empty provider_doc_id",
+		TestName:            "empty_doc_id",
+		TestShouldFail:      true,
+		TestDescription:     "Testing get_provider_details with empty provider_doc_id",
+		TestInvalidArgument: "provider_doc_id",
 		TestPayload: map[string]interface{}{
 			"provider_doc_id": "",
 		},
 	},
 	{
-		TestName:        "invalid_doc_id",
-		TestShouldFail:  true,
-		TestDescription: "Testing get_provider_details with invalid provider_doc_id",
+		TestName:            "invalid_doc_id",
+		TestShouldFail:      true,
+		TestDescription:     "Testing get_provider_details with invalid provider_doc_id",
+		TestInvalidArgument: "provider_doc_id",
 		TestPayload: map[string]interface{}{
 			"provider_doc_id": "invalid-doc-id",
 		},
@@ -242,10 +276,11 @@ var providerDetailsTestCases = []RegistryTestCase{
 }
 var searchModulesTestCases = []RegistryTestCase{
 	{
-		TestName:        "no_parameters",
-		TestShouldFail:  true,
-		TestDescription: "Testing search_modules with no parameters",
-		TestPayload:     map[string]interface{}{},
+		TestName:            "no_parameters",
+		TestShouldFail:      true,
+		TestDescription:     "Testing search_modules with no parameters",
+		TestInvalidArgument: "module_query",
+		TestPayload:         map[string]interface{}{},
 	},
 	{
 		TestName:        "empty_query_all_modules",
@@ -280,9 +315,10 @@ var searchModulesTestCases = []RegistryTestCase{
 		},
 	},
 	{
-		TestName:        "negative_offset",
-		TestShouldFail:  false,
-		TestDescription: "Testing search_modules with invalid current_offset (negative)",
+		TestName:            "negative_offset",
+		TestShouldFail:      true,
+		TestDescription:     "Testing search_modules with invalid current_offset (negative)",
+		TestInvalidArgument: "current_offset",
 		TestPayload: map[string]interface{}{
 			"module_query":   "",
 			"current_offset": -1,
@@ -328,6 +364,15 @@ var searchModulesTestCases = []RegistryTestCase{
 			"module_query": "vertex ai",
 		},
 	},
+	{
+		TestName:        "verified_only",
+		TestShouldFail:  false,
+		TestDescription: "Testing search_modules with verified_only",
+		TestPayload: map[string]interface{}{
+			"module_query":  "vpc",
+			"verified_only": true,
+		},
+	},
 }
 
 var moduleDetailsTestCases = []RegistryTestCase{
@@ -340,15 +385,17 @@ var moduleDetailsTestCases = []RegistryTestCase{
 		},
 	},
 	{
-		TestName:        "missing_module_id",
-		TestShouldFail:  true,
-		TestDescription: "Testing get_module_details missing module_id",
-		TestPayload:     map[string]interface{}{},
+		TestName:            "missing_module_id",
+		TestShouldFail:      true,
+		TestDescription:     "Testing get_module_details missing module_id",
+		TestInvalidArgument: "module_id",
+		TestPayload:         map[string]interface{}{},
 	},
 	{
-		TestName:        "empty_module_id",
-		TestShouldFail:  true,
-		TestDescription: "Testing get_module_details with empty module_id",
+		TestName:            "empty_module_id",
+		TestShouldFail:      true,
+		TestDescription:     "Testing get_module_details with empty module_id",
+		TestInvalidArgument: "module_id",
 		TestPayload: map[string]interface{}{
 			"module_id": "",
 		},
@@ -362,9 +409,10 @@ var moduleDetailsTestCases = []RegistryTestCase{
 		},
 	},
 	{
-		TestName:        "invalid_format",
-		TestShouldFail:  true, // Expecting empty or error, tool call might succeed but return no useful data
-		TestDescription: "Testing get_module_details with invalid module_id format",
+		TestName:            "invalid_format",
+		TestShouldFail:      true, // Expecting empty or error, tool call might succeed but return no useful data
+		TestDescription:     "Testing get_module_details with invalid module_id format",
+		TestInvalidArgument: "module_id",
 		TestPayload: map[string]interface{}{
 			"module_id": "invalid-format",
 		},
@@ -373,13 +421,15 @@ var moduleDetailsTestCases = []RegistryTestCase{
 
 var searchPoliciesTestCases = []RegistryTestCase{
 	{
-		TestShouldFail:  true,
-		TestDescription: "Testing search_policies with empty payload",
-		TestPayload:     map[string]interface{}{},
+		TestShouldFail:      true,
+		TestDescription:     "Testing search_policies with empty payload",
+		TestInvalidArgument: "policy_query",
+		TestPayload:         map[string]interface{}{},
 	},
 	{
-		TestShouldFail:  true,
-		TestDescription: "Testing search_policies with empty policy_query",
+		TestShouldFail:      true,
+		TestDescription:     "Testing search_policies with empty policy_query",
+		TestInvalidArgument: "policy_query",
 		TestPayload: map[string]interface{}{
 			"policy_query": "",
 		},
@@ -437,13 +487,23 @@ var policyDetailsTestCases = []RegistryTestCase{
 		},
 	},
 	{
-		TestShouldFail:  true,
-		TestDescription: "Testing get_policy_details with missing terraform_policy_id",
-		TestPayload:     map[string]interface{}{},
+		TestShouldFail:  false,
+		TestDescription: "Testing get_policy_details with verify_checksums",
+		TestPayload: map[string]interface{}{
+			"terraform_policy_id": "policies/hashicorp/azure-storage-terraform/1.0.2",
+			"verify_checksums":    true,
+		},
 	},
 	{
-		TestShouldFail:  true,
-		TestDescription: "Testing get_policy_details with empty terraform_policy_id",
+		TestShouldFail:      true,
+		TestDescription:     "Testing get_policy_details with missing terraform_policy_id",
+		TestInvalidArgument: "terraform_policy_id",
+		TestPayload:         map[string]interface{}{},
+	},
+	{
+		TestShouldFail:      true,
+		TestDescription:     "Testing get_policy_details with empty terraform_policy_id",
+		TestInvalidArgument: "terraform_policy_id",
 		TestPayload: map[string]interface{}{
 			"terraform_policy_id": "",
 		},
@@ -496,37 +556,41 @@ var getLatestModuleVersionTestCases = []RegistryTestCase{
 		},
 	},
 	{
-		TestName:        "missing_module_publisher",
-		TestShouldFail:  true,
-		TestDescription: "Testing get_latest_module_version with missing module_publisher",
+		TestName:            "missing_module_publisher",
+		TestShouldFail:      true,
+		TestDescription:     "Testing get_latest_module_version with missing module_publisher",
+		TestInvalidArgument: "module_publisher",
 		TestPayload: map[string]interface{}{
 			"module_name":     "vpc",
 			"module_provider": "aws",
 		},
 	},
 	{
-		TestName:        "missing_module_name",
-		TestShouldFail:  true,
-		TestDescription: "Testing get_latest_module_version with missing module_name",
+		TestName:            "missing_module_name",
+		TestShouldFail:      true,
+		TestDescription:     "Testing get_latest_module_version with missing module_name",
+		TestInvalidArgument: "module_name",
 		TestPayload: map[string]interface{}{
 			"module_publisher": "terraform-aws-modules",
 			"module_provider":  "aws",
 		},
 	},
 	{
-		TestName:        "missing_module_provider",
-		TestShouldFail:  true,
-		TestDescription: "Testing get_latest_module_version with missing module_provider",
+		TestName:            "missing_module_provider",
+		TestShouldFail:      true,
+		TestDescription:     "Testing get_latest_module_version with missing module_provider",
+		TestInvalidArgument: "module_provider",
 		TestPayload: map[string]interface{}{
 			"module_publisher": "terraform-aws-modules",
 			"module_name":      "vpc",
 		},
 	},
 	{
-		TestName:        "empty_parameters",
-		TestShouldFail:  true,
-		TestDescription: "Testing get_latest_module_version with empty parameters",
-		TestPayload:     map[string]interface{}{},
+		TestName:            "empty_parameters",
+		TestShouldFail:      true,
+		TestDescription:     "Testing get_latest_module_version with empty parameters",
+		TestInvalidArgument: "module_publisher",
+		TestPayload:         map[string]interface{}{},
 	},
 	{
 		TestName:        "nonexistent_module",
@@ -598,26 +662,29 @@ var getLatestProviderVersionTestCases = []RegistryTestCase{
 		},
 	},
 	{
-		TestName:        "missing_namespace",
-		TestShouldFail:  true,
-		TestDescription: "Testing get_latest_provider_version with missing namespace",
+		TestName:            "missing_namespace",
+		TestShouldFail:      true,
+		TestDescription:     "Testing get_latest_provider_version with missing namespace",
+		TestInvalidArgument: "namespace",
 		TestPayload: map[string]interface{}{
 			"name": "aws",
 		},
 	},
 	{
-		TestName:        "missing_name",
-		TestShouldFail:  true,
-		TestDescription: "Testing get_latest_provider_version with missing name",
+		TestName:            "missing_name",
+		TestShouldFail:      true,
+		TestDescription:     "Testing get_latest_provider_version with missing name",
+		TestInvalidArgument: "name",
 		TestPayload: map[string]interface{}{
 			"namespace": "hashicorp",
 		},
 	},
 	{
-		TestName:        "empty_parameters",
-		TestShouldFail:  true,
-		TestDescription: "Testing get_latest_provider_version with empty parameters",
-		TestPayload:     map[string]interface{}{},
+		TestName:            "empty_parameters",
+		TestShouldFail:      true,
+		TestDescription:     "Testing get_latest_provider_version with empty parameters",
+		TestInvalidArgument: "namespace",
+		TestPayload:         map[string]interface{}{},
 	},
 	{
 		TestName:        "nonexistent_provider",
@@ -655,4 +722,14 @@ var getLatestProviderVersionTestCases = []RegistryTestCase{
 			"name":      "",
 		},
 	},
+	{
+		TestName:        "include_prerelease",
+		TestShouldFail:  false,
+		TestDescription: "Testing get_latest_provider_version with pre-release versions included",
+		TestPayload: map[string]interface{}{
+			"namespace":          "hashicorp",
+			"name":               "aws",
+			"include_prerelease": true,
+		},
+	},
 }
@@ -0,0 +1,262 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// registryCacheFileSuffix is the extension of the files written to the disk cache directory
+const registryCacheFileSuffix = ".json"
+
+// maxRegistryCacheEntries bounds the number of registry responses kept in memory, the disk cache is not bounded
+const maxRegistryCacheEntries = 1000
+
+// registryCacheEntry is a cached registry response
+type registryCacheEntry struct {
+	Key       string    `json:"key"`
+	Body      []byte    `json:"body"`
+	ETag      string    `json:"etag,omitempty"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (e registryCacheEntry) expired(now time.Time) bool {
+	return !now.Before(e.ExpiresAt)
+}
+
+// RegistryCache caches successful registry GET responses in memory for a fixed TTL. Once the TTL has expired,
+// entries with an ETag are revalidated with a conditional request rather than fetched again.
+// When a directory is configured, entries are also persisted to disk so they survive restarts.
+type RegistryCache struct {
+	ttl     time.Duration
+	dir     string
+	mu      sync.RWMutex
+	entries map[string]registryCacheEntry
+	logger  *log.Logger
+}
+
+var (
+	registryCache     *RegistryCache
+	registryCacheOnce sync.Once
+)
+
+// getRegistryCache returns the process wide registry cache configured from the environment, or nil when caching is disabled
+func getRegistryCache(logger *log.Logger) *RegistryCache {
+	registryCacheOnce.Do(func() {
+		ttlStr := utils.GetEnv("TERRAFORM_REGISTRY_CACHE_TTL", "")
+		if ttlStr == "" {
+			return
+		}
+		ttl, err := time.ParseDuration(ttlStr)
+		if err != nil || ttl <= 0 {
+			logger.Warnf("Invalid TERRAFORM_REGISTRY_CACHE_TTL value %q, registry response caching is disabled", ttlStr)
+			return
+		}
+		registryCache = NewRegistryCache(ttl, utils.GetEnv("TERRAFORM_REGISTRY_CACHE_DIR", ""), logger)
+	})
+	return registryCache
+}
+
+// NewRegistryCache creates a registry response cache. If dir is not empty, valid entries are loaded from it
+// and new entries are written to it.
+func NewRegistryCache(ttl time.Duration, dir string, logger *log.Logger) *RegistryCache {
+	cache := &RegistryCache{
+		ttl:     ttl,
+		dir:     dir,
+		entries: make(map[string]registryCacheEntry),
+		logger:  logger,
+	}
+
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			logger.Warnf("Unable to create registry cache directory %s, using in-memory cache only: %v", dir, err)
+			cache.dir = ""
+		} else {
+			cache.loadFromDisk()
+		}
+	}
+
+	logger.Infof("Registry response cache enabled with TTL %v (directory: %q, %d entries loaded)", ttl, cache.dir, len(cache.entries))
+	return cache
+}
+
+// Get returns the cached body for the key if it has not expired. An expired entry without an ETag, which cannot
+// be revalidated, is dropped from memory.
+func (c *RegistryCache) Get(key string) ([]byte, bool) {
+	entry, ok := c.lookup(key)
+	if !ok {
+		return nil, false
+	}
+	if entry.expired(time.Now()) {
+		if entry.ETag == "" {
+			c.mu.Lock()
+			if current, ok := c.entries[key]; ok && current.expired(time.Now()) {
+				delete(c.entries, key)
+			}
+			c.mu.Unlock()
+		}
+		return nil, false
+	}
+	return entry.Body, true
+}
+
+// Revalidation returns the expired entry for the key when it has an ETag, so the response can be revalidated
+// with If-None-Match instead of downloaded again
+func (c *RegistryCache) Revalidation(key string) (registryCacheEntry, bool) {
+	entry, ok := c.lookup(key)
+	if !ok || entry.ETag == "" || !entry.expired(time.Now()) {
+		return registryCacheEntry{}, false
+	}
+	return entry, true
+}
+
+// lookup returns the entry for the key, consulting the disk cache on a memory miss since another
+// process sharing the directory may have written it. Expired entries are returned as well.
+func (c *RegistryCache) lookup(key string) (registryCacheEntry, bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+	if ok || c.dir == "" {
+		return entry, ok
+	}
+
+	entry, err := c.readEntry(c.entryPath(key))
+	if err != nil || entry.Key != key {
+		return registryCacheEntry{}, false
+	}
+	c.mu.Lock()
+	c.storeLocked(entry)
+	c.mu.Unlock()
+	return entry, true
+}
+
+// Set stores a response body for the key
+func (c *RegistryCache) Set(key string, body []byte, etag string) {
+	entry := registryCacheEntry{
+		Key:       key,
+		Body:      body,
+		ETag:      etag,
+		ExpiresAt: time.Now().Add(c.ttl),
+	}
+
+	c.mu.Lock()
+	c.storeLocked(entry)
+	c.mu.Unlock()
+
+	if c.dir != "" {
+		if err := c.writeEntry(entry); err != nil {
+			c.logger.Warnf("Unable to persist registry cache entry for %s: %v", key, err)
+		}
+	}
+}
+
+// storeLocked keeps an entry in memory, making room by dropping the expired entries, or the entry closest to
+// expiring when none has expired. Entries dropped from memory are still read back from the disk cache if any.
+// The caller must hold the write lock.
+func (c *RegistryCache) storeLocked(entry registryCacheEntry) {
+	if _, ok := c.entries[entry.Key]; !ok && len(c.entries) >= maxRegistryCacheEntries {
+		now := time.Now()
+		for key, existing := range c.entries {
+			if existing.expired(now) {
+				delete(c.entries, key)
+			}
+		}
+		if len(c.entries) >= maxRegistryCacheEntries {
+			oldestKey := ""
+			var oldest time.Time
+			for key, existing := range c.entries {
+				if oldestKey == "" || existing.ExpiresAt.Before(oldest) {
+					oldestKey, oldest = key, existing.ExpiresAt
+				}
+			}
+			delete(c.entries, oldestKey)
+		}
+	}
+	c.entries[entry.Key] = entry
+}
+
+func (c *RegistryCache) entryPath(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+registryCacheFileSuffix)
+}
+
+// writeEntry writes the entry to a temporary file and renames it into place, so concurrent
+// readers never observe a partially written file
+func (c *RegistryCache) writeEntry(entry registryCacheEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmpName)
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+	if err := os.Rename(tmpName, c.entryPath(entry.Key)); err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+	return nil
+}
+
+func (c *RegistryCache) readEntry(path string) (registryCacheEntry, error) {
+	var entry registryCacheEntry
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return entry, err
+	}
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return entry, err
+	}
+	return entry, nil
+}
+
+// loadFromDisk loads the entries of the cache directory. Corrupted files are removed, as are expired files
+// unless they have an ETag to revalidate them with.
+func (c *RegistryCache) loadFromDisk() {
+	files, err := os.ReadDir(c.dir)
+	if err != nil {
+		c.logger.Warnf("Unable to read registry cache directory %s: %v", c.dir, err)
+		return
+	}
+
+	now := time.Now()
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), registryCacheFileSuffix) {
+			continue
+		}
+		path := filepath.Join(c.dir, file.Name())
+		entry, err := c.readEntry(path)
+		if err != nil || entry.Key == "" || c.entryPath(entry.Key) != path {
+			c.logger.Debugf("Removing unreadable registry cache file %s: %v", path, err)
+			os.Remove(path)
+			continue
+		}
+		if entry.expired(now) && entry.ETag == "" {
+			os.Remove(path)
+			continue
+		}
+		c.entries[entry.Key] = entry
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+)
+
+// LoadCacheControlFromEnv reads MCP_CACHE_CONTROL, the Cache-Control header sent with the results of cacheable tools
+// in HTTP mode, e.g. "private, max-age=300". Empty, the default, sends no header.
+func LoadCacheControlFromEnv(logger *log.Logger) string {
+	value := strings.TrimSpace(utils.GetEnv("MCP_CACHE_CONTROL", ""))
+	if strings.ContainsAny(value, "\r\n") {
+		logger.Warnf("Invalid MCP_CACHE_CONTROL value %q, no Cache-Control header will be sent", value)
+		return ""
+	}
+	return value
+}
+
+// CacheControlMiddleware sets the Cache-Control header to cacheControl on the responses to tools/call requests of the
+// tools isCacheable reports as cacheable, such as the documentation lookups whose content rarely changes. Only
+// successful results returned as a single application/json response are marked, event streams, batches, error results
+// and all other messages are passed on unchanged.
+func CacheControlMiddleware(cacheControl string, isCacheable func(toolName string) bool, logger *log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.Body == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, "Failed to read request body", http.StatusBadRequest)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			toolName, ok := toolCallName(body)
+			if !ok || !isCacheable(toolName) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			cw := &cacheControlWriter{ResponseWriter: w}
+			next.ServeHTTP(cw, r)
+			if cw.finish(cacheControl) {
+				LoggerFromContext(r.Context(), logger).Debugf("Sent Cache-Control %q with the result of %s", cacheControl, toolName)
+			}
+		})
+	}
+}
+
+// toolCallName returns the name of the tool called by a single JSON-RPC tools/call message
+func toolCallName(body []byte) (string, bool) {
+	var message struct {
+		Method string `json:"method"`
+		Params struct {
+			Name string `json:"name"`
+		} `json:"params"`
+	}
+	if err := json.Unmarshal(body, &message); err != nil || message.Method != string(mcp.MethodToolsCall) {
+		return "", false
+	}
+	return message.Params.Name, message.Params.Name != ""
+}
+
+// cacheControlWriter holds back a 200 application/json response until the handler is done, so the Cache-Control
+// header can be added once the result is known to be successful. Any other response, in particular an upgrade to an
+// event stream, is written through as is.
+type cacheControlWriter struct {
+	http.ResponseWriter
+	status    int
+	buffering bool
+	body      bytes.Buffer
+}
+
+func (w *cacheControlWriter) WriteHeader(status int) {
+	if w.status != 0 {
+		return
+	}
+	w.status = status
+	mediaType, _, _ := mime.ParseMediaType(w.Header().Get("Content-Type"))
+	if status == http.StatusOK && mediaType == "application/json" {
+		w.buffering = true
+		return
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *cacheControlWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.buffering {
+		return w.body.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush keeps the writer usable for event streams, buffered JSON responses are written by finish
+func (w *cacheControlWriter) Flush() {
+	if w.buffering {
+		return
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// finish writes a held back response, with the Cache-Control header when it carries a successful tool result, and
+// reports whether the header was set
+func (w *cacheControlWriter) finish(cacheControl string) bool {
+	if !w.buffering {
+		return false
+	}
+	cached := isSuccessfulToolResult(w.body.Bytes())
+	if cached {
+		w.Header().Set("Cache-Control", cacheControl)
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+	_, _ = w.ResponseWriter.Write(w.body.Bytes())
+	return cached
+}
+
+// isSuccessfulToolResult reports whether a JSON-RPC response carries a tool result that is not an error
+func isSuccessfulToolResult(body []byte) bool {
+	var response struct {
+		Result *struct {
+			IsError bool `json:"isError"`
+		} `json:"result"`
+		Error json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return false
+	}
+	return response.Result != nil && !response.Result.IsError && len(response.Error) == 0
+}
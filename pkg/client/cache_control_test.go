@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheControlMiddleware(t *testing.T) {
+	const cacheControl = "private, max-age=300"
+	isCacheable := func(toolName string) bool { return toolName == "get_provider_details" }
+	handler := CacheControlMiddleware(cacheControl, isCacheable, logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.RawQuery, "stream") {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("event: message\ndata: {}\n\n"))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if strings.Contains(r.URL.RawQuery, "error") {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"content":[],"isError":true}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"content":[]}}`))
+	}))
+
+	tests := []struct {
+		name     string
+		query    string
+		body     string
+		expected string
+	}{
+		{name: "cacheable tool", body: `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"get_provider_details"}}`, expected: cacheControl},
+		{name: "uncacheable tool", body: `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"list_workspaces"}}`},
+		{name: "error result", query: "error", body: `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"get_provider_details"}}`},
+		{name: "event stream", query: "stream", body: `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"get_provider_details"}}`},
+		{name: "other method", body: `{"jsonrpc":"2.0","id":1,"method":"tools/list"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/mcp?"+tt.query, strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tt.expected, rec.Header().Get("Cache-Control"))
+			assert.NotEmpty(t, rec.Body.String())
+		})
+	}
+}
+
+func TestLoadCacheControlFromEnv(t *testing.T) {
+	t.Setenv("MCP_CACHE_CONTROL", " public, max-age=600 ")
+	assert.Equal(t, "public, max-age=600", LoadCacheControlFromEnv(logger))
+
+	t.Setenv("MCP_CACHE_CONTROL", "max-age=600\r\nX-Injected: 1")
+	assert.Equal(t, "", LoadCacheControlFromEnv(logger))
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryCacheSetGet(t *testing.T) {
+	cache := NewRegistryCache(time.Minute, "", logger)
+
+	_, ok := cache.Get("https://registry.terraform.io/v1/providers/hashicorp/aws")
+	assert.False(t, ok)
+
+	cache.Set("https://registry.terraform.io/v1/providers/hashicorp/aws", []byte(`{"id":"aws"}`), `"abc"`)
+	body, ok := cache.Get("https://registry.terraform.io/v1/providers/hashicorp/aws")
+	require.True(t, ok)
+	assert.Equal(t, `{"id":"aws"}`, string(body))
+}
+
+func TestRegistryCacheExpiry(t *testing.T) {
+	cache := NewRegistryCache(time.Millisecond, "", logger)
+	cache.Set("key", []byte("value"), "")
+	time.Sleep(5 * time.Millisecond)
+
+	_, ok := cache.Get("key")
+	assert.False(t, ok)
+}
+
+func TestRegistryCachePersistsAcrossInstances(t *testing.T) {
+	dir := t.TempDir()
+
+	first := NewRegistryCache(time.Minute, dir, logger)
+	first.Set("key", []byte("value"), `"etag"`)
+
+	second := NewRegistryCache(time.Minute, dir, logger)
+	body, ok := second.Get("key")
+	require.True(t, ok)
+	assert.Equal(t, "value", string(body))
+
+	entry, ok := second.lookup("key")
+	require.True(t, ok)
+	assert.Equal(t, `"etag"`, entry.ETag)
+}
+
+func TestRegistryCacheSkipsExpiredAndCorruptFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	expired := NewRegistryCache(time.Millisecond, dir, logger)
+	expired.Set("expired", []byte("value"), "")
+	expiredPath := expired.entryPath("expired")
+	time.Sleep(5 * time.Millisecond)
+
+	corruptPath := filepath.Join(dir, "corrupt.json")
+	require.NoError(t, os.WriteFile(corruptPath, []byte("{not json"), 0o600))
+
+	cache := NewRegistryCache(time.Minute, dir, logger)
+	_, ok := cache.Get("expired")
+	assert.False(t, ok)
+	assert.Empty(t, cache.entries)
+
+	assert.NoFileExists(t, expiredPath)
+	assert.NoFileExists(t, corruptPath)
+
+	// A corrupted entry for a key is treated as a miss and replaced on the next Set
+	require.NoError(t, os.WriteFile(cache.entryPath("key"), []byte("garbage"), 0o600))
+	_, ok = cache.Get("key")
+	assert.False(t, ok)
+
+	cache.Set("key", []byte("fresh"), "")
+	body, ok := NewRegistryCache(time.Minute, dir, logger).Get("key")
+	require.True(t, ok)
+	assert.Equal(t, "fresh", string(body))
+}
+
+func TestRegistryCacheRevalidation(t *testing.T) {
+	cache := NewRegistryCache(time.Millisecond, "", logger)
+	cache.Set("with-etag", []byte("value"), `"v1"`)
+	cache.Set("without-etag", []byte("value"), "")
+
+	_, ok := cache.Revalidation("with-etag")
+	assert.False(t, ok, "fresh entries are served without revalidation")
+
+	time.Sleep(5 * time.Millisecond)
+	entry, ok := cache.Revalidation("with-etag")
+	require.True(t, ok)
+	assert.Equal(t, `"v1"`, entry.ETag)
+	assert.Equal(t, "value", string(entry.Body))
+
+	_, ok = cache.Revalidation("without-etag")
+	assert.False(t, ok)
+	_, ok = cache.Revalidation("missing")
+	assert.False(t, ok)
+}
+
+func TestRegistryCacheKeepsExpiredFilesWithETag(t *testing.T) {
+	dir := t.TempDir()
+
+	first := NewRegistryCache(time.Millisecond, dir, logger)
+	first.Set("key", []byte("value"), `"v1"`)
+	time.Sleep(5 * time.Millisecond)
+
+	second := NewRegistryCache(time.Minute, dir, logger)
+	_, ok := second.Get("key")
+	assert.False(t, ok)
+	entry, ok := second.Revalidation("key")
+	require.True(t, ok)
+	assert.Equal(t, "value", string(entry.Body))
+}
+
+func TestRegistryCacheBoundsEntries(t *testing.T) {
+	cache := NewRegistryCache(time.Minute, "", logger)
+	cache.entries["expired"] = registryCacheEntry{Key: "expired", Body: []byte("old"), ExpiresAt: time.Now().Add(-time.Minute)}
+	for i := 0; len(cache.entries) < maxRegistryCacheEntries; i++ {
+		cache.Set(fmt.Sprintf("key-%d", i), []byte("value"), "")
+	}
+
+	cache.Set("new", []byte("value"), "")
+	assert.Len(t, cache.entries, maxRegistryCacheEntries)
+	assert.NotContains(t, cache.entries, "expired")
+
+	cache.Set("newer", []byte("value"), "")
+	assert.Len(t, cache.entries, maxRegistryCacheEntries)
+	assert.Contains(t, cache.entries, "newer")
+}
+
+func TestRegistryCacheDropsExpiredEntriesWithoutETag(t *testing.T) {
+	cache := NewRegistryCache(time.Millisecond, "", logger)
+	cache.Set("plain", []byte("value"), "")
+	cache.Set("tagged", []byte("value"), `"abc"`)
+	time.Sleep(5 * time.Millisecond)
+
+	_, ok := cache.Get("plain")
+	assert.False(t, ok)
+	_, ok = cache.Get("tagged")
+	assert.False(t, ok)
+	assert.NotContains(t, cache.entries, "plain")
+	assert.Contains(t, cache.entries, "tagged")
+}
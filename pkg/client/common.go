@@ -5,7 +5,6 @@ package client
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -14,15 +13,15 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-func GetLatestProviderVersion(httpClient *http.Client, providerNamespace string, providerName string, logger *log.Logger) (string, error) {
+func GetLatestProviderVersion(ctx context.Context, httpClient *http.Client, providerNamespace string, providerName string, logger *log.Logger) (string, error) {
 	uri := fmt.Sprintf("providers/%s/%s", providerNamespace, providerName)
-	jsonData, err := SendRegistryCall(httpClient, "GET", uri, logger, "v1")
+	jsonData, err := SendRegistryCall(ctx, httpClient, "GET", uri, logger, RegistryAPIVersions().Providers)
 	if err != nil {
 		return "", utils.LogAndReturnError(logger, "making the latest provider version API request", err)
 	}
 
 	var providerVersionLatest ProviderVersionLatest
-	if err := json.Unmarshal(jsonData, &providerVersionLatest); err != nil {
+	if err := DecodeRegistryResponse(jsonData, &providerVersionLatest); err != nil {
 		return "", utils.LogAndReturnError(logger, "unmarshalling provider versions request", err)
 	}
 
@@ -30,41 +29,104 @@ func GetLatestProviderVersion(httpClient *http.Client, providerNamespace string,
 	return providerVersionLatest.Version, nil
 }
 
+// GetLatestProviderRelease returns the latest published release of a provider, including its publish date.
+// Pre-release versions (e.g. 6.0.0-beta1) are skipped unless includePrerelease is set.
+func GetLatestProviderRelease(ctx context.Context, httpClient *http.Client, providerNamespace string, providerName string, includePrerelease bool, logger *log.Logger) (ProviderVersionLatest, error) {
+	uri := fmt.Sprintf("providers/%s/%s", providerNamespace, providerName)
+	jsonData, err := SendRegistryCall(ctx, httpClient, "GET", uri, logger, RegistryAPIVersions().Providers)
+	if err != nil {
+		return ProviderVersionLatest{}, utils.LogAndReturnError(logger, "making the latest provider version API request", err)
+	}
+
+	var providerVersionLatest ProviderVersionLatest
+	if err := DecodeRegistryResponse(jsonData, &providerVersionLatest); err != nil {
+		return ProviderVersionLatest{}, utils.LogAndReturnError(logger, "unmarshalling provider versions request", err)
+	}
+
+	latest, err := utils.LatestVersion(providerVersionLatest.Versions, includePrerelease)
+	if err != nil {
+		return ProviderVersionLatest{}, fmt.Errorf("provider %s/%s: %w", providerNamespace, providerName, err)
+	}
+	if latest == providerVersionLatest.Version {
+		return providerVersionLatest, nil
+	}
+
+	// The newest version is not the one the registry reports as latest (e.g. a pre-release), fetch its own release details
+	uri = fmt.Sprintf("providers/%s/%s/%s", providerNamespace, providerName, latest)
+	jsonData, err = SendRegistryCall(ctx, httpClient, "GET", uri, logger, RegistryAPIVersions().Providers)
+	if err != nil {
+		return ProviderVersionLatest{}, utils.LogAndReturnError(logger, fmt.Sprintf("fetching provider version %s", latest), err)
+	}
+	var release ProviderVersionLatest
+	if err := DecodeRegistryResponse(jsonData, &release); err != nil {
+		return ProviderVersionLatest{}, utils.LogAndReturnError(logger, "unmarshalling provider version request", err)
+	}
+
+	logger.Debugf("Fetched latest provider release: %s", release.Version)
+	return release, nil
+}
+
+// GetProviderVersionsInRange returns the published versions of a provider between from and to (inclusive), oldest first.
+// Pre-release versions are skipped unless they are one of the bounds.
+func GetProviderVersionsInRange(ctx context.Context, httpClient *http.Client, providerNamespace string, providerName string, from string, to string, logger *log.Logger) ([]string, error) {
+	uri := fmt.Sprintf("providers/%s/%s", providerNamespace, providerName)
+	jsonData, err := SendRegistryCall(ctx, httpClient, "GET", uri, logger, RegistryAPIVersions().Providers)
+	if err != nil {
+		return nil, utils.LogAndReturnError(logger, "making the provider versions API request", err)
+	}
+
+	var providerVersionLatest ProviderVersionLatest
+	if err := DecodeRegistryResponse(jsonData, &providerVersionLatest); err != nil {
+		return nil, utils.LogAndReturnError(logger, "unmarshalling provider versions request", err)
+	}
+
+	versions, err := utils.VersionsInRange(providerVersionLatest.Versions, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("provider %s/%s: %w", providerNamespace, providerName, err)
+	}
+	return versions, nil
+}
+
 // Every provider version has a unique ID, which is used to identify the provider version in the registry and its specific documentation
 // https://registry.terraform.io/v2/providers/hashicorp/aws?include=provider-versions
-func GetProviderVersionID(httpClient *http.Client, namespace string, name string, version string, logger *log.Logger) (string, error) {
+func GetProviderVersionID(ctx context.Context, httpClient *http.Client, namespace string, name string, version string, logger *log.Logger) (string, error) {
 	uri := fmt.Sprintf("providers/%s/%s?include=provider-versions", namespace, name)
-	response, err := SendRegistryCall(httpClient, "GET", uri, logger, "v2")
+	response, err := SendRegistryCall(ctx, httpClient, "GET", uri, logger, RegistryAPIVersions().ProviderDocs)
 	if err != nil {
 		return "", utils.LogAndReturnError(logger, "making provider version ID request", err)
 	}
 	var providerVersionList ProviderVersionList
-	if err := json.Unmarshal(response, &providerVersionList); err != nil {
+	if err := DecodeRegistryResponse(response, &providerVersionList); err != nil {
 		return "", utils.LogAndReturnError(logger, "unmarshalling provider version ID request", err)
 	}
+	// Compare normalized versions so "v5.0.0" or "5.0" find the published "5.0.0"
+	target, err := utils.NormalizeVersion(version)
+	if err != nil {
+		return "", fmt.Errorf("provider version %s: %w", version, err)
+	}
 	for _, providerVersion := range providerVersionList.Included {
-		if providerVersion.Attributes.Version == version {
+		if published, err := utils.NormalizeVersion(providerVersion.Attributes.Version); err == nil && published == target {
 			return providerVersion.ID, nil
 		}
 	}
-	return "", fmt.Errorf("provider version %s not found", version)
+	return "", fmt.Errorf("provider version %s: %w", version, ErrRegistryNotFound)
 }
 
-func GetProviderOverviewDocs(httpClient *http.Client, providerVersionID string, logger *log.Logger) (string, error) {
+func GetProviderOverviewDocs(ctx context.Context, httpClient *http.Client, providerVersionID string, logger *log.Logger) (string, error) {
 	// https://registry.terraform.io/v2/provider-docs?filter[provider-version]=21818&filter[category]=overview&filter[slug]=index
 	uri := fmt.Sprintf("provider-docs?filter[provider-version]=%s&filter[category]=overview&filter[slug]=index", providerVersionID)
-	response, err := SendRegistryCall(httpClient, "GET", uri, logger, "v2")
+	response, err := SendRegistryCall(ctx, httpClient, "GET", uri, logger, RegistryAPIVersions().ProviderDocs)
 	if err != nil {
 		return "", utils.LogAndReturnError(logger, "getting provider docs overview", err)
 	}
 	var providerOverview ProviderOverviewStruct
-	if err := json.Unmarshal(response, &providerOverview); err != nil {
+	if err := DecodeRegistryResponse(response, &providerOverview); err != nil {
 		return "", utils.LogAndReturnError(logger, "getting provider docs request unmarshalling", err)
 	}
 
 	resourceContent := ""
 	for _, providerOverviewPage := range providerOverview.Data {
-		resourceContentNew, err := GetProviderResourceDocs(httpClient, providerOverviewPage.ID, logger)
+		resourceContentNew, err := GetProviderResourceDocs(ctx, httpClient, providerOverviewPage.ID, logger)
 		resourceContent += resourceContentNew
 		if err != nil {
 			return "", utils.LogAndReturnError(logger, "getting provider resource docs looping", err)
@@ -74,15 +136,15 @@ func GetProviderOverviewDocs(httpClient *http.Client, providerVersionID string,
 	return resourceContent, nil
 }
 
-func GetProviderResourceDocs(httpClient *http.Client, providerDocsID string, logger *log.Logger) (string, error) {
+func GetProviderResourceDocs(ctx context.Context, httpClient *http.Client, providerDocsID string, logger *log.Logger) (string, error) {
 	// https://registry.terraform.io/v2/provider-docs/8862001
 	uri := fmt.Sprintf("provider-docs/%s", providerDocsID)
-	response, err := SendRegistryCall(httpClient, "GET", uri, logger, "v2")
+	response, err := SendRegistryCall(ctx, httpClient, "GET", uri, logger, RegistryAPIVersions().ProviderDocs)
 	if err != nil {
 		return "", utils.LogAndReturnError(logger, "getting provider resource docs ", err)
 	}
 	var providerServiceDetails ProviderResourceDetails
-	if err := json.Unmarshal(response, &providerServiceDetails); err != nil {
+	if err := DecodeRegistryResponse(response, &providerServiceDetails); err != nil {
 		return "", utils.LogAndReturnError(logger, "unmarshalling provider resource docs", err)
 	}
 	return providerServiceDetails.Data.Attributes.Content, nil
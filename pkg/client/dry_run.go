@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrDryRun is returned instead of a registry or TFE client to the handler of a dry run, which stops there
+var ErrDryRun = errors.New("dry run, the tool is not run")
+
+// dryRunKey is the context key of the DryRunProbe of a dry run
+const dryRunKey = contextKey("dry-run")
+
+// DryRunProbe records whether the handler of a dry run got past its own argument checks, up to its first network
+// access
+type DryRunProbe struct {
+	reached atomic.Bool
+}
+
+// Reached reports whether the handler asked for a registry or TFE client
+func (p *DryRunProbe) Reached() bool {
+	return p.reached.Load()
+}
+
+// WithDryRun returns a context under which handlers get ErrDryRun instead of a registry or TFE client, so a dry run
+// goes through the argument checks of the handler without any network access. The logs of the handler are
+// discarded, they would report the stopped call as a failure.
+func WithDryRun(ctx context.Context) (context.Context, *DryRunProbe) {
+	probe := &DryRunProbe{}
+	quiet := log.New()
+	quiet.SetOutput(io.Discard)
+	ctx = context.WithValue(ctx, dryRunKey, probe)
+	return context.WithValue(ctx, loggerKey, quiet), probe
+}
+
+// stopDryRun returns ErrDryRun when ctx belongs to a dry run, recording that the handler reached the network
+func stopDryRun(ctx context.Context) error {
+	if probe, ok := ctx.Value(dryRunKey).(*DryRunProbe); ok {
+		probe.reached.Store(true)
+		return ErrDryRun
+	}
+	return nil
+}
@@ -14,12 +14,33 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+const (
+	// DefaultCORSAllowedMethods are the methods advertised in CORS responses when MCP_CORS_ALLOWED_METHODS is unset
+	DefaultCORSAllowedMethods = "GET, POST, OPTIONS"
+	// DefaultCORSAllowedHeaders are the headers advertised in CORS responses when MCP_CORS_ALLOWED_HEADERS is unset
+	DefaultCORSAllowedHeaders = "Content-Type, Mcp-Session-Id, Authorization, X-Request-ID"
+)
+
 // CORSConfig holds CORS configuration
 type CORSConfig struct {
 	AllowedOrigins []string
+	AllowedMethods []string
+	AllowedHeaders []string
 	Mode           string // "strict", "development", "disabled"
 }
 
+// splitCommaList splits a comma separated list and trims surrounding whitespace, dropping empty entries
+func splitCommaList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 // LoadCORSConfigFromEnv loads CORS configuration from environment variables
 func LoadCORSConfigFromEnv() CORSConfig {
 	originsStr := os.Getenv("MCP_ALLOWED_ORIGINS")
@@ -39,8 +60,13 @@ func LoadCORSConfigFromEnv() CORSConfig {
 		}
 	}
 
+	methods := splitCommaList(utils.GetEnv("MCP_CORS_ALLOWED_METHODS", DefaultCORSAllowedMethods))
+	headers := splitCommaList(utils.GetEnv("MCP_CORS_ALLOWED_HEADERS", DefaultCORSAllowedHeaders))
+
 	return CORSConfig{
 		AllowedOrigins: origins,
+		AllowedMethods: methods,
+		AllowedHeaders: headers,
 		Mode:           mode,
 	}
 }
@@ -78,6 +104,8 @@ func isOriginAllowed(origin string, allowedOrigins []string, mode string) bool {
 type securityHandler struct {
 	handler        http.Handler
 	allowedOrigins []string
+	allowedMethods string
+	allowedHeaders string
 	corsMode       string
 	logger         *log.Logger
 }
@@ -99,8 +127,9 @@ func (h *securityHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		// If we have a valid origin, add CORS headers
 		w.Header().Set("Access-Control-Max-Age", "3600")
 		w.Header().Set("Access-Control-Allow-Origin", origin)
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Mcp-Session-Id, Authorization")
+		w.Header().Set("Access-Control-Allow-Methods", h.allowedMethods)
+		w.Header().Set("Access-Control-Allow-Headers", h.allowedHeaders)
+		w.Header().Set("Access-Control-Expose-Headers", RequestIDHeader)
 	}
 
 	// Handle OPTIONS requests for CORS preflight
@@ -114,12 +143,32 @@ func (h *securityHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	h.handler.ServeHTTP(w, r)
 }
 
-// NewSecurityHandler creates a new security handler
+// NewSecurityHandler creates a new security handler using the default CORS methods and headers
 func NewSecurityHandler(handler http.Handler, allowedOrigins []string, corsMode string, logger *log.Logger) http.Handler {
+	return NewSecurityHandlerWithConfig(handler, CORSConfig{
+		AllowedOrigins: allowedOrigins,
+		Mode:           corsMode,
+	}, logger)
+}
+
+// NewSecurityHandlerWithConfig creates a new security handler from a full CORS configuration.
+// Empty method or header lists fall back to the defaults.
+func NewSecurityHandlerWithConfig(handler http.Handler, config CORSConfig, logger *log.Logger) http.Handler {
+	methods := strings.Join(config.AllowedMethods, ", ")
+	if methods == "" {
+		methods = DefaultCORSAllowedMethods
+	}
+	headers := strings.Join(config.AllowedHeaders, ", ")
+	if headers == "" {
+		headers = DefaultCORSAllowedHeaders
+	}
+
 	return &securityHandler{
 		handler:        handler,
-		allowedOrigins: allowedOrigins,
-		corsMode:       corsMode,
+		allowedOrigins: config.AllowedOrigins,
+		allowedMethods: methods,
+		allowedHeaders: headers,
+		corsMode:       config.Mode,
 		logger:         logger,
 	}
 }
@@ -140,6 +140,28 @@ func TestLoadCORSConfigFromEnv(t *testing.T) {
 	assert.Equal(t, []string{"https://example.com", "https://test.com"}, config.AllowedOrigins)
 }
 
+func TestLoadCORSConfigFromEnvMethodsAndHeaders(t *testing.T) {
+	origMethods := os.Getenv("MCP_CORS_ALLOWED_METHODS")
+	origHeaders := os.Getenv("MCP_CORS_ALLOWED_HEADERS")
+	defer func() {
+		os.Setenv("MCP_CORS_ALLOWED_METHODS", origMethods)
+		os.Setenv("MCP_CORS_ALLOWED_HEADERS", origHeaders)
+	}()
+
+	// Defaults match the values previously hardcoded in the security handler, plus the request ID header
+	os.Unsetenv("MCP_CORS_ALLOWED_METHODS")
+	os.Unsetenv("MCP_CORS_ALLOWED_HEADERS")
+	config := LoadCORSConfigFromEnv()
+	assert.Equal(t, []string{"GET", "POST", "OPTIONS"}, config.AllowedMethods)
+	assert.Equal(t, []string{"Content-Type", "Mcp-Session-Id", "Authorization", "X-Request-ID"}, config.AllowedHeaders)
+
+	os.Setenv("MCP_CORS_ALLOWED_METHODS", "GET, POST, DELETE, OPTIONS")
+	os.Setenv("MCP_CORS_ALLOWED_HEADERS", "Content-Type, Mcp-Session-Id, Mcp-Protocol-Version, ,")
+	config = LoadCORSConfigFromEnv()
+	assert.Equal(t, []string{"GET", "POST", "DELETE", "OPTIONS"}, config.AllowedMethods)
+	assert.Equal(t, []string{"Content-Type", "Mcp-Session-Id", "Mcp-Protocol-Version"}, config.AllowedHeaders)
+}
+
 // TestSecurityHandler tests the HTTP handler that applies CORS validation logic
 // to incoming requests. This test verifies the complete request handling flow,
 // including origin validation and response generation.
@@ -316,6 +338,63 @@ func TestOptionsRequest(t *testing.T) {
 	assert.NotEmpty(t, rr.Header().Get("Access-Control-Allow-Methods"))
 }
 
+// TestOptionsRequestWithConfig verifies that preflight responses advertise the
+// configured methods and headers, and fall back to the defaults when unset.
+func TestOptionsRequestWithConfig(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	mockHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Mock handler should not be called for OPTIONS request")
+	})
+
+	tests := []struct {
+		name            string
+		config          CORSConfig
+		expectedMethods string
+		expectedHeaders string
+	}{
+		{
+			name: "custom methods and headers",
+			config: CORSConfig{
+				AllowedOrigins: []string{"https://example.com"},
+				AllowedMethods: []string{"GET", "POST", "DELETE", "OPTIONS"},
+				AllowedHeaders: []string{"Content-Type", "Mcp-Protocol-Version"},
+				Mode:           "strict",
+			},
+			expectedMethods: "GET, POST, DELETE, OPTIONS",
+			expectedHeaders: "Content-Type, Mcp-Protocol-Version",
+		},
+		{
+			name: "defaults when unset",
+			config: CORSConfig{
+				AllowedOrigins: []string{"https://example.com"},
+				Mode:           "strict",
+			},
+			expectedMethods: DefaultCORSAllowedMethods,
+			expectedHeaders: DefaultCORSAllowedHeaders,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewSecurityHandlerWithConfig(mockHandler, tt.config, logger)
+
+			req := httptest.NewRequest(http.MethodOptions, "/mcp", nil)
+			req.Header.Set("Origin", "https://example.com")
+			req.Header.Set("Access-Control-Request-Method", "POST")
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, "https://example.com", rr.Header().Get("Access-Control-Allow-Origin"))
+			assert.Equal(t, tt.expectedMethods, rr.Header().Get("Access-Control-Allow-Methods"))
+			assert.Equal(t, tt.expectedHeaders, rr.Header().Get("Access-Control-Allow-Headers"))
+		})
+	}
+}
+
 // TestGetTokenFromAuthHeader tests the helper function that extracts token from Authorization Bearer header
 func TestGetTokenFromAuthHeader(t *testing.T) {
 	tests := []struct {
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// OutputFormat is the format tool results are returned in
+type OutputFormat string
+
+const (
+	// OutputFormatMarkdown returns tool results as the tools format them, markdown text for most tools
+	OutputFormatMarkdown OutputFormat = "markdown"
+	// OutputFormatJSON returns tool results as a JSON document, for clients that parse results rather than read them
+	OutputFormatJSON OutputFormat = "json"
+)
+
+// OutputFormatArgument is the tool call argument overriding the output format of the server for one call
+const OutputFormatArgument = "format"
+
+// OutputFormatToolOption declares the "format" argument in the input schema of a tool
+func OutputFormatToolOption() mcp.ToolOption {
+	return mcp.WithString(OutputFormatArgument,
+		mcp.Description("The format of the result: 'markdown' text to read, or 'json' for a JSON document with the typed data of the result. Defaults to the OUTPUT_FORMAT of the server"),
+		mcp.Enum(string(OutputFormatMarkdown), string(OutputFormatJSON)),
+	)
+}
+
+// parseOutputFormat parses an output format, case-insensitively
+func parseOutputFormat(value string) (OutputFormat, bool) {
+	switch format := OutputFormat(strings.ToLower(strings.TrimSpace(value))); format {
+	case OutputFormatMarkdown, OutputFormatJSON:
+		return format, true
+	}
+	return "", false
+}
+
+// LoadOutputFormatFromEnv reads OUTPUT_FORMAT, "markdown" (default) or "json"
+func LoadOutputFormatFromEnv(logger *log.Logger) OutputFormat {
+	value := strings.TrimSpace(utils.GetEnv("OUTPUT_FORMAT", ""))
+	if value == "" {
+		return OutputFormatMarkdown
+	}
+	format, ok := parseOutputFormat(value)
+	if !ok {
+		logger.Warnf("Invalid OUTPUT_FORMAT value %q, using default %s", value, OutputFormatMarkdown)
+		return OutputFormatMarkdown
+	}
+	return format
+}
+
+// jsonToolOutput is the JSON document of a tool result, the same envelope for successes and errors. Data holds the
+// typed data of a successful result: the value the tool formatted its text from, its structured content or the JSON
+// it returned as text. Data of a failed result is {"code": ..., "message": ...}. A result that only has text, e.g.
+// after being truncated or offloaded to a download, has {"text": ...} as data.
+type jsonToolOutput struct {
+	Tool    string `json:"tool"`
+	IsError bool   `json:"is_error"`
+	Data    any    `json:"data"`
+}
+
+// jsonTextData is the data of a result that only has text
+type jsonTextData struct {
+	Text string `json:"text"`
+}
+
+// jsonErrorData is the data of a failed result without an error code
+type jsonErrorData struct {
+	Message string `json:"message"`
+}
+
+// OutputFormatMiddleware returns the results of tool calls in the JSON format when it is the default format of the
+// server, or when a call asks for it with the "format" argument ("markdown" or "json"). Markdown results are returned
+// without the typed data carried for the JSON format.
+func OutputFormatMiddleware(defaultFormat OutputFormat, logger *log.Logger) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			format := defaultFormat
+			if value := request.GetString(OutputFormatArgument, ""); value != "" {
+				var ok bool
+				if format, ok = parseOutputFormat(value); !ok {
+					return utils.NewErrorResult(utils.ErrorCodeInvalidArgument, fmt.Sprintf("invalid argument 'format': must be '%s' or '%s'", OutputFormatMarkdown, OutputFormatJSON)), nil
+				}
+			}
+
+			result, err := next(ctx, request)
+			if err != nil || result == nil {
+				return result, err
+			}
+			if format != OutputFormatJSON {
+				utils.TakeResultData(result)
+				return result, nil
+			}
+			return jsonToolResult(request.Params.Name, result, logger), nil
+		}
+	}
+}
+
+// jsonToolResult replaces the text of a result with its JSON document, keeping content that is not text (e.g.
+// resource links) after it
+func jsonToolResult(toolName string, result *mcp.CallToolResult, logger *log.Logger) *mcp.CallToolResult {
+	output := jsonToolOutput{Tool: toolName, IsError: result.IsError}
+	data, hasData := utils.TakeResultData(result)
+	var texts []string
+	var others []mcp.Content
+	for _, content := range result.Content {
+		if text, ok := content.(mcp.TextContent); ok {
+			texts = append(texts, text.Text)
+			continue
+		}
+		others = append(others, content)
+	}
+	text := strings.Join(texts, "\n")
+
+	switch {
+	case hasData && !result.IsError:
+		output.Data = data
+	case result.StructuredContent != nil:
+		output.Data = result.StructuredContent
+	case json.Valid([]byte(strings.TrimSpace(text))) && strings.TrimSpace(text) != "":
+		output.Data = json.RawMessage(strings.TrimSpace(text))
+	case result.IsError:
+		output.Data = jsonErrorData{Message: text}
+	default:
+		output.Data = jsonTextData{Text: text}
+	}
+
+	document, err := json.Marshal(output)
+	if err != nil {
+		logger.Warnf("Failed to encode the result of %s as JSON, returning it unchanged: %v", toolName, err)
+		return result
+	}
+	result.Content = append([]mcp.Content{mcp.NewTextContent(string(document))}, others...)
+	result.StructuredContent = output
+	return result
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadOutputFormatFromEnv(t *testing.T) {
+	t.Setenv("OUTPUT_FORMAT", "")
+	assert.Equal(t, OutputFormatMarkdown, LoadOutputFormatFromEnv(logger))
+
+	t.Setenv("OUTPUT_FORMAT", " JSON ")
+	assert.Equal(t, OutputFormatJSON, LoadOutputFormatFromEnv(logger))
+
+	t.Setenv("OUTPUT_FORMAT", "yaml")
+	assert.Equal(t, OutputFormatMarkdown, LoadOutputFormatFromEnv(logger))
+}
+
+func TestOutputFormatMiddleware(t *testing.T) {
+	call := func(defaultFormat OutputFormat, result *mcp.CallToolResult, arguments map[string]any) *mcp.CallToolResult {
+		handler := OutputFormatMiddleware(defaultFormat, logger)(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return result, nil
+		})
+		request := mcp.CallToolRequest{}
+		request.Params.Name = "get_provider_details"
+		request.Params.Arguments = arguments
+		out, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		return out
+	}
+	decode := func(result *mcp.CallToolResult) map[string]any {
+		require.NotEmpty(t, result.Content)
+		var output map[string]any
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &output))
+		return output
+	}
+
+	t.Run("markdown results unchanged", func(t *testing.T) {
+		result := call(OutputFormatMarkdown, mcp.NewToolResultText("# Docs"), nil)
+		assert.Equal(t, "# Docs", result.Content[0].(mcp.TextContent).Text)
+		assert.Nil(t, result.StructuredContent)
+	})
+
+	t.Run("markdown results without their typed data", func(t *testing.T) {
+		result := call(OutputFormatMarkdown, utils.NewToolResultData("# Docs", map[string]any{"title": "Docs"}), nil)
+		assert.Equal(t, "# Docs", result.Content[0].(mcp.TextContent).Text)
+		assert.Nil(t, result.Meta)
+	})
+
+	t.Run("typed data as data", func(t *testing.T) {
+		output := decode(call(OutputFormatJSON, utils.NewToolResultData("# Docs", map[string]any{"title": "Docs"}), nil))
+		assert.Equal(t, map[string]any{"tool": "get_provider_details", "is_error": false, "data": map[string]any{"title": "Docs"}}, output)
+	})
+
+	t.Run("text only as text data", func(t *testing.T) {
+		output := decode(call(OutputFormatJSON, mcp.NewToolResultText("# Docs"), nil))
+		assert.Equal(t, map[string]any{"tool": "get_provider_details", "is_error": false, "data": map[string]any{"text": "# Docs"}}, output)
+	})
+
+	t.Run("structured content as data", func(t *testing.T) {
+		result := mcp.NewToolResultStructured(map[string]any{"version": "1.0.0"}, "Version: 1.0.0")
+		output := decode(call(OutputFormatJSON, result, nil))
+		assert.Equal(t, map[string]any{"version": "1.0.0"}, output["data"])
+	})
+
+	t.Run("JSON text as data", func(t *testing.T) {
+		output := decode(call(OutputFormatJSON, mcp.NewToolResultText(`{"id": "ws-1"}`), nil))
+		assert.Equal(t, map[string]any{"id": "ws-1"}, output["data"])
+	})
+
+	t.Run("errors keep their code", func(t *testing.T) {
+		result := call(OutputFormatJSON, utils.NewErrorResult(utils.ErrorCodeNotFound, "provider not found"), nil)
+		assert.True(t, result.IsError)
+		output := decode(result)
+		assert.Equal(t, true, output["is_error"])
+		assert.Equal(t, map[string]any{"code": "NOT_FOUND", "message": "provider not found"}, output["data"])
+
+		output = decode(call(OutputFormatJSON, mcp.NewToolResultError("failed"), nil))
+		assert.Equal(t, map[string]any{"message": "failed"}, output["data"])
+	})
+
+	t.Run("per-call format overrides the default", func(t *testing.T) {
+		output := decode(call(OutputFormatMarkdown, mcp.NewToolResultText("# Docs"), map[string]any{"format": "json"}))
+		assert.Equal(t, map[string]any{"text": "# Docs"}, output["data"])
+
+		result := call(OutputFormatJSON, mcp.NewToolResultText("# Docs"), map[string]any{"format": "markdown"})
+		assert.Equal(t, "# Docs", result.Content[0].(mcp.TextContent).Text)
+	})
+
+	t.Run("invalid per-call format", func(t *testing.T) {
+		result := call(OutputFormatMarkdown, mcp.NewToolResultText("# Docs"), map[string]any{"format": "yaml"})
+		assert.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "invalid argument 'format'")
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+)
+
+// defaultRegistryParallelCalls is the number of concurrent registry calls of a tool unless TERRAFORM_REGISTRY_PARALLEL_CALLS is set
+const defaultRegistryParallelCalls = 4
+
+// ParallelResult is the outcome of one call made by ParallelRegistryCalls
+type ParallelResult[R any] struct {
+	Value R
+	Err   error
+}
+
+// RegistryParallelCalls returns the number of registry calls a tool may have in flight at once, read from
+// TERRAFORM_REGISTRY_PARALLEL_CALLS
+func RegistryParallelCalls() int {
+	value := strings.TrimSpace(utils.GetEnv("TERRAFORM_REGISTRY_PARALLEL_CALLS", ""))
+	if workers, err := strconv.Atoi(value); err == nil && workers > 0 {
+		return workers
+	}
+	return defaultRegistryParallelCalls
+}
+
+// ParallelRegistryCalls calls fetch for every item with at most workers calls in flight, RegistryParallelCalls when
+// workers is not positive, and returns the results in the order of the items. A failed call does not stop the others.
+func ParallelRegistryCalls[T any, R any](items []T, workers int, fetch func(T) (R, error)) []ParallelResult[R] {
+	results := make([]ParallelResult[R], len(items))
+	if workers <= 0 {
+		workers = RegistryParallelCalls()
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(workers, len(items)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				value, err := fetch(items[i])
+				results[i] = ParallelResult[R]{Value: value, Err: err}
+			}
+		}()
+	}
+	for i := range items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return results
+}
+
+// ParallelErrors joins the errors of the failed calls, nil when every call succeeded
+func ParallelErrors[R any](results []ParallelResult[R]) error {
+	var errs []error
+	for _, result := range results {
+		if result.Err != nil {
+			errs = append(errs, result.Err)
+		}
+	}
+	return errors.Join(errs...)
+}
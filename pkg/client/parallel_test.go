@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParallelRegistryCalls(t *testing.T) {
+	items := make([]int, 20)
+	for i := range items {
+		items[i] = i
+	}
+
+	var inFlight, maxInFlight atomic.Int32
+	results := ParallelRegistryCalls(items, 3, func(item int) (string, error) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			observed := maxInFlight.Load()
+			if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+		time.Sleep(2 * time.Millisecond)
+		if item%5 == 0 {
+			return "", fmt.Errorf("item %d failed", item)
+		}
+		return fmt.Sprintf("item %d", item), nil
+	})
+
+	require.Len(t, results, len(items))
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(3), "expected at most 3 concurrent calls")
+	assert.Greater(t, maxInFlight.Load(), int32(1), "expected the calls to run concurrently")
+	for i, result := range results {
+		if i%5 == 0 {
+			assert.EqualError(t, result.Err, fmt.Sprintf("item %d failed", i))
+		} else {
+			require.NoError(t, result.Err)
+			assert.Equal(t, fmt.Sprintf("item %d", i), result.Value, "expected results in the order of the items")
+		}
+	}
+
+	err := ParallelErrors(results)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "item 0 failed")
+	assert.Contains(t, err.Error(), "item 15 failed")
+}
+
+func TestParallelRegistryCallsDefaults(t *testing.T) {
+	t.Setenv("TERRAFORM_REGISTRY_PARALLEL_CALLS", "")
+	assert.Equal(t, defaultRegistryParallelCalls, RegistryParallelCalls())
+	t.Setenv("TERRAFORM_REGISTRY_PARALLEL_CALLS", "zero")
+	assert.Equal(t, defaultRegistryParallelCalls, RegistryParallelCalls())
+	t.Setenv("TERRAFORM_REGISTRY_PARALLEL_CALLS", "2")
+	assert.Equal(t, 2, RegistryParallelCalls())
+
+	var inFlight, maxInFlight atomic.Int32
+	results := ParallelRegistryCalls([]int{1, 2, 3, 4, 5, 6}, 0, func(item int) (int, error) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			observed := maxInFlight.Load()
+			if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+		time.Sleep(2 * time.Millisecond)
+		return item * 2, nil
+	})
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+	assert.NoError(t, ParallelErrors(results))
+	assert.Equal(t, 12, results[5].Value)
+
+	assert.Empty(t, ParallelRegistryCalls(nil, 3, func(item int) (int, error) { return 0, errors.New("not called") }))
+}
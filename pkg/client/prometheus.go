@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// PrometheusMetricsPath is the HTTP path the Prometheus metrics are served on when METRICS_ENABLED is set
+const PrometheusMetricsPath = "/metrics"
+
+var (
+	prometheusRegistry = prometheus.NewRegistry()
+
+	promToolCalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "terraform_mcp_tool_calls_total",
+		Help: "Total number of tool calls, by tool name.",
+	}, []string{"tool"})
+
+	promToolCallErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "terraform_mcp_tool_call_errors_total",
+		Help: "Total number of tool calls that returned an error, by tool name.",
+	}, []string{"tool"})
+
+	promRegistryRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "terraform_mcp_registry_request_duration_seconds",
+		Help:    "Latency of requests to the Terraform registry, by HTTP method and response status code.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "status"})
+
+	promRegistryCacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "terraform_mcp_registry_cache_lookups_total",
+		Help: "Total number of registry response cache lookups, by result (hit or miss).",
+	}, []string{"result"})
+
+	promRegistryErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "terraform_mcp_registry_errors_total",
+		Help: "Total number of failed requests to the Terraform registry, by category (timeout, 4xx, 5xx, parse_error, network or other).",
+	}, []string{"category"})
+)
+
+func init() {
+	prometheusRegistry.MustRegister(promToolCalls, promToolCallErrors, promRegistryRequestDuration, promRegistryCacheLookups, promRegistryErrors)
+}
+
+// IsPrometheusMetricsEnabled reports whether the Prometheus metrics endpoint is enabled with METRICS_ENABLED
+func IsPrometheusMetricsEnabled() bool {
+	enabled, err := strconv.ParseBool(strings.TrimSpace(utils.GetEnv("METRICS_ENABLED", "false")))
+	return err == nil && enabled
+}
+
+// PrometheusMetricsHandler serves the Prometheus metrics of the server
+func PrometheusMetricsHandler() http.Handler {
+	return promhttp.HandlerFor(prometheusRegistry, promhttp.HandlerOpts{})
+}
+
+// RecordPrometheusToolCall counts a tool call and, if it failed, a tool error
+func RecordPrometheusToolCall(_ context.Context, message *mcp.CallToolRequest, toolErr bool) {
+	if message == nil || message.Params.Name == "" {
+		return
+	}
+	promToolCalls.WithLabelValues(message.Params.Name).Inc()
+	if toolErr {
+		promToolCallErrors.WithLabelValues(message.Params.Name).Inc()
+	}
+}
+
+// observeRegistryRequest records the latency of a registry request. A zero status code means the request failed
+// before a response was received.
+func observeRegistryRequest(method string, statusCode int, duration time.Duration) {
+	status := "error"
+	if statusCode > 0 {
+		status = strconv.Itoa(statusCode)
+	}
+	promRegistryRequestDuration.WithLabelValues(method, status).Observe(duration.Seconds())
+}
+
+// recordRegistryCacheLookup counts a registry response cache hit or miss
+func recordRegistryCacheLookup(hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	promRegistryCacheLookups.WithLabelValues(result).Inc()
+}
+
+// Categories of failed registry requests
+const (
+	registryErrorTimeout = "timeout"
+	registryErrorClient  = "4xx"
+	registryErrorServer  = "5xx"
+	registryErrorParse   = "parse_error"
+	registryErrorNetwork = "network"
+	registryErrorOther   = "other"
+)
+
+// registryErrorCategory returns the category of the error of a registry request, or an empty string for the errors
+// that are not registry failures, such as a request cancelled by the client
+func registryErrorCategory(err error) string {
+	var statusErr *RegistryStatusError
+	var unexpectedErr *RegistryUnexpectedResponseError
+	var netErr net.Error
+	switch {
+	case err == nil, errors.Is(err, context.Canceled):
+		return ""
+	case errors.As(err, &statusErr) && statusErr.StatusCode >= 500:
+		return registryErrorServer
+	case errors.As(err, &statusErr) && statusErr.StatusCode >= 400:
+		return registryErrorClient
+	case errors.As(err, &unexpectedErr), errors.Is(err, errDecompressRegistryResponse):
+		return registryErrorParse
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return registryErrorTimeout
+	case errors.As(err, &netErr):
+		return registryErrorNetwork
+	}
+	return registryErrorOther
+}
+
+// recordRegistryError counts a failed registry request by category
+func recordRegistryError(err error) {
+	if category := registryErrorCategory(err); category != "" {
+		promRegistryErrors.WithLabelValues(category).Inc()
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPrometheusMetricsEnabled(t *testing.T) {
+	t.Setenv("METRICS_ENABLED", "")
+	assert.False(t, IsPrometheusMetricsEnabled())
+
+	t.Setenv("METRICS_ENABLED", "true")
+	assert.True(t, IsPrometheusMetricsEnabled())
+
+	t.Setenv("METRICS_ENABLED", "not-a-bool")
+	assert.False(t, IsPrometheusMetricsEnabled())
+}
+
+func TestPrometheusMetricsHandler(t *testing.T) {
+	request := &mcp.CallToolRequest{}
+	request.Params.Name = "prometheus_test_tool"
+	RecordPrometheusToolCall(context.Background(), request, false)
+	RecordPrometheusToolCall(context.Background(), request, true)
+	RecordPrometheusToolCall(context.Background(), &mcp.CallToolRequest{}, true)
+
+	observeRegistryRequest(http.MethodGet, http.StatusOK, 150*time.Millisecond)
+	observeRegistryRequest(http.MethodGet, 0, time.Second)
+	recordRegistryCacheLookup(true)
+	recordRegistryCacheLookup(false)
+
+	recorder := httptest.NewRecorder()
+	PrometheusMetricsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, PrometheusMetricsPath, nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	body, err := io.ReadAll(recorder.Body)
+	require.NoError(t, err)
+	metrics := string(body)
+
+	assert.Contains(t, metrics, `terraform_mcp_tool_calls_total{tool="prometheus_test_tool"} 2`)
+	assert.Contains(t, metrics, `terraform_mcp_tool_call_errors_total{tool="prometheus_test_tool"} 1`)
+	assert.NotContains(t, metrics, `tool=""`)
+	assert.Contains(t, metrics, `terraform_mcp_registry_request_duration_seconds_count{method="GET",status="200"}`)
+	assert.Contains(t, metrics, `terraform_mcp_registry_request_duration_seconds_count{method="GET",status="error"}`)
+	assert.Contains(t, metrics, `terraform_mcp_registry_cache_lookups_total{result="hit"}`)
+	assert.Contains(t, metrics, `terraform_mcp_registry_cache_lookups_total{result="miss"}`)
+}
+
+func TestRegistryErrorCategory(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{name: "no error", err: nil, expected: ""},
+		{name: "cancelled by the client", err: fmt.Errorf("request: %w", context.Canceled), expected: ""},
+		{name: "not found", err: &RegistryStatusError{StatusCode: http.StatusNotFound, Status: "404 Not Found"}, expected: "4xx"},
+		{name: "rate limited", err: &RegistryStatusError{StatusCode: http.StatusTooManyRequests, Status: "429 Too Many Requests"}, expected: "4xx"},
+		{name: "bad gateway", err: &RegistryStatusError{StatusCode: http.StatusBadGateway, Status: "502 Bad Gateway"}, expected: "5xx"},
+		{name: "html error page with 503", err: newRegistryUnexpectedResponseError(http.StatusServiceUnavailable, "503 Service Unavailable", "text/html", []byte("<h1>down</h1>")), expected: "5xx"},
+		{name: "html page with 200", err: newRegistryUnexpectedResponseError(http.StatusOK, "200 OK", "text/html", []byte("<h1>login</h1>")), expected: "parse_error"},
+		{name: "corrupt gzip body", err: fmt.Errorf("%w: %w", errDecompressRegistryResponse, io.ErrUnexpectedEOF), expected: "parse_error"},
+		{name: "deadline exceeded", err: &url.Error{Op: "Get", URL: "https://registry.terraform.io", Err: context.DeadlineExceeded}, expected: "timeout"},
+		{name: "connection refused", err: &url.Error{Op: "Get", URL: "https://registry.terraform.io", Err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}}, expected: "network"},
+		{name: "unknown", err: errors.New("boom"), expected: "other"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, registryErrorCategory(tt.err))
+		})
+	}
+}
+
+func TestSendRegistryCallRecordsErrorCategory(t *testing.T) {
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/html" {
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write([]byte("<html><body>Sign in</body></html>"))
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer registry.Close()
+
+	serverErrors := registryErrorCount(t, "5xx")
+	parseErrors := registryErrorCount(t, "parse_error")
+	_, err := SendRegistryCall(context.Background(), registry.Client(), http.MethodGet, "modules", logger, "v1", registry.URL)
+	require.Error(t, err)
+	_, err = SendRegistryCall(context.Background(), registry.Client(), http.MethodGet, "html", logger, "v1", registry.URL)
+	require.Error(t, err)
+
+	assert.Equal(t, serverErrors+1, registryErrorCount(t, "5xx"))
+	assert.Equal(t, parseErrors+1, registryErrorCount(t, "parse_error"))
+}
+
+// registryErrorCount returns the current value of the registry error counter of a category, from the metrics endpoint
+func registryErrorCount(t *testing.T, category string) float64 {
+	t.Helper()
+	recorder := httptest.NewRecorder()
+	PrometheusMetricsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, PrometheusMetricsPath, nil))
+	prefix := fmt.Sprintf("terraform_mcp_registry_errors_total{category=%q} ", category)
+	for _, line := range strings.Split(recorder.Body.String(), "\n") {
+		if value, ok := strings.CutPrefix(line, prefix); ok {
+			count, err := strconv.ParseFloat(value, 64)
+			require.NoError(t, err)
+			return count
+		}
+	}
+	return 0
+}
+
+func TestDecodeRegistryResponseRecordsParseError(t *testing.T) {
+	parseErrors := registryErrorCount(t, "parse_error")
+
+	var decoded struct {
+		Versions []string `json:"versions"`
+	}
+	require.NoError(t, DecodeRegistryResponse([]byte(`{"versions":["1.0.0"]}`), &decoded))
+	assert.Equal(t, []string{"1.0.0"}, decoded.Versions)
+	assert.Equal(t, parseErrors, registryErrorCount(t, "parse_error"))
+
+	require.Error(t, DecodeRegistryResponse([]byte(`{"versions":"1.0.0"}`), &decoded))
+	assert.Equal(t, parseErrors+1, registryErrorCount(t, "parse_error"))
+}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ReadinessPath is the HTTP path of the readiness probe, which checks that the registry can be reached
+const ReadinessPath = "/readyz"
+
+const (
+	// registryDiscoveryPath is the service discovery document every registry serves, cheap to probe
+	registryDiscoveryPath = "/.well-known/terraform.json"
+	// readinessProbeTimeout bounds a single registry probe, below the default timeout of orchestrator probes
+	readinessProbeTimeout = 5 * time.Second
+	// readinessCacheTTL spreads frequent probes from several orchestrators over a single registry request
+	readinessCacheTTL = 10 * time.Second
+)
+
+// readinessStatus is the JSON body of the readiness probe
+type readinessStatus struct {
+	Status   string `json:"status"`
+	Registry string `json:"registry"`
+	Error    string `json:"error,omitempty"`
+}
+
+// RegistryReadinessHandler answers readiness probes: 200 when a HEAD request to the service discovery document
+// of the registry succeeds, 503 otherwise. Results are reused for a few seconds.
+type RegistryReadinessHandler struct {
+	httpClient *http.Client
+	logger     *log.Logger
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	lastErr   error
+}
+
+// NewRegistryReadinessHandler creates a readiness handler probing the configured registry, through the same
+// proxy and TLS settings as the registry tools
+func NewRegistryReadinessHandler(logger *log.Logger) *RegistryReadinessHandler {
+	return &RegistryReadinessHandler{
+		httpClient: createHTTPClient(false, logger),
+		logger:     logger,
+	}
+}
+
+func (h *RegistryReadinessHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	registryURL := GetRegistryURL()
+	status := readinessStatus{Status: "ready", Registry: registryURL}
+	code := http.StatusOK
+	if err := h.check(registryURL); err != nil {
+		status.Status = "unavailable"
+		status.Error = err.Error()
+		code = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		h.logger.Debugf("Failed to write readiness response: %v", err)
+	}
+}
+
+// check probes the registry, or returns the result of a probe made within readinessCacheTTL
+func (h *RegistryReadinessHandler) check(registryURL string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.checkedAt.IsZero() && time.Since(h.checkedAt) < readinessCacheTTL {
+		return h.lastErr
+	}
+
+	h.lastErr = h.probe(registryURL)
+	h.checkedAt = time.Now()
+	if h.lastErr != nil {
+		h.logger.Warnf("Readiness check failed: %v", h.lastErr)
+	}
+	return h.lastErr
+}
+
+// probe sends the HEAD request. It is not tied to the request context, so a prober hanging up early does not
+// cache a failure.
+func (h *RegistryReadinessHandler) probe(registryURL string) error {
+	return probeRegistry(h.httpClient, registryURL, h.logger)
+}
+
+// probeRegistry sends a HEAD request for the service discovery document of the registry
+func probeRegistry(httpClient *http.Client, registryURL string, logger *log.Logger) error {
+	ctx, cancel := context.WithTimeout(context.Background(), readinessProbeTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, registryURL+registryDiscoveryPath, nil)
+	if err != nil {
+		return fmt.Errorf("invalid registry URL: %w", err)
+	}
+	if token, ok := getRegistryCredentials(logger).TokenFor(req.URL.Host); ok {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("registry unreachable: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("registry responded with %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryReadinessHandler(t *testing.T) {
+	var probes atomic.Int32
+	var healthy atomic.Bool
+	healthy.Store(true)
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		probes.Add(1)
+		assert.Equal(t, http.MethodHead, r.Method)
+		assert.Equal(t, registryDiscoveryPath, r.URL.Path)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer registry.Close()
+	t.Setenv("TERRAFORM_REGISTRY_URL", registry.URL)
+
+	probe := func(handler http.Handler) (int, readinessStatus) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, ReadinessPath, nil))
+		var status readinessStatus
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &status))
+		return recorder.Code, status
+	}
+
+	handler := NewRegistryReadinessHandler(logger)
+	code, status := probe(handler)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, readinessStatus{Status: "ready", Registry: registry.URL}, status)
+
+	// Probes within the cache TTL reuse the last result
+	healthy.Store(false)
+	code, _ = probe(handler)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, int32(1), probes.Load())
+
+	code, status = probe(NewRegistryReadinessHandler(logger))
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "unavailable", status.Status)
+	assert.Contains(t, status.Error, "502")
+}
+
+func TestRegistryReadinessHandlerUnreachable(t *testing.T) {
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	registry.Close()
+	t.Setenv("TERRAFORM_REGISTRY_URL", registry.URL)
+
+	recorder := httptest.NewRecorder()
+	NewRegistryReadinessHandler(logger).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, ReadinessPath, nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "registry unreachable")
+}
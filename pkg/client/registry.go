@@ -4,14 +4,21 @@
 package client
 
 import (
+	"compress/gzip"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
+	"os"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-cleanhttp"
@@ -19,23 +26,190 @@ import (
 	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	"github.com/hashicorp/terraform-mcp-server/version"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 const DefaultPublicRegistryURL = "https://registry.terraform.io"
 
+// GetRegistryURL returns the base URL registry calls are sent to, TERRAFORM_REGISTRY_URL when a mirror is configured
+func GetRegistryURL() string {
+	return strings.TrimRight(utils.GetEnv("TERRAFORM_REGISTRY_URL", DefaultPublicRegistryURL), "/")
+}
+
+// registryProxy returns the proxy selection of registry requests. TERRAFORM_REGISTRY_PROXY sends every registry
+// request through the given proxy, otherwise HTTP_PROXY, HTTPS_PROXY and NO_PROXY are honored.
+func registryProxy(logger *log.Logger) func(*http.Request) (*url.URL, error) {
+	proxy := strings.TrimSpace(utils.GetEnv("TERRAFORM_REGISTRY_PROXY", ""))
+	if proxy == "" {
+		return http.ProxyFromEnvironment
+	}
+	proxyURL, err := url.Parse(proxy)
+	if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+		logger.Warnf("Invalid TERRAFORM_REGISTRY_PROXY value, falling back to the HTTP_PROXY/HTTPS_PROXY environment")
+		return http.ProxyFromEnvironment
+	}
+	logger.Debugf("Sending registry requests through proxy %s", proxyURL.Redacted())
+	return http.ProxyURL(proxyURL)
+}
+
+// registryCAFile returns the PEM file of additional CA certificates, TERRAFORM_REGISTRY_CA_FILE or its alias
+// REGISTRY_CA_BUNDLE
+func registryCAFile() string {
+	if caFile := strings.TrimSpace(utils.GetEnv("TERRAFORM_REGISTRY_CA_FILE", "")); caFile != "" {
+		return caFile
+	}
+	return strings.TrimSpace(utils.GetEnv("REGISTRY_CA_BUNDLE", ""))
+}
+
+// registryRootCAs returns the system certificate pool extended with TERRAFORM_REGISTRY_CA_FILE (or REGISTRY_CA_BUNDLE),
+// used to verify a registry mirror or a Terraform Enterprise instance with a certificate issued by an internal CA.
+// Nil means the system pool is used as is.
+func registryRootCAs(logger *log.Logger) *x509.CertPool {
+	caFile := registryCAFile()
+	if caFile == "" {
+		return nil
+	}
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		logger.Errorf("Unable to read CA bundle %s: %v", caFile, err)
+		return nil
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		logger.Errorf("No PEM certificates found in CA bundle %s", caFile)
+		return nil
+	}
+	return pool
+}
+
+var insecureSkipVerifyWarning sync.Once
+
+// isInsecureSkipVerify reports whether INSECURE_SKIP_VERIFY turns off TLS certificate verification for all registry
+// and HCP Terraform/TFE requests. It is meant for development against instances with untrusted certificates only, a
+// CA bundle should be configured instead, so it is logged as a warning.
+func isInsecureSkipVerify(logger *log.Logger) bool {
+	value := strings.TrimSpace(utils.GetEnv("INSECURE_SKIP_VERIFY", ""))
+	if value == "" {
+		return false
+	}
+	skip, err := strconv.ParseBool(value)
+	if err != nil {
+		logger.Warnf("Invalid INSECURE_SKIP_VERIFY value %q, TLS certificates are verified", value)
+		return false
+	}
+	if skip {
+		insecureSkipVerifyWarning.Do(func() {
+			logger.Warn("INSECURE_SKIP_VERIFY is set: TLS certificates of the registry and HCP Terraform/TFE are NOT verified and connections can be intercepted. Use TERRAFORM_REGISTRY_CA_FILE to trust an internal CA instead, and never set it in production")
+		})
+	}
+	return skip
+}
+
+const (
+	defaultRegistryMaxIdleConns        = 100
+	defaultRegistryMaxIdleConnsPerHost = 20
+	defaultRegistryIdleConnTimeout     = 90 * time.Second
+)
+
+// registryPoolConfig tunes how many idle connections to the registry are kept open for reuse, and for how long
+type registryPoolConfig struct {
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
+}
+
+// loadRegistryPoolConfig reads TERRAFORM_REGISTRY_MAX_IDLE_CONNS, TERRAFORM_REGISTRY_MAX_IDLE_CONNS_PER_HOST and
+// TERRAFORM_REGISTRY_IDLE_CONN_TIMEOUT. Registry calls go to a single host, so the per-host limit is raised from the
+// Go default of 2 to keep the connections of concurrent calls open.
+func loadRegistryPoolConfig(logger *log.Logger) registryPoolConfig {
+	config := registryPoolConfig{
+		MaxIdleConns:        defaultRegistryMaxIdleConns,
+		MaxIdleConnsPerHost: defaultRegistryMaxIdleConnsPerHost,
+		IdleConnTimeout:     defaultRegistryIdleConnTimeout,
+	}
+	if value := strings.TrimSpace(utils.GetEnv("TERRAFORM_REGISTRY_MAX_IDLE_CONNS", "")); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			logger.Warnf("Invalid TERRAFORM_REGISTRY_MAX_IDLE_CONNS value %q, using default %d", value, defaultRegistryMaxIdleConns)
+		} else {
+			config.MaxIdleConns = limit
+		}
+	}
+	if value := strings.TrimSpace(utils.GetEnv("TERRAFORM_REGISTRY_MAX_IDLE_CONNS_PER_HOST", "")); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			logger.Warnf("Invalid TERRAFORM_REGISTRY_MAX_IDLE_CONNS_PER_HOST value %q, using default %d", value, defaultRegistryMaxIdleConnsPerHost)
+		} else {
+			config.MaxIdleConnsPerHost = limit
+		}
+	}
+	if value := strings.TrimSpace(utils.GetEnv("TERRAFORM_REGISTRY_IDLE_CONN_TIMEOUT", "")); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout < 0 {
+			logger.Warnf("Invalid TERRAFORM_REGISTRY_IDLE_CONN_TIMEOUT value %q, using default %v", value, defaultRegistryIdleConnTimeout)
+		} else {
+			config.IdleConnTimeout = timeout
+		}
+	}
+	return config
+}
+
+const (
+	defaultRegistryDialTimeout         = 5 * time.Second
+	defaultRegistryTLSHandshakeTimeout = 5 * time.Second
+	registryDialKeepAlive              = 30 * time.Second
+)
+
+// registryConnectTimeouts bound the steps of opening a connection to the registry, so a registry that accepts
+// connections slowly or stalls in the TLS handshake fails fast instead of using up the whole request timeout
+type registryConnectTimeouts struct {
+	DialTimeout         time.Duration
+	TLSHandshakeTimeout time.Duration
+}
+
+// loadRegistryConnectTimeouts reads TERRAFORM_REGISTRY_DIAL_TIMEOUT and TERRAFORM_REGISTRY_TLS_HANDSHAKE_TIMEOUT,
+// 0 for no timeout
+func loadRegistryConnectTimeouts(logger *log.Logger) registryConnectTimeouts {
+	timeouts := registryConnectTimeouts{
+		DialTimeout:         defaultRegistryDialTimeout,
+		TLSHandshakeTimeout: defaultRegistryTLSHandshakeTimeout,
+	}
+	if value := strings.TrimSpace(utils.GetEnv("TERRAFORM_REGISTRY_DIAL_TIMEOUT", "")); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout < 0 {
+			logger.Warnf("Invalid TERRAFORM_REGISTRY_DIAL_TIMEOUT value %q, using default %v", value, defaultRegistryDialTimeout)
+		} else {
+			timeouts.DialTimeout = timeout
+		}
+	}
+	if value := strings.TrimSpace(utils.GetEnv("TERRAFORM_REGISTRY_TLS_HANDSHAKE_TIMEOUT", "")); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout < 0 {
+			logger.Warnf("Invalid TERRAFORM_REGISTRY_TLS_HANDSHAKE_TIMEOUT value %q, using default %v", value, defaultRegistryTLSHandshakeTimeout)
+		} else {
+			timeouts.TLSHandshakeTimeout = timeout
+		}
+	}
+	return timeouts
+}
+
+// registryRequestTimeout bounds a single attempt of a registry request, retries get a fresh timeout each
+const registryRequestTimeout = 10 * time.Second
+
 // createHTTPClient initializes a retryable HTTP client
 func createHTTPClient(insecureSkipVerify bool, logger *log.Logger) *http.Client {
 	retryClient := retryablehttp.NewClient()
 	retryClient.Logger = logger
 
-	transport := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: insecureSkipVerify},
-	}
-	transport.Proxy = http.ProxyFromEnvironment
-
 	retryClient.HTTPClient = cleanhttp.DefaultClient()
-	retryClient.HTTPClient.Timeout = 10 * time.Second
-	retryClient.HTTPClient.Transport = transport
+	retryClient.HTTPClient.Timeout = registryRequestTimeout
+	retryClient.HTTPClient.Transport = newRegistryTransport(insecureSkipVerify, logger)
 	retryClient.RetryMax = 3
 
 	retryClient.Backoff = func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
@@ -58,55 +232,189 @@ func createHTTPClient(insecureSkipVerify bool, logger *log.Logger) *http.Client
 		}
 		return false, nil
 	}
+	// Return the last 429 response once the retries are used up, so callers see the rate limit status
+	retryClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
 
 	return retryClient.StandardClient()
 }
 
-func SendRegistryCall(client *http.Client, method string, uri string, logger *log.Logger, callOptions ...string) ([]byte, error) {
-	ver := "v1"
+// newRegistryTransport creates a transport with the TLS, CA, proxy, pool and connect timeout settings of the registry
+func newRegistryTransport(insecureSkipVerify bool, logger *log.Logger) *http.Transport {
+	transport := cleanhttp.DefaultPooledTransport()
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: insecureSkipVerify || isInsecureSkipVerify(logger),
+		RootCAs:            registryRootCAs(logger),
+	}
+	transport.Proxy = registryProxy(logger)
+	poolConfig := loadRegistryPoolConfig(logger)
+	transport.MaxIdleConns = poolConfig.MaxIdleConns
+	transport.MaxIdleConnsPerHost = poolConfig.MaxIdleConnsPerHost
+	transport.IdleConnTimeout = poolConfig.IdleConnTimeout
+	connectTimeouts := loadRegistryConnectTimeouts(logger)
+	transport.DialContext = (&net.Dialer{Timeout: connectTimeouts.DialTimeout, KeepAlive: registryDialKeepAlive}).DialContext
+	transport.TLSHandshakeTimeout = connectTimeouts.TLSHandshakeTimeout
+	return transport
+}
+
+// SendRegistryCall sends a request to the registry and returns the response body. The request is bound to ctx,
+// the context of the tool call, so it is aborted as soon as the call is cancelled, e.g. when the client disconnects.
+func SendRegistryCall(ctx context.Context, client *http.Client, method string, uri string, logger *log.Logger, callOptions ...string) ([]byte, error) {
+	ver := RegistryAPIVersions().Providers
 	if len(callOptions) > 0 {
-		ver = callOptions[0] // API version will be the first optional arg to this function
+		ver = callOptions[0] // API version will be the first optional arg to this function, one of RegistryAPIVersions
+	}
+	requestLogger := LoggerFromContext(ctx, logger)
+	baseURL := GetRegistryURL()
+	if len(callOptions) > 1 && callOptions[1] != "" {
+		baseURL = strings.TrimRight(callOptions[1], "/") // The registry base URL can be overridden by the second optional arg
 	}
 
-	url, err := url.Parse(fmt.Sprintf("%s/%s/%s", DefaultPublicRegistryURL, ver, uri))
+	url, err := url.Parse(fmt.Sprintf("%s/%s/%s", baseURL, ver, uri))
 	if err != nil {
 		return nil, fmt.Errorf("error parsing terraform registry URL: %w", err)
 	}
-	logger.Debugf("Requested URL: %s", url)
+	requestLogger.Debugf("Requested URL: %s", url)
+
+	ctx, span := startRegistrySpan(ctx, method, url.String())
+	defer span.End()
+
+	cache := getRegistryCache(logger)
+	var stale registryCacheEntry
+	if cache != nil && method == http.MethodGet {
+		body, ok := cache.Get(url.String())
+		recordRegistryCacheLookup(ok)
+		span.SetAttributes(attribute.Bool("cache.hit", ok))
+		if ok {
+			requestLogger.Debugf("Registry cache hit: %s", url)
+			return body, nil
+		}
+		stale, _ = cache.Revalidation(url.String())
+	}
 
-	req, err := http.NewRequest(method, url.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
 	if err != nil {
 		return nil, err
 	}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
 	req.Header.Set("User-Agent", fmt.Sprintf("terraform-mcp-server/%s", version.GetHumanVersion()))
+	// Setting the header explicitly turns off the transparent decompression of the transport, see readRegistryBody
+	req.Header.Set("Accept-Encoding", "gzip")
+	// The token is resolved per request as the base URL can differ between calls
+	if token, ok := getRegistryCredentials(logger).TokenFor(url.Host); ok {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if stale.ETag != "" {
+		req.Header.Set("If-None-Match", stale.ETag)
+	}
+
+	// Cache hits above do not count against the outbound rate limit
+	if err := waitForRegistryLimiter(req.Context(), getRegistryLimiter(logger)); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
 
+	start := time.Now()
 	resp, err := client.Do(req)
 	if err != nil {
+		observeRegistryRequest(method, 0, time.Since(start))
+		recordRegistryError(err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
+	observeRegistryRequest(method, resp.StatusCode, time.Since(start))
+	recordRegistryRateLimit(client, resp)
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+
+	// The cached body is still current, keep serving it for another TTL
+	if resp.StatusCode == http.StatusNotModified && stale.ETag != "" {
+		resp.Body.Close()
+		etag := resp.Header.Get("ETag")
+		if etag == "" {
+			etag = stale.ETag
+		}
+		cache.Set(url.String(), stale.Body, etag)
+		span.SetAttributes(attribute.Bool("cache.revalidated", true))
+		requestLogger.Debugf("Registry cache revalidated: %s", url)
+		return stale.Body, nil
+	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error: %s", "404 Not Found")
+		defer resp.Body.Close()
+		span.SetStatus(codes.Error, resp.Status)
+		// Error pages are quoted in the error, other error bodies are of no use
+		var statusErr error = &RegistryStatusError{StatusCode: resp.StatusCode, Status: resp.Status}
+		if body, err := readRegistryBody(resp); err == nil && isUnexpectedRegistryResponse(resp.Header.Get("Content-Type"), body) {
+			statusErr = newRegistryUnexpectedResponseError(resp.StatusCode, resp.Status, resp.Header.Get("Content-Type"), body)
+		}
+		recordRegistryError(statusErr)
+		return nil, statusErr
 	}
 
 	defer resp.Body.Close()
 	// Read the response body
-	body, err := io.ReadAll(resp.Body)
+	body, err := readRegistryBody(resp)
 	if err != nil {
+		recordRegistryError(err)
 		return nil, err
 	}
-	logger.Debugf("Response status: %s", resp.Status)
-	logger.Tracef("Response body: %s", string(body))
+	if isUnexpectedRegistryResponse(resp.Header.Get("Content-Type"), body) {
+		span.SetStatus(codes.Error, "unexpected response content type")
+		unexpectedErr := newRegistryUnexpectedResponseError(resp.StatusCode, resp.Status, resp.Header.Get("Content-Type"), body)
+		recordRegistryError(unexpectedErr)
+		return nil, unexpectedErr
+	}
+	requestLogger.Debugf("Response status: %s", resp.Status)
+	requestLogger.Tracef("Response body: %s", string(body))
+	if cache != nil && method == http.MethodGet {
+		cache.Set(url.String(), body, resp.Header.Get("ETag"))
+	}
 	return body, nil
 }
 
-func SendPaginatedRegistryCall(client *http.Client, uriPrefix string, logger *log.Logger) ([]ProviderDocData, error) {
+// DecodeRegistryResponse unmarshals the JSON body of a registry response into v. A body that cannot be decoded is
+// counted as a parse_error of the registry, like a response that is not JSON at all.
+func DecodeRegistryResponse(body []byte, v any) error {
+	if err := json.Unmarshal(body, v); err != nil {
+		promRegistryErrors.WithLabelValues(registryErrorParse).Inc()
+		return err
+	}
+	return nil
+}
+
+// errDecompressRegistryResponse is wrapped by the errors of gzipped registry responses that cannot be decompressed
+var errDecompressRegistryResponse = errors.New("decompressing registry response")
+
+// readRegistryBody reads a response body, decompressing it when the registry sent it gzipped. Responses the
+// registry chose not to compress are returned as is.
+func readRegistryBody(resp *http.Response) ([]byte, error) {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return io.ReadAll(resp.Body)
+	}
+	reader, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errDecompressRegistryResponse, err)
+	}
+	defer reader.Close()
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errDecompressRegistryResponse, err)
+	}
+	return body, nil
+}
+
+func SendPaginatedRegistryCall(ctx context.Context, client *http.Client, uriPrefix string, logger *log.Logger) ([]ProviderDocData, error) {
 	var results []ProviderDocData
 	page := 1
 
 	for {
 		uri := fmt.Sprintf("%s&page[number]=%d", uriPrefix, page)
-		resp, err := SendRegistryCall(client, "GET", uri, logger, "v2")
+		resp, err := SendRegistryCall(ctx, client, "GET", uri, logger, RegistryAPIVersions().ProviderDocs)
+		if errors.Is(err, ErrRegistryCallBudgetExceeded) && len(results) > 0 {
+			// Return the complete pages fetched so far, the caller is told the results are partial
+			logger.Warnf("Stopping paginated registry call at page %d: %v", page, err)
+			break
+		}
 		if err != nil {
 			return nil, utils.LogAndReturnError(logger, fmt.Sprintf("calling paginated registry API (page %d)", page), err)
 		}
@@ -114,7 +422,7 @@ func SendPaginatedRegistryCall(client *http.Client, uriPrefix string, logger *lo
 		var wrapper struct {
 			Data []ProviderDocData `json:"data"`
 		}
-		if err := json.Unmarshal(resp, &wrapper); err != nil {
+		if err := DecodeRegistryResponse(resp, &wrapper); err != nil {
 			return nil, utils.LogAndReturnError(logger, fmt.Sprintf("unmarshalling page %d", page), err)
 		}
 
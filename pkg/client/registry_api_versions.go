@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// RegistryAPIVersionConfig holds the version of each registry API the server calls, the first path segment of its
+// requests (e.g. "v1" in /v1/providers/hashicorp/aws)
+type RegistryAPIVersionConfig struct {
+	// Providers serves provider metadata, versions, package downloads and the documentation index of a version
+	Providers string
+	// ProviderDocs serves the provider listing, the versions of a provider with their ids and the documentation pages
+	ProviderDocs string
+	// Modules serves module search, metadata and versions
+	Modules string
+	// Policies serves policy libraries and their files
+	Policies string
+}
+
+// DefaultRegistryAPIVersions are the registry API versions used unless TERRAFORM_REGISTRY_API_VERSIONS overrides them
+var DefaultRegistryAPIVersions = RegistryAPIVersionConfig{
+	Providers:    "v1",
+	ProviderDocs: "v2",
+	Modules:      "v1",
+	Policies:     "v2",
+}
+
+var registryAPIVersionRegex = regexp.MustCompile(`^v[0-9]+$`)
+
+var registryAPIVersions = struct {
+	sync.RWMutex
+	config RegistryAPIVersionConfig
+}{config: DefaultRegistryAPIVersions}
+
+// RegistryAPIVersions returns the registry API versions the server calls
+func RegistryAPIVersions() RegistryAPIVersionConfig {
+	registryAPIVersions.RLock()
+	defer registryAPIVersions.RUnlock()
+	return registryAPIVersions.config
+}
+
+// LoadRegistryAPIVersionsFromEnv reads TERRAFORM_REGISTRY_API_VERSIONS, comma-separated api=version pairs
+// (e.g. "provider_docs=v3,policies=v2") overriding the default version of the providers, provider_docs, modules and
+// policies APIs, and makes them the versions returned by RegistryAPIVersions
+func LoadRegistryAPIVersionsFromEnv(logger *log.Logger) RegistryAPIVersionConfig {
+	config := DefaultRegistryAPIVersions
+	fields := map[string]*string{
+		"providers":     &config.Providers,
+		"provider_docs": &config.ProviderDocs,
+		"modules":       &config.Modules,
+		"policies":      &config.Policies,
+	}
+	for _, pair := range splitCommaList(utils.GetEnv("TERRAFORM_REGISTRY_API_VERSIONS", "")) {
+		api, version, found := strings.Cut(pair, "=")
+		field, known := fields[strings.ToLower(strings.TrimSpace(api))]
+		version = strings.ToLower(strings.TrimSpace(version))
+		if !found || !known || !registryAPIVersionRegex.MatchString(version) {
+			logger.Warnf("Ignoring invalid TERRAFORM_REGISTRY_API_VERSIONS entry %q, expected api=version with api one of providers, provider_docs, modules, policies and a version like v2", pair)
+			continue
+		}
+		*field = version
+	}
+
+	registryAPIVersions.Lock()
+	registryAPIVersions.config = config
+	registryAPIVersions.Unlock()
+	return config
+}
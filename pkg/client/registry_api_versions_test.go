@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadRegistryAPIVersionsFromEnv(t *testing.T) {
+	t.Cleanup(func() {
+		t.Setenv("TERRAFORM_REGISTRY_API_VERSIONS", "")
+		LoadRegistryAPIVersionsFromEnv(logger)
+	})
+
+	t.Setenv("TERRAFORM_REGISTRY_API_VERSIONS", "")
+	assert.Equal(t, DefaultRegistryAPIVersions, LoadRegistryAPIVersionsFromEnv(logger))
+
+	t.Setenv("TERRAFORM_REGISTRY_API_VERSIONS", "provider_docs=V3, modules=v2, unknown=v1, policies=latest, providers")
+	config := LoadRegistryAPIVersionsFromEnv(logger)
+	assert.Equal(t, RegistryAPIVersionConfig{Providers: "v1", ProviderDocs: "v3", Modules: "v2", Policies: "v2"}, config)
+	assert.Equal(t, config, RegistryAPIVersions())
+}
+
+func TestSendRegistryCallUsesConfiguredVersion(t *testing.T) {
+	var requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Path
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	t.Setenv("TERRAFORM_REGISTRY_URL", server.URL)
+	t.Setenv("TERRAFORM_REGISTRY_API_VERSIONS", "providers=v9")
+	t.Cleanup(func() {
+		t.Setenv("TERRAFORM_REGISTRY_API_VERSIONS", "")
+		LoadRegistryAPIVersionsFromEnv(logger)
+	})
+	LoadRegistryAPIVersionsFromEnv(logger)
+
+	_, err := SendRegistryCall(context.Background(), server.Client(), http.MethodGet, "providers/hashicorp/aws", logger)
+	require.NoError(t, err)
+	assert.Equal(t, "/v9/providers/hashicorp/aws", requested)
+}
@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrRegistryCallBudgetExceeded is returned for registry requests made after a tool invocation used up its call budget
+var ErrRegistryCallBudgetExceeded = errors.New("registry call budget for this tool invocation exceeded")
+
+// registryCallBudgetKey is the context key of the call budget of the current tool invocation
+const registryCallBudgetKey = contextKey("registry-call-budget")
+
+// RegistryCallBudgetConfig limits the number of registry requests a single tool invocation may make.
+// A limit of 0 means unlimited.
+type RegistryCallBudgetConfig struct {
+	GlobalLimit int
+	ToolLimits  map[string]int
+}
+
+// LimitFor returns the call limit of a tool, preferring the per-tool limit over the global one
+func (c RegistryCallBudgetConfig) LimitFor(toolName string) int {
+	if limit, ok := c.ToolLimits[toolName]; ok {
+		return limit
+	}
+	return c.GlobalLimit
+}
+
+// Enabled reports whether any limit is configured
+func (c RegistryCallBudgetConfig) Enabled() bool {
+	if c.GlobalLimit > 0 {
+		return true
+	}
+	for _, limit := range c.ToolLimits {
+		if limit > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadRegistryCallBudgetConfigFromEnv reads MCP_REGISTRY_CALL_LIMIT (global) and MCP_REGISTRY_CALL_LIMIT_PER_TOOL
+// (comma-separated tool=limit pairs, e.g. "search_providers=20,compare_provider_docs=6")
+func LoadRegistryCallBudgetConfigFromEnv(logger *log.Logger) RegistryCallBudgetConfig {
+	config := RegistryCallBudgetConfig{ToolLimits: make(map[string]int)}
+
+	if value := strings.TrimSpace(utils.GetEnv("MCP_REGISTRY_CALL_LIMIT", "")); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			logger.Warnf("Invalid MCP_REGISTRY_CALL_LIMIT value %q, registry calls per tool invocation are not limited", value)
+		} else {
+			config.GlobalLimit = limit
+		}
+	}
+
+	for _, pair := range splitCommaList(utils.GetEnv("MCP_REGISTRY_CALL_LIMIT_PER_TOOL", "")) {
+		name, value, found := strings.Cut(pair, "=")
+		limit, err := strconv.Atoi(strings.TrimSpace(value))
+		if !found || strings.TrimSpace(name) == "" || err != nil || limit < 0 {
+			logger.Warnf("Ignoring invalid MCP_REGISTRY_CALL_LIMIT_PER_TOOL entry %q, expected tool=limit", pair)
+			continue
+		}
+		config.ToolLimits[strings.TrimSpace(name)] = limit
+	}
+
+	return config
+}
+
+// registryCallBudget counts the registry requests of one tool invocation
+type registryCallBudget struct {
+	limit    int64
+	used     atomic.Int64
+	exceeded atomic.Bool
+}
+
+// take reserves one call from the budget, returning false once the limit is reached
+func (b *registryCallBudget) take() bool {
+	if b.used.Add(1) > b.limit {
+		b.used.Add(-1)
+		b.exceeded.Store(true)
+		return false
+	}
+	return true
+}
+
+// budgetTransport refuses requests once the budget of the tool invocation is used up
+type budgetTransport struct {
+	base   http.RoundTripper
+	parent *http.Client
+	budget *registryCallBudget
+}
+
+func (t *budgetTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.budget.take() {
+		return nil, fmt.Errorf("%w (limit: %d)", ErrRegistryCallBudgetExceeded, t.budget.limit)
+	}
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
+
+// withRegistryCallBudget returns a copy of the session client whose requests are counted against the budget in ctx
+func withRegistryCallBudget(ctx context.Context, client *http.Client) *http.Client {
+	budget, ok := ctx.Value(registryCallBudgetKey).(*registryCallBudget)
+	if !ok || client == nil {
+		return client
+	}
+	budgeted := *client
+	budgeted.Transport = &budgetTransport{base: client.Transport, parent: client, budget: budget}
+	return &budgeted
+}
+
+// sessionHttpClient returns the session client a budgeted or call context tagged client was derived from
+func sessionHttpClient(client *http.Client) *http.Client {
+	for client != nil {
+		switch transport := client.Transport.(type) {
+		case *budgetTransport:
+			client = transport.parent
+		case *callContextTransport:
+			client = transport.parent
+		default:
+			return client
+		}
+	}
+	return nil
+}
+
+// registryCallBudgetExceeded reports whether the tool invocation of ctx reached its registry call limit, so its
+// results may be incomplete
+func registryCallBudgetExceeded(ctx context.Context) bool {
+	budget, ok := ctx.Value(registryCallBudgetKey).(*registryCallBudget)
+	return ok && budget.exceeded.Load()
+}
+
+// RegistryCallBudgetMiddleware caps the number of registry requests of each tool invocation. Once the cap is
+// reached further requests fail, tools return what they gathered so far, and a note is appended to the result.
+func RegistryCallBudgetMiddleware(config RegistryCallBudgetConfig, logger *log.Logger) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			limit := config.LimitFor(request.Params.Name)
+			if limit <= 0 {
+				return next(ctx, request)
+			}
+
+			budget := &registryCallBudget{limit: int64(limit)}
+			result, err := next(context.WithValue(ctx, registryCallBudgetKey, budget), request)
+			if !budget.exceeded.Load() {
+				return result, err
+			}
+
+			logger.Warnf("Tool %s reached its limit of %d registry calls, returning partial results", request.Params.Name, limit)
+			if err != nil || result == nil {
+				return result, err
+			}
+			result.Content = append(result.Content, mcp.NewTextContent(fmt.Sprintf(
+				"Note: this tool call stopped after reaching its limit of %d registry requests, so the results above may be incomplete. Narrow the request (e.g. a more specific name or filter) to get complete results.", limit)))
+			return result, nil
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadRegistryCallBudgetConfigFromEnv(t *testing.T) {
+	t.Setenv("MCP_REGISTRY_CALL_LIMIT", "")
+	t.Setenv("MCP_REGISTRY_CALL_LIMIT_PER_TOOL", "")
+	config := LoadRegistryCallBudgetConfigFromEnv(logger)
+	assert.False(t, config.Enabled())
+	assert.Equal(t, 0, config.LimitFor("search_providers"))
+
+	t.Setenv("MCP_REGISTRY_CALL_LIMIT", "25")
+	t.Setenv("MCP_REGISTRY_CALL_LIMIT_PER_TOOL", "search_providers=10, compare_provider_docs=0, invalid, bad=x")
+	config = LoadRegistryCallBudgetConfigFromEnv(logger)
+	assert.True(t, config.Enabled())
+	assert.Equal(t, 25, config.LimitFor("get_provider_details"))
+	assert.Equal(t, 10, config.LimitFor("search_providers"))
+	assert.Equal(t, 0, config.LimitFor("compare_provider_docs"), "a per-tool limit of 0 disables the cap for that tool")
+	assert.Len(t, config.ToolLimits, 2)
+
+	t.Setenv("MCP_REGISTRY_CALL_LIMIT", "-1")
+	t.Setenv("MCP_REGISTRY_CALL_LIMIT_PER_TOOL", "")
+	assert.False(t, LoadRegistryCallBudgetConfigFromEnv(logger).Enabled())
+}
+
+func TestRegistryCallBudgetMiddleware(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sessionClient := server.Client()
+	config := RegistryCallBudgetConfig{GlobalLimit: 2, ToolLimits: map[string]int{"unlimited_tool": 0}}
+
+	// The handler makes three requests and reports how many succeeded
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		httpClient := withRegistryCallBudget(ctx, sessionClient)
+		succeeded := 0
+		for i := 0; i < 3; i++ {
+			resp, err := httpClient.Get(server.URL)
+			if err != nil {
+				assert.True(t, errors.Is(err, ErrRegistryCallBudgetExceeded))
+				break
+			}
+			resp.Body.Close()
+			succeeded++
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("%d requests", succeeded)), nil
+	}
+	wrapped := RegistryCallBudgetMiddleware(config, logger)(handler)
+
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "search_providers"
+	result, err := wrapped(context.Background(), request)
+	require.NoError(t, err)
+	require.Len(t, result.Content, 2)
+	assert.Equal(t, "2 requests", result.Content[0].(mcp.TextContent).Text)
+	assert.Contains(t, result.Content[1].(mcp.TextContent).Text, "limit of 2 registry requests")
+	assert.Equal(t, 2, requests)
+
+	// Each invocation gets a fresh budget, and tools with a limit of 0 are not capped
+	request.Params.Name = "unlimited_tool"
+	result, err = wrapped(context.Background(), request)
+	require.NoError(t, err)
+	require.Len(t, result.Content, 1)
+	assert.Equal(t, "3 requests", result.Content[0].(mcp.TextContent).Text)
+	assert.Equal(t, 5, requests)
+}
+
+func TestSessionHttpClient(t *testing.T) {
+	sessionClient := &http.Client{}
+	assert.Same(t, sessionClient, sessionHttpClient(sessionClient))
+
+	ctx := context.WithValue(context.Background(), registryCallBudgetKey, &registryCallBudget{limit: 1})
+	budgeted := withRegistryCallBudget(ctx, sessionClient)
+	assert.NotSame(t, sessionClient, budgeted)
+	assert.Same(t, sessionClient, sessionHttpClient(budgeted))
+
+	// Without a budget in the context the session client is used as is
+	assert.Same(t, sessionClient, withRegistryCallBudget(context.Background(), sessionClient))
+}
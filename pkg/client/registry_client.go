@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/mark3labs/mcp-go/server"
 	log "github.com/sirupsen/logrus"
@@ -35,11 +36,16 @@ func GetHttpClient(sessionId string) *http.Client {
 
 // DeleteHttpClient removes the HTTP client for the given session
 func DeleteHttpClient(sessionId string) {
-	activeHttpClients.Delete(sessionId)
+	if value, ok := activeHttpClients.LoadAndDelete(sessionId); ok {
+		deleteRegistryRateLimitStatus(value.(*http.Client))
+	}
 }
 
 // GetHttpClientFromContext extracts HTTP client from the MCP context
 func GetHttpClientFromContext(ctx context.Context, logger *log.Logger) (*http.Client, error) {
+	if err := stopDryRun(ctx); err != nil {
+		return nil, err
+	}
 	session := server.ClientSessionFromContext(ctx)
 	if session == nil {
 		return nil, fmt.Errorf("no active session")
@@ -48,11 +54,21 @@ func GetHttpClientFromContext(ctx context.Context, logger *log.Logger) (*http.Cl
 	// Try to get existing client
 	client := GetHttpClient(session.SessionID())
 	if client != nil {
-		return client, nil
+		return withRegistryCallBudget(ctx, withCallContext(ctx, client)), nil
 	}
 
 	logger.Warnf("HTTP client not found, creating a new one")
-	return CreateHttpClientForSession(ctx, session, logger), nil
+	return withRegistryCallBudget(ctx, withCallContext(ctx, CreateHttpClientForSession(ctx, session, logger))), nil
+}
+
+// NewDownloadHttpClient creates an HTTP client for downloads served outside of the registry API, such as provider
+// packages. It uses the TLS, CA and proxy settings of the registry client of the session, without its retries, and
+// bounds each request by timeout instead of the timeout of a registry request.
+func NewDownloadHttpClient(ctx context.Context, timeout time.Duration, logger *log.Logger) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: newRegistryTransport(parseTerraformSkipTLSVerify(ctx), logger),
+	}
 }
 
 // CreateHttpClientForSession creates only an HTTP client for the session
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// RegistryCredentials maps registry hosts (e.g. "registry.example.com" or "mirror.internal:8443") to the
+// bearer token sent to them. Hosts are lowercase.
+type RegistryCredentials map[string]string
+
+var (
+	registryCredentials     RegistryCredentials
+	registryCredentialsOnce sync.Once
+)
+
+// LoadRegistryCredentialsFromEnv reads the registry tokens of TERRAFORM_REGISTRY_CREDENTIALS_FILE, a file in the
+// credentials.tfrc.json format of the Terraform CLI, and TERRAFORM_REGISTRY_TOKENS, comma-separated host=token
+// pairs that take precedence over the file. Any invalid entry is an error, which never includes a token.
+func LoadRegistryCredentialsFromEnv() (RegistryCredentials, error) {
+	credentials := make(RegistryCredentials)
+
+	if path := strings.TrimSpace(utils.GetEnv("TERRAFORM_REGISTRY_CREDENTIALS_FILE", "")); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading TERRAFORM_REGISTRY_CREDENTIALS_FILE: %w", err)
+		}
+		var file credentialsFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("parsing TERRAFORM_REGISTRY_CREDENTIALS_FILE %s: invalid JSON", path)
+		}
+		for host, entry := range file.Credentials {
+			if err := credentials.add(host, entry.Token); err != nil {
+				return nil, fmt.Errorf("TERRAFORM_REGISTRY_CREDENTIALS_FILE %s: %w", path, err)
+			}
+		}
+	}
+
+	for i, pair := range splitCommaList(utils.GetEnv("TERRAFORM_REGISTRY_TOKENS", "")) {
+		host, token, found := strings.Cut(pair, "=")
+		if !found {
+			// The entry may be a bare token, so it is referred to by position only
+			return nil, fmt.Errorf("TERRAFORM_REGISTRY_TOKENS: entry %d is not of the form host=token", i+1)
+		}
+		if err := credentials.add(host, token); err != nil {
+			return nil, fmt.Errorf("TERRAFORM_REGISTRY_TOKENS: %w", err)
+		}
+	}
+
+	return credentials, nil
+}
+
+// add validates and stores the token of a host
+func (c RegistryCredentials) add(host string, token string) error {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if !isValidRegistryHost(host) {
+		return fmt.Errorf("invalid registry host %q, expected a hostname with an optional port", host)
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return fmt.Errorf("empty token for registry host %q", host)
+	}
+	c[host] = token
+	return nil
+}
+
+// TokenFor returns the token configured for a host, matched case-insensitively
+func (c RegistryCredentials) TokenFor(host string) (string, bool) {
+	token, ok := c[strings.ToLower(host)]
+	return token, ok
+}
+
+// Hosts returns the configured hosts in alphabetical order, for logging without the tokens
+func (c RegistryCredentials) Hosts() []string {
+	hosts := make([]string, 0, len(c))
+	for host := range c {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	return hosts
+}
+
+// isValidRegistryHost reports whether a value is a bare hostname with an optional port, not a URL
+func isValidRegistryHost(host string) bool {
+	if host == "" || strings.ContainsAny(host, "/@?# ") {
+		return false
+	}
+	if name, port, err := net.SplitHostPort(host); err == nil {
+		return name != "" && port != ""
+	}
+	return !strings.Contains(host, ":")
+}
+
+// InitRegistryCredentials loads and validates the registry credentials at startup, so a broken configuration
+// stops the server rather than surfacing as authentication failures in tool calls.
+func InitRegistryCredentials(logger *log.Logger) error {
+	credentials, err := LoadRegistryCredentialsFromEnv()
+	if err != nil {
+		return err
+	}
+	registryCredentialsOnce.Do(func() {
+		registryCredentials = credentials
+	})
+	if len(credentials) > 0 {
+		logger.Infof("Registry tokens configured for hosts: %s", strings.Join(credentials.Hosts(), ", "))
+	}
+	return nil
+}
+
+// getRegistryCredentials returns the process wide registry credentials, loading them on first use when the
+// server did not initialize them at startup
+func getRegistryCredentials(logger *log.Logger) RegistryCredentials {
+	registryCredentialsOnce.Do(func() {
+		credentials, err := LoadRegistryCredentialsFromEnv()
+		if err != nil {
+			logger.Errorf("Registry requests are sent without tokens: %v", err)
+			return
+		}
+		registryCredentials = credentials
+	})
+	return registryCredentials
+}
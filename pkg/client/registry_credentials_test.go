@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadRegistryCredentialsFromEnv(t *testing.T) {
+	credentialsFile := filepath.Join(t.TempDir(), "credentials.tfrc.json")
+	require.NoError(t, os.WriteFile(credentialsFile, []byte(`{
+  "credentials": {
+    "Registry.Example.com": {"token": "file-token"},
+    "mirror.internal:8443": {"token": "mirror-token"}
+  }
+}`), 0o600))
+	t.Setenv("TERRAFORM_REGISTRY_CREDENTIALS_FILE", credentialsFile)
+	t.Setenv("TERRAFORM_REGISTRY_TOKENS", "registry.example.com=env-token, other.example.com = other-token")
+
+	credentials, err := LoadRegistryCredentialsFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mirror.internal:8443", "other.example.com", "registry.example.com"}, credentials.Hosts())
+
+	token, ok := credentials.TokenFor("REGISTRY.example.com")
+	assert.True(t, ok)
+	assert.Equal(t, "env-token", token, "TERRAFORM_REGISTRY_TOKENS takes precedence over the file")
+	token, _ = credentials.TokenFor("mirror.internal:8443")
+	assert.Equal(t, "mirror-token", token)
+	_, ok = credentials.TokenFor("registry.terraform.io")
+	assert.False(t, ok)
+}
+
+func TestLoadRegistryCredentialsFromEnvInvalid(t *testing.T) {
+	tests := map[string]string{
+		"missing separator":   "secret-token",
+		"url instead of host": "https://registry.example.com=secret-token",
+		"empty token":         "registry.example.com=",
+		"empty host":          "=secret-token",
+	}
+	for name, value := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("TERRAFORM_REGISTRY_TOKENS", value)
+			_, err := LoadRegistryCredentialsFromEnv()
+			require.Error(t, err)
+			assert.NotContains(t, err.Error(), "secret-token")
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Setenv("TERRAFORM_REGISTRY_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "missing.json"))
+		_, err := LoadRegistryCredentialsFromEnv()
+		assert.Error(t, err)
+	})
+}
+
+func TestSendRegistryCallUsesTokenOfHost(t *testing.T) {
+	var privateAuth, publicAuth string
+	private := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		privateAuth = r.Header.Get("Authorization")
+		w.Write([]byte(`{}`))
+	}))
+	defer private.Close()
+	public := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		publicAuth = r.Header.Get("Authorization")
+		w.Write([]byte(`{}`))
+	}))
+	defer public.Close()
+
+	privateURL, err := url.Parse(private.URL)
+	require.NoError(t, err)
+	t.Setenv("TERRAFORM_REGISTRY_URL", public.URL)
+	t.Setenv("TERRAFORM_REGISTRY_TOKENS", privateURL.Host+"=private-token")
+	registryCredentialsOnce = sync.Once{}
+	t.Cleanup(func() {
+		registryCredentials = nil
+		registryCredentialsOnce = sync.Once{}
+	})
+	require.NoError(t, InitRegistryCredentials(logger))
+
+	httpClient := createHTTPClient(false, logger)
+	_, err = SendRegistryCall(context.Background(), httpClient, http.MethodGet, "modules/acme/vpc/aws", logger, "v1", private.URL)
+	require.NoError(t, err)
+	_, err = SendRegistryCall(context.Background(), httpClient, http.MethodGet, "providers/hashicorp/aws", logger)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Bearer private-token", privateAuth)
+	assert.Empty(t, publicAuth)
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+)
+
+// RegistryStatusError is returned for registry responses with a status other than 200 OK
+type RegistryStatusError struct {
+	StatusCode int
+	Status     string // e.g. "404 Not Found"
+}
+
+func (e *RegistryStatusError) Error() string {
+	return fmt.Sprintf("error: %s", e.Status)
+}
+
+// ErrRegistryNotFound is returned when the registry answers but what was asked for is not among the results, e.g. a
+// version missing from the versions of a provider
+var ErrRegistryNotFound = errors.New("not found in the registry")
+
+// maxRegistryResponseSnippet bounds the part of an unexpected response body quoted in its error
+const maxRegistryResponseSnippet = 200
+
+var htmlTagRegex = regexp.MustCompile(`(?s)<(?:script|style)[^>]*>.*?</(?:script|style)>|<[^>]*>`)
+
+// RegistryUnexpectedResponseError is returned when the registry answers with something else than JSON, typically the
+// HTML error page of a proxy or an outage, instead of failing later with a confusing JSON parse error
+type RegistryUnexpectedResponseError struct {
+	StatusCode  int
+	Status      string
+	ContentType string
+	Snippet     string // the start of the body as text
+}
+
+func (e *RegistryUnexpectedResponseError) Error() string {
+	contentType := e.ContentType
+	if contentType == "" {
+		contentType = "no content type"
+	}
+	return fmt.Sprintf("unexpected response from registry: %s (%s): %q", e.Status, contentType, e.Snippet)
+}
+
+// Unwrap exposes the status of failed responses, so they are classified like any other failed response
+func (e *RegistryUnexpectedResponseError) Unwrap() error {
+	if e.StatusCode == http.StatusOK {
+		return nil
+	}
+	return &RegistryStatusError{StatusCode: e.StatusCode, Status: e.Status}
+}
+
+// isUnexpectedRegistryResponse reports whether a response is an HTML page rather than the JSON the registry API
+// serves. A body starting with a tag is only taken for HTML when the content type does not claim JSON.
+func isUnexpectedRegistryResponse(contentType string, body []byte) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if strings.Contains(mediaType, "html") {
+		return true
+	}
+	return !strings.Contains(mediaType, "json") && bytes.HasPrefix(bytes.TrimSpace(body), []byte("<"))
+}
+
+// newRegistryUnexpectedResponseError quotes the text of the body without its markup, truncated to
+// maxRegistryResponseSnippet characters
+func newRegistryUnexpectedResponseError(statusCode int, status string, contentType string, body []byte) *RegistryUnexpectedResponseError {
+	snippet := strings.Join(strings.Fields(htmlTagRegex.ReplaceAllString(string(body), " ")), " ")
+	if runes := []rune(snippet); len(runes) > maxRegistryResponseSnippet {
+		snippet = string(runes[:maxRegistryResponseSnippet]) + "..."
+	}
+	return &RegistryUnexpectedResponseError{StatusCode: statusCode, Status: status, ContentType: contentType, Snippet: snippet}
+}
+
+// RegistryErrorCode classifies the error of a registry call: the status of a failed response, NOT_FOUND when the
+// response does not include what was asked for, RATE_LIMITED when the outbound rate limit or the call budget of the
+// tool was hit, TIMEOUT when the request or the tool call timed out, and UPSTREAM_ERROR otherwise
+func RegistryErrorCode(err error) utils.ErrorCode {
+	var statusErr *RegistryStatusError
+	switch {
+	case errors.As(err, &statusErr):
+		return utils.ErrorCodeForStatus(statusErr.StatusCode)
+	case errors.Is(err, ErrRegistryNotFound):
+		return utils.ErrorCodeNotFound
+	case errors.Is(err, ErrRegistryThrottled), errors.Is(err, ErrRegistryCallBudgetExceeded):
+		return utils.ErrorCodeRateLimited
+	case errors.Is(err, context.DeadlineExceeded):
+		return utils.ErrorCodeTimeout
+	}
+	return utils.ErrorCodeUpstreamError
+}
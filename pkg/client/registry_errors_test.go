@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryErrorCode(t *testing.T) {
+	tests := []struct {
+		status   int
+		expected utils.ErrorCode
+	}{
+		{status: http.StatusNotFound, expected: utils.ErrorCodeNotFound},
+		{status: http.StatusTooManyRequests, expected: utils.ErrorCodeRateLimited},
+		{status: http.StatusBadRequest, expected: utils.ErrorCodeInvalidArgument},
+		{status: http.StatusBadGateway, expected: utils.ErrorCodeUpstreamError},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer registry.Close()
+
+			_, err := SendRegistryCall(context.Background(), registry.Client(), http.MethodGet, "modules", logger, "v1", registry.URL)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), fmt.Sprintf("%d %s", tt.status, http.StatusText(tt.status)))
+			assert.Equal(t, tt.expected, RegistryErrorCode(fmt.Errorf("wrapped: %w", err)))
+		})
+	}
+
+	assert.Equal(t, utils.ErrorCodeNotFound, RegistryErrorCode(fmt.Errorf("provider version 9.9.9: %w", ErrRegistryNotFound)))
+	assert.Equal(t, utils.ErrorCodeRateLimited, RegistryErrorCode(fmt.Errorf("%w: wait", ErrRegistryThrottled)))
+	assert.Equal(t, utils.ErrorCodeRateLimited, RegistryErrorCode(ErrRegistryCallBudgetExceeded))
+	assert.Equal(t, utils.ErrorCodeTimeout, RegistryErrorCode(fmt.Errorf("registry request: %w", context.DeadlineExceeded)))
+	assert.Equal(t, utils.ErrorCodeUpstreamError, RegistryErrorCode(errors.New("connection reset by peer")))
+}
+
+func TestSendRegistryCallUnexpectedResponse(t *testing.T) {
+	const errorPage = `<!DOCTYPE html><html><head><style>body { color: red; }</style><title>Error</title></head>
+<body><h1>502 Bad Gateway</h1><p>The registry is temporarily unavailable.</p></body></html>`
+	tests := []struct {
+		name        string
+		status      int
+		contentType string
+		expected    utils.ErrorCode
+	}{
+		{name: "html page with 200", status: http.StatusOK, contentType: "text/html; charset=utf-8", expected: utils.ErrorCodeUpstreamError},
+		{name: "html page without content type", status: http.StatusOK, contentType: "", expected: utils.ErrorCodeUpstreamError},
+		{name: "html page with 404", status: http.StatusNotFound, contentType: "text/html", expected: utils.ErrorCodeNotFound},
+		{name: "html page with 502", status: http.StatusBadGateway, contentType: "text/html", expected: utils.ErrorCodeUpstreamError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header()["Content-Type"] = []string{tt.contentType}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(errorPage))
+			}))
+			defer registry.Close()
+
+			_, err := SendRegistryCall(context.Background(), registry.Client(), http.MethodGet, "modules", logger, "v1", registry.URL)
+			require.Error(t, err)
+			var unexpected *RegistryUnexpectedResponseError
+			require.ErrorAs(t, err, &unexpected)
+			assert.Contains(t, err.Error(), "unexpected response from registry")
+			assert.Contains(t, err.Error(), http.StatusText(tt.status))
+			assert.Equal(t, "Error 502 Bad Gateway The registry is temporarily unavailable.", unexpected.Snippet)
+			assert.Equal(t, tt.expected, RegistryErrorCode(err))
+		})
+	}
+}
+
+func TestSendRegistryCallJSONResponse(t *testing.T) {
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Written without a content type, so it is sniffed as text/plain
+		_, _ = w.Write([]byte(`{"modules": []}`))
+	}))
+	defer registry.Close()
+
+	body, err := SendRegistryCall(context.Background(), registry.Client(), http.MethodGet, "modules", logger, "v1", registry.URL)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"modules": []}`, string(body))
+}
+
+func TestNewRegistryUnexpectedResponseErrorTruncatesSnippet(t *testing.T) {
+	err := newRegistryUnexpectedResponseError(http.StatusOK, "200 OK", "text/html", []byte("<p>"+strings.Repeat("a", 500)+"</p>"))
+	assert.Equal(t, strings.Repeat("a", maxRegistryResponseSnippet)+"...", err.Snippet)
+}
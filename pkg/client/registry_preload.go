@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+var preloadProviderRegex = regexp.MustCompile(`^(?:[a-z0-9][a-z0-9-]*/)?[a-z0-9][a-z0-9-]*$`)
+
+// LoadPreloadProvidersFromEnv reads PRELOAD_PROVIDERS, a comma-separated list of providers as "namespace/name", or
+// "name" in defaultNamespace, and returns them as "namespace/name" without duplicates
+func LoadPreloadProvidersFromEnv(defaultNamespace string, logger *log.Logger) []string {
+	seen := make(map[string]bool)
+	var providers []string
+	for _, entry := range splitCommaList(utils.GetEnv("PRELOAD_PROVIDERS", "")) {
+		provider := strings.ToLower(entry)
+		if !preloadProviderRegex.MatchString(provider) {
+			logger.Warnf("Ignoring invalid PRELOAD_PROVIDERS entry %q, expected namespace/name or name", entry)
+			continue
+		}
+		if !strings.Contains(provider, "/") {
+			provider = defaultNamespace + "/" + provider
+		}
+		if !seen[provider] {
+			seen[provider] = true
+			providers = append(providers, provider)
+		}
+	}
+	return providers
+}
+
+// PreloadRegistryProviders fetches the version lists, latest version documentation index and overview of the given
+// providers into the registry response cache in a background goroutine, so the first tool calls about them are
+// answered from the cache. It returns immediately, the preload stops when ctx is cancelled. Without a registry cache
+// (TERRAFORM_REGISTRY_CACHE_TTL) there is nowhere to keep the responses, so nothing is preloaded.
+func PreloadRegistryProviders(ctx context.Context, providers []string, logger *log.Logger) {
+	if len(providers) == 0 {
+		return
+	}
+	if getRegistryCache(logger) == nil {
+		logger.Warnf("PRELOAD_PROVIDERS is set but the registry cache is disabled, set TERRAFORM_REGISTRY_CACHE_TTL to preload providers")
+		return
+	}
+
+	httpClient := createHTTPClient(parseTerraformSkipTLSVerify(context.Background()), logger)
+	go func() {
+		start := time.Now()
+		results := ParallelRegistryCalls(providers, 0, func(provider string) (struct{}, error) {
+			return struct{}{}, preloadRegistryProvider(ctx, httpClient, provider, logger)
+		})
+		preloaded := 0
+		for i, result := range results {
+			if result.Err != nil {
+				logger.Warnf("Failed to preload provider %s: %v", providers[i], result.Err)
+				continue
+			}
+			preloaded++
+		}
+		logger.Infof("Preloaded %d of %d providers into the registry cache in %v", preloaded, len(providers), time.Since(start).Round(time.Millisecond))
+	}()
+}
+
+// preloadRegistryProvider makes the registry requests of the provider lookups tools start with: the version list, the
+// version ids and the documentation index and overview of the latest version
+func preloadRegistryProvider(ctx context.Context, httpClient *http.Client, provider string, logger *log.Logger) error {
+	namespace, name, _ := strings.Cut(provider, "/")
+	version, err := GetLatestProviderVersion(ctx, httpClient, namespace, name, logger)
+	if err != nil {
+		return err
+	}
+	if _, err := SendRegistryCall(ctx, httpClient, http.MethodGet, fmt.Sprintf("providers/%s/%s/%s", namespace, name, version), logger, RegistryAPIVersions().Providers); err != nil {
+		return fmt.Errorf("fetching the documentation index of version %s: %w", version, err)
+	}
+	versionID, err := GetProviderVersionID(ctx, httpClient, namespace, name, version, logger)
+	if err != nil {
+		return err
+	}
+	if _, err := GetProviderOverviewDocs(ctx, httpClient, versionID, logger); err != nil {
+		return fmt.Errorf("fetching the overview of version %s: %w", version, err)
+	}
+	logger.Debugf("Preloaded provider %s version %s", provider, version)
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadPreloadProvidersFromEnv(t *testing.T) {
+	t.Setenv("PRELOAD_PROVIDERS", "")
+	assert.Empty(t, LoadPreloadProvidersFromEnv("hashicorp", logger))
+
+	t.Setenv("PRELOAD_PROVIDERS", "aws, Azurerm, integrations/github, hashicorp/aws, not/a/provider, bad name")
+	assert.Equal(t, []string{"acme/aws", "acme/azurerm", "integrations/github", "hashicorp/aws"}, LoadPreloadProvidersFromEnv("acme", logger))
+}
+
+func TestPreloadRegistryProvider(t *testing.T) {
+	var mu sync.Mutex
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requested = append(requested, r.URL.RequestURI())
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/v1/providers/hashicorp/aws":
+			_, _ = w.Write([]byte(`{"version": "5.0.0"}`))
+		case "/v2/providers/hashicorp/aws":
+			_, _ = w.Write([]byte(`{"included": [{"id": "42", "attributes": {"version": "5.0.0"}}]}`))
+		case "/v2/provider-docs":
+			_, _ = w.Write([]byte(`{"data": []}`))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+	t.Setenv("TERRAFORM_REGISTRY_URL", server.URL)
+
+	require.NoError(t, preloadRegistryProvider(context.Background(), server.Client(), "hashicorp/aws", logger))
+	assert.Equal(t, []string{
+		"/v1/providers/hashicorp/aws",
+		"/v1/providers/hashicorp/aws/5.0.0",
+		"/v2/providers/hashicorp/aws?include=provider-versions",
+		"/v2/provider-docs?filter[provider-version]=42&filter[category]=overview&filter[slug]=index",
+	}, requested)
+}
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// RegistryRateLimitMetaKey is the key under which the registry rate-limit status is exposed in tool result metadata
+const RegistryRateLimitMetaKey = "terraform-mcp-server/registry-rate-limit"
+
+// RegistryRateLimitStatus is the most recent rate-limit state reported by the Terraform registry
+type RegistryRateLimitStatus struct {
+	Limit      int       `json:"limit,omitempty"`
+	Remaining  int       `json:"remaining"`
+	Reset      time.Time `json:"reset,omitzero"`
+	ObservedAt time.Time `json:"observed_at"`
+}
+
+var (
+	// registryRateLimits holds the last observed status per HTTP client, i.e. per session
+	registryRateLimits sync.Map
+)
+
+// IsRateLimitMetadataEnabled reports whether registry rate-limit status should be added to tool result metadata
+func IsRateLimitMetadataEnabled() bool {
+	enabled, err := strconv.ParseBool(utils.GetEnv("MCP_RATE_LIMIT_METADATA", "false"))
+	return err == nil && enabled
+}
+
+// parseRegistryRateLimitHeaders reads the x-ratelimit-* headers returned by the registry
+func parseRegistryRateLimitHeaders(header http.Header) (RegistryRateLimitStatus, bool) {
+	remaining, err := strconv.Atoi(strings.TrimSpace(header.Get("x-ratelimit-remaining")))
+	if err != nil {
+		return RegistryRateLimitStatus{}, false
+	}
+
+	status := RegistryRateLimitStatus{
+		Remaining:  remaining,
+		ObservedAt: time.Now().UTC(),
+	}
+	if limit, err := strconv.Atoi(strings.TrimSpace(header.Get("x-ratelimit-limit"))); err == nil {
+		status.Limit = limit
+	}
+	if reset, err := strconv.ParseInt(strings.TrimSpace(header.Get("x-ratelimit-reset")), 10, 64); err == nil {
+		status.Reset = time.Unix(reset, 0).UTC()
+	}
+	return status, true
+}
+
+// recordRegistryRateLimit stores the rate-limit status of a registry response for the given client
+func recordRegistryRateLimit(client *http.Client, resp *http.Response) {
+	if client == nil || resp == nil {
+		return
+	}
+	if status, ok := parseRegistryRateLimitHeaders(resp.Header); ok {
+		registryRateLimits.Store(sessionHttpClient(client), status)
+	}
+}
+
+// GetRegistryRateLimitStatus returns the last rate-limit status observed by the given client
+func GetRegistryRateLimitStatus(client *http.Client) (RegistryRateLimitStatus, bool) {
+	if value, ok := registryRateLimits.Load(client); ok {
+		return value.(RegistryRateLimitStatus), true
+	}
+	return RegistryRateLimitStatus{}, false
+}
+
+// deleteRegistryRateLimitStatus drops the stored status for a client that is being discarded
+func deleteRegistryRateLimitStatus(client *http.Client) {
+	registryRateLimits.Delete(client)
+}
+
+// RegistryRateLimitMetadataMiddleware adds the session's latest registry rate-limit status to the
+// _meta field of tool results, so agent frameworks can pace their own requests.
+func RegistryRateLimitMetadataMiddleware(logger *log.Logger) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			result, err := next(ctx, request)
+			if err != nil || result == nil {
+				return result, err
+			}
+
+			sessionID := getSessionIDFromContext(ctx)
+			if sessionID == "" {
+				return result, err
+			}
+			httpClient := GetHttpClient(sessionID)
+			if httpClient == nil {
+				return result, err
+			}
+			status, ok := GetRegistryRateLimitStatus(httpClient)
+			if !ok {
+				return result, err
+			}
+
+			if result.Meta == nil {
+				result.Meta = &mcp.Meta{}
+			}
+			if result.Meta.AdditionalFields == nil {
+				result.Meta.AdditionalFields = make(map[string]any)
+			}
+			result.Meta.AdditionalFields[RegistryRateLimitMetaKey] = status
+			logger.Debugf("Attached registry rate-limit status to %s result: %d remaining", request.Params.Name, status.Remaining)
+			return result, err
+		}
+	}
+}
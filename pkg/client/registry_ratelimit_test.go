@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRegistryRateLimitHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("x-ratelimit-limit", "100")
+	header.Set("x-ratelimit-remaining", "42")
+	header.Set("x-ratelimit-reset", "1700000000")
+
+	status, ok := parseRegistryRateLimitHeaders(header)
+	require.True(t, ok)
+	assert.Equal(t, 100, status.Limit)
+	assert.Equal(t, 42, status.Remaining)
+	assert.Equal(t, time.Unix(1700000000, 0).UTC(), status.Reset)
+
+	// Responses without a remaining count are ignored
+	_, ok = parseRegistryRateLimitHeaders(http.Header{})
+	assert.False(t, ok)
+}
+
+func TestRecordRegistryRateLimit(t *testing.T) {
+	httpClient := &http.Client{}
+	defer deleteRegistryRateLimitStatus(httpClient)
+
+	_, ok := GetRegistryRateLimitStatus(httpClient)
+	assert.False(t, ok)
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("x-ratelimit-remaining", "7")
+	recordRegistryRateLimit(httpClient, resp)
+
+	status, ok := GetRegistryRateLimitStatus(httpClient)
+	require.True(t, ok)
+	assert.Equal(t, 7, status.Remaining)
+}
+
+func TestIsRateLimitMetadataEnabled(t *testing.T) {
+	t.Setenv("MCP_RATE_LIMIT_METADATA", "")
+	assert.False(t, IsRateLimitMetadataEnabled())
+
+	t.Setenv("MCP_RATE_LIMIT_METADATA", "true")
+	assert.True(t, IsRateLimitMetadataEnabled())
+
+	t.Setenv("MCP_RATE_LIMIT_METADATA", "not-a-bool")
+	assert.False(t, IsRateLimitMetadataEnabled())
+}
+
+func TestRegistryRateLimitMetadataMiddlewareWithoutSession(t *testing.T) {
+	handler := RegistryRateLimitMetadataMiddleware(logger)(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	result, err := handler(context.Background(), mcp.CallToolRequest{})
+	require.NoError(t, err)
+	assert.Nil(t, result.Meta, "no metadata should be attached without a session")
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// RegistryStartupCheck selects what happens when the registry cannot be reached at startup
+type RegistryStartupCheck string
+
+const (
+	// RegistryStartupCheckWarn logs a warning and starts the server anyway
+	RegistryStartupCheckWarn RegistryStartupCheck = "warn"
+	// RegistryStartupCheckFail refuses to start the server
+	RegistryStartupCheckFail RegistryStartupCheck = "fail"
+	// RegistryStartupCheckOff skips the check
+	RegistryStartupCheckOff RegistryStartupCheck = "off"
+)
+
+// LoadRegistryStartupCheckFromEnv reads TERRAFORM_REGISTRY_STARTUP_CHECK, warn unless set to fail or off
+func LoadRegistryStartupCheckFromEnv(logger *log.Logger) RegistryStartupCheck {
+	value := strings.ToLower(strings.TrimSpace(utils.GetEnv("TERRAFORM_REGISTRY_STARTUP_CHECK", "")))
+	switch RegistryStartupCheck(value) {
+	case "":
+		return RegistryStartupCheckWarn
+	case RegistryStartupCheckWarn, RegistryStartupCheckFail, RegistryStartupCheckOff:
+		return RegistryStartupCheck(value)
+	}
+	logger.Warnf("Invalid TERRAFORM_REGISTRY_STARTUP_CHECK value %q, using default %s", value, RegistryStartupCheckWarn)
+	return RegistryStartupCheckWarn
+}
+
+// CheckRegistryAtStartup probes the configured registry once, through the same proxy and TLS settings as the
+// registry tools, so a misconfigured registry URL, proxy or CA is reported when the server starts rather than on
+// the first tool call. An unreachable registry is only an error in fail mode.
+func CheckRegistryAtStartup(mode RegistryStartupCheck, logger *log.Logger) error {
+	if mode == RegistryStartupCheckOff {
+		return nil
+	}
+	registryURL := GetRegistryURL()
+	httpClient := createHTTPClient(parseTerraformSkipTLSVerify(context.Background()), logger)
+	if err := probeRegistry(httpClient, registryURL, logger); err != nil {
+		err = fmt.Errorf("terraform registry %s is not reachable: %w - check TERRAFORM_REGISTRY_URL, the proxy settings (TERRAFORM_REGISTRY_PROXY, HTTPS_PROXY) and TERRAFORM_REGISTRY_CA_FILE", registryURL, err)
+		if mode == RegistryStartupCheckFail {
+			return err
+		}
+		logger.Warnf("Registry startup check failed, registry tools will not work until it is reachable: %v", err)
+		return nil
+	}
+	logger.Infof("Registry startup check passed: %s is reachable", registryURL)
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadRegistryStartupCheckFromEnv(t *testing.T) {
+	for value, expected := range map[string]RegistryStartupCheck{
+		"":       RegistryStartupCheckWarn,
+		"warn":   RegistryStartupCheckWarn,
+		" FAIL ": RegistryStartupCheckFail,
+		"off":    RegistryStartupCheckOff,
+		"maybe":  RegistryStartupCheckWarn,
+	} {
+		t.Setenv("TERRAFORM_REGISTRY_STARTUP_CHECK", value)
+		assert.Equal(t, expected, LoadRegistryStartupCheckFromEnv(logger), "value %q", value)
+	}
+}
+
+func TestCheckRegistryAtStartup(t *testing.T) {
+	var probes atomic.Int32
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		probes.Add(1)
+		assert.Equal(t, registryDiscoveryPath, r.URL.Path)
+	}))
+	defer registry.Close()
+	t.Setenv("TERRAFORM_REGISTRY_URL", registry.URL)
+
+	require.NoError(t, CheckRegistryAtStartup(RegistryStartupCheckFail, logger))
+	require.NoError(t, CheckRegistryAtStartup(RegistryStartupCheckOff, logger))
+	assert.Equal(t, int32(1), probes.Load(), "expected no probe when the check is off")
+
+	unreachable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	unreachable.Close()
+	t.Setenv("TERRAFORM_REGISTRY_URL", unreachable.URL)
+
+	assert.NoError(t, CheckRegistryAtStartup(RegistryStartupCheckWarn, logger), "expected only a warning in warn mode")
+	err := CheckRegistryAtStartup(RegistryStartupCheckFail, logger)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), unreachable.URL)
+	assert.Contains(t, err.Error(), "TERRAFORM_REGISTRY_URL")
+}
@@ -4,12 +4,19 @@
 package client
 
 import (
+	"compress/gzip"
+	"context"
+	"encoding/pem"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-retryablehttp"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -102,7 +109,7 @@ func TestSendRegistryCall(t *testing.T) {
 			}))
 			defer server.Close()
 
-			_, err := SendRegistryCall(server.Client(), tc.httpMethod, tc.uri, logger, tc.apiVersion, server.URL)
+			_, err := SendRegistryCall(context.Background(), server.Client(), tc.httpMethod, tc.uri, logger, tc.apiVersion, server.URL)
 
 			if tc.expectErrContent == "" {
 				require.NoError(t, err, "TestSendRegistryCall (%s)", tc.name)
@@ -113,3 +120,197 @@ func TestSendRegistryCall(t *testing.T) {
 		})
 	}
 }
+
+func TestSendRegistryCallThroughProxy(t *testing.T) {
+	var proxiedHost, proxiedURL string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A forward proxy receives the absolute URL of the target
+		proxiedHost, proxiedURL = r.Host, r.URL.String()
+		fmt.Fprint(w, `{"data": "proxied"}`)
+	}))
+	defer proxy.Close()
+
+	t.Setenv("TERRAFORM_REGISTRY_URL", "http://registry.mirror.internal/")
+	t.Setenv("TERRAFORM_REGISTRY_PROXY", proxy.URL)
+
+	body, err := SendRegistryCall(context.Background(), createHTTPClient(false, logger), http.MethodGet, "providers/hashicorp/aws", logger)
+	require.NoError(t, err)
+	assert.Equal(t, `{"data": "proxied"}`, string(body))
+	assert.Equal(t, "registry.mirror.internal", proxiedHost)
+	assert.Equal(t, "http://registry.mirror.internal/v1/providers/hashicorp/aws", proxiedURL)
+}
+
+func TestSendRegistryCallVerifiesMirrorTLS(t *testing.T) {
+	mirror := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": "mirror"}`)
+	}))
+	defer mirror.Close()
+	t.Setenv("TERRAFORM_REGISTRY_URL", mirror.URL)
+
+	// The mirror certificate is not trusted by the system pool
+	_, err := SendRegistryCall(context.Background(), createHTTPClient(false, logger), http.MethodGet, "providers/hashicorp/aws", logger)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "certificate")
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: mirror.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, certPEM, 0o600))
+	t.Setenv("TERRAFORM_REGISTRY_CA_FILE", caFile)
+
+	body, err := SendRegistryCall(context.Background(), createHTTPClient(false, logger), http.MethodGet, "providers/hashicorp/aws", logger)
+	require.NoError(t, err)
+	assert.Equal(t, `{"data": "mirror"}`, string(body))
+}
+
+func TestSendRegistryCallCABundleAndInsecureSkipVerify(t *testing.T) {
+	mirror := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": "mirror"}`)
+	}))
+	defer mirror.Close()
+	t.Setenv("TERRAFORM_REGISTRY_URL", mirror.URL)
+	t.Setenv("TERRAFORM_REGISTRY_CA_FILE", "")
+
+	// An invalid flag keeps the verification strict
+	t.Setenv("INSECURE_SKIP_VERIFY", "maybe")
+	_, err := SendRegistryCall(context.Background(), createHTTPClient(false, logger), http.MethodGet, "providers/hashicorp/aws", logger)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "certificate")
+
+	t.Setenv("INSECURE_SKIP_VERIFY", "true")
+	body, err := SendRegistryCall(context.Background(), createHTTPClient(false, logger), http.MethodGet, "providers/hashicorp/aws", logger)
+	require.NoError(t, err)
+	assert.Equal(t, `{"data": "mirror"}`, string(body))
+
+	t.Setenv("INSECURE_SKIP_VERIFY", "")
+	caFile := filepath.Join(t.TempDir(), "bundle.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: mirror.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, certPEM, 0o600))
+	t.Setenv("REGISTRY_CA_BUNDLE", caFile)
+	body, err = SendRegistryCall(context.Background(), createHTTPClient(false, logger), http.MethodGet, "providers/hashicorp/aws", logger)
+	require.NoError(t, err)
+	assert.Equal(t, `{"data": "mirror"}`, string(body))
+}
+
+func TestSendRegistryCallDecompressesGzip(t *testing.T) {
+	payload := `{"data": "` + strings.Repeat("compressible ", 100) + `"}`
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "gzip", r.Header.Get("Accept-Encoding"))
+		if r.URL.Query().Get("plain") != "" {
+			fmt.Fprint(w, payload)
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		writer := gzip.NewWriter(w)
+		fmt.Fprint(writer, payload)
+		writer.Close()
+	}))
+	defer registry.Close()
+	t.Setenv("TERRAFORM_REGISTRY_URL", registry.URL)
+
+	httpClient := createHTTPClient(false, logger)
+	body, err := SendRegistryCall(context.Background(), httpClient, http.MethodGet, "modules/acme/vpc/aws", logger)
+	require.NoError(t, err)
+	assert.Equal(t, payload, string(body))
+
+	// Servers may ignore Accept-Encoding
+	body, err = SendRegistryCall(context.Background(), httpClient, http.MethodGet, "modules/acme/vpc/aws?plain=1", logger)
+	require.NoError(t, err)
+	assert.Equal(t, payload, string(body))
+}
+
+func TestSendRegistryCallAbortsOnCancellation(t *testing.T) {
+	release := make(chan struct{})
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer registry.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := SendRegistryCall(ctx, registry.Client(), http.MethodGet, "providers/hashicorp/aws", logger, "v1", registry.URL)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), context.Canceled.Error())
+	assert.Less(t, time.Since(start), 5*time.Second, "expected the request to be aborted when the context is cancelled")
+}
+
+func TestSendRegistryCallRevalidatesWithETag(t *testing.T) {
+	registryCacheOnce.Do(func() {})
+	previous := registryCache
+	registryCache = NewRegistryCache(time.Millisecond, "", logger)
+	t.Cleanup(func() { registryCache = previous })
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fmt.Fprint(w, `{"data": "docs"}`)
+	}))
+	defer server.Close()
+
+	body, err := SendRegistryCall(context.Background(), server.Client(), http.MethodGet, "providers/hashicorp/aws", logger, "v1", server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, `{"data": "docs"}`, string(body))
+
+	// Once the TTL expired, the cached body is revalidated and served on 304
+	time.Sleep(5 * time.Millisecond)
+	body, err = SendRegistryCall(context.Background(), server.Client(), http.MethodGet, "providers/hashicorp/aws", logger, "v1", server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, `{"data": "docs"}`, string(body))
+	assert.Equal(t, 2, requests)
+}
+
+func TestLoadRegistryPoolConfig(t *testing.T) {
+	config := loadRegistryPoolConfig(logger)
+	assert.Equal(t, registryPoolConfig{MaxIdleConns: 100, MaxIdleConnsPerHost: 20, IdleConnTimeout: 90 * time.Second}, config)
+
+	t.Setenv("TERRAFORM_REGISTRY_MAX_IDLE_CONNS", "50")
+	t.Setenv("TERRAFORM_REGISTRY_MAX_IDLE_CONNS_PER_HOST", "50")
+	t.Setenv("TERRAFORM_REGISTRY_IDLE_CONN_TIMEOUT", "2m")
+	config = loadRegistryPoolConfig(logger)
+	assert.Equal(t, registryPoolConfig{MaxIdleConns: 50, MaxIdleConnsPerHost: 50, IdleConnTimeout: 2 * time.Minute}, config)
+
+	client := createHTTPClient(false, logger)
+	roundTripper, ok := client.Transport.(*retryablehttp.RoundTripper)
+	require.True(t, ok)
+	transport, ok := roundTripper.Client.HTTPClient.Transport.(*http.Transport)
+	require.True(t, ok)
+	assert.Equal(t, 50, transport.MaxIdleConns)
+	assert.Equal(t, 50, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 2*time.Minute, transport.IdleConnTimeout)
+
+	t.Setenv("TERRAFORM_REGISTRY_MAX_IDLE_CONNS", "-1")
+	t.Setenv("TERRAFORM_REGISTRY_MAX_IDLE_CONNS_PER_HOST", "many")
+	t.Setenv("TERRAFORM_REGISTRY_IDLE_CONN_TIMEOUT", "90")
+	config = loadRegistryPoolConfig(logger)
+	assert.Equal(t, registryPoolConfig{MaxIdleConns: 100, MaxIdleConnsPerHost: 20, IdleConnTimeout: 90 * time.Second}, config)
+}
+
+func TestLoadRegistryConnectTimeouts(t *testing.T) {
+	assert.Equal(t, registryConnectTimeouts{DialTimeout: 5 * time.Second, TLSHandshakeTimeout: 5 * time.Second}, loadRegistryConnectTimeouts(logger))
+
+	t.Setenv("TERRAFORM_REGISTRY_DIAL_TIMEOUT", "2s")
+	t.Setenv("TERRAFORM_REGISTRY_TLS_HANDSHAKE_TIMEOUT", "0")
+	assert.Equal(t, registryConnectTimeouts{DialTimeout: 2 * time.Second}, loadRegistryConnectTimeouts(logger))
+
+	client := createHTTPClient(false, logger)
+	roundTripper, ok := client.Transport.(*retryablehttp.RoundTripper)
+	require.True(t, ok)
+	transport, ok := roundTripper.Client.HTTPClient.Transport.(*http.Transport)
+	require.True(t, ok)
+	assert.NotNil(t, transport.DialContext)
+	assert.Equal(t, time.Duration(0), transport.TLSHandshakeTimeout)
+
+	t.Setenv("TERRAFORM_REGISTRY_DIAL_TIMEOUT", "-1s")
+	t.Setenv("TERRAFORM_REGISTRY_TLS_HANDSHAKE_TIMEOUT", "fast")
+	assert.Equal(t, registryConnectTimeouts{DialTimeout: 5 * time.Second, TLSHandshakeTimeout: 5 * time.Second}, loadRegistryConnectTimeouts(logger))
+}
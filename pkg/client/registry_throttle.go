@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+// ErrRegistryThrottled is returned for registry requests that would have waited too long for the outbound rate limit
+var ErrRegistryThrottled = errors.New("outbound registry rate limit exceeded")
+
+const (
+	// defaultRegistryRateLimit is conservative: the public registry limits clients per IP and all sessions share it
+	defaultRegistryRateLimit = rate.Limit(5)
+	defaultRegistryBurst     = 10
+	// registryThrottleMaxWait bounds how long a request queues for the rate limit before failing
+	registryThrottleMaxWait = 30 * time.Second
+)
+
+var (
+	registryLimiter     *rate.Limiter
+	registryLimiterOnce sync.Once
+)
+
+// getRegistryLimiter returns the process wide limiter of outbound registry requests configured with
+// TERRAFORM_REGISTRY_RATE_LIMIT ("rps:burst", or "0" to disable), nil when limiting is disabled
+func getRegistryLimiter(logger *log.Logger) *rate.Limiter {
+	registryLimiterOnce.Do(func() {
+		registryLimiter = newRegistryLimiter(strings.TrimSpace(utils.GetEnv("TERRAFORM_REGISTRY_RATE_LIMIT", "")), logger)
+	})
+	return registryLimiter
+}
+
+func newRegistryLimiter(value string, logger *log.Logger) *rate.Limiter {
+	switch value {
+	case "":
+		return rate.NewLimiter(defaultRegistryRateLimit, defaultRegistryBurst)
+	case "0":
+		logger.Infof("Outbound registry rate limiting is disabled")
+		return nil
+	}
+	rps, burst := parseRateLimit(value)
+	if rps <= 0 || burst <= 0 {
+		logger.Warnf("Invalid TERRAFORM_REGISTRY_RATE_LIMIT format, using default %v rps with burst %d", float64(defaultRegistryRateLimit), defaultRegistryBurst)
+		return rate.NewLimiter(defaultRegistryRateLimit, defaultRegistryBurst)
+	}
+	logger.Infof("Outbound registry rate limit set to %v rps with burst %d", rps, burst)
+	return rate.NewLimiter(rate.Limit(rps), burst)
+}
+
+// waitForRegistryLimiter blocks until the outbound rate limit allows another registry request. Requests that
+// would have to wait longer than registryThrottleMaxWait fail right away rather than hold up the tool call.
+func waitForRegistryLimiter(ctx context.Context, limiter *rate.Limiter) error {
+	if limiter == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, registryThrottleMaxWait)
+	defer cancel()
+	if err := limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("%w: %v", ErrRegistryThrottled, err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestNewRegistryLimiter(t *testing.T) {
+	limiter := newRegistryLimiter("", logger)
+	require.NotNil(t, limiter)
+	assert.Equal(t, defaultRegistryRateLimit, limiter.Limit())
+	assert.Equal(t, defaultRegistryBurst, limiter.Burst())
+
+	limiter = newRegistryLimiter("2.5:4", logger)
+	require.NotNil(t, limiter)
+	assert.Equal(t, rate.Limit(2.5), limiter.Limit())
+	assert.Equal(t, 4, limiter.Burst())
+
+	assert.Nil(t, newRegistryLimiter("0", logger))
+
+	limiter = newRegistryLimiter("fast", logger)
+	require.NotNil(t, limiter)
+	assert.Equal(t, defaultRegistryRateLimit, limiter.Limit())
+}
+
+func TestWaitForRegistryLimiter(t *testing.T) {
+	assert.NoError(t, waitForRegistryLimiter(context.Background(), nil))
+
+	// One request per minute: the burst passes, the next one would wait longer than the maximum
+	limiter := rate.NewLimiter(rate.Limit(1.0/60), 2)
+	assert.NoError(t, waitForRegistryLimiter(context.Background(), limiter))
+	assert.NoError(t, waitForRegistryLimiter(context.Background(), limiter))
+	err := waitForRegistryLimiter(context.Background(), limiter)
+	assert.ErrorIs(t, err, ErrRegistryThrottled)
+}
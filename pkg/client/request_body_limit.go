@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultMaxRequestBodyBytes is the size limit of MCP request bodies when MCP_MAX_REQUEST_BODY_BYTES is unset,
+// far above any legitimate JSON-RPC message
+const DefaultMaxRequestBodyBytes int64 = 4 << 20
+
+// LoadMaxRequestBodyBytesFromEnv reads MCP_MAX_REQUEST_BODY_BYTES, the maximum size of the body of a request to the
+// MCP endpoint. 0 means unlimited.
+func LoadMaxRequestBodyBytesFromEnv(logger *log.Logger) int64 {
+	value := strings.TrimSpace(utils.GetEnv("MCP_MAX_REQUEST_BODY_BYTES", ""))
+	if value == "" {
+		return DefaultMaxRequestBodyBytes
+	}
+	maxBytes, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || maxBytes < 0 {
+		logger.Warnf("Invalid MCP_MAX_REQUEST_BODY_BYTES value %q, using default %d", value, DefaultMaxRequestBodyBytes)
+		return DefaultMaxRequestBodyBytes
+	}
+	return maxBytes
+}
+
+// RequestBodyLimitMiddleware rejects requests with a body larger than maxBytes with 413 Request Entity Too Large.
+// Bodies are read up to the limit before the request is passed on, so the transport never sees a truncated message.
+func RequestBodyLimitMiddleware(maxBytes int64, logger *log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if maxBytes <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > maxBytes {
+				rejectRequestBody(w, r, maxBytes, logger)
+				return
+			}
+			if r.Body != nil && r.Body != http.NoBody {
+				body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBytes))
+				if err != nil {
+					var maxBytesErr *http.MaxBytesError
+					if errors.As(err, &maxBytesErr) {
+						rejectRequestBody(w, r, maxBytes, logger)
+						return
+					}
+					http.Error(w, "Failed to read request body", http.StatusBadRequest)
+					return
+				}
+				r.Body = io.NopCloser(bytes.NewReader(body))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func rejectRequestBody(w http.ResponseWriter, r *http.Request, maxBytes int64, logger *log.Logger) {
+	LoggerFromContext(r.Context(), logger).Warnf("Rejected %s %s with a body larger than %d bytes (MCP_MAX_REQUEST_BODY_BYTES)", r.Method, r.URL.Path, maxBytes)
+	http.Error(w, "Request body too large", http.StatusRequestEntityTooLarge)
+}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestBodyLimitMiddleware(t *testing.T) {
+	var received string
+	handler := RequestBodyLimitMiddleware(16, logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+	}))
+
+	tests := []struct {
+		name          string
+		body          string
+		contentLength int64
+		expected      int
+	}{
+		{name: "within the limit", body: `{"id":1}`, contentLength: 8, expected: http.StatusOK},
+		{name: "at the limit", body: strings.Repeat("a", 16), contentLength: 16, expected: http.StatusOK},
+		{name: "declared too large", body: strings.Repeat("a", 17), contentLength: 17, expected: http.StatusRequestEntityTooLarge},
+		{name: "chunked too large", body: strings.Repeat("a", 64), contentLength: -1, expected: http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received = ""
+			req := httptest.NewRequest(http.MethodPost, "/mcp", strings.NewReader(tt.body))
+			req.ContentLength = tt.contentLength
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tt.expected, recorder.Code)
+			if tt.expected == http.StatusOK {
+				assert.Equal(t, tt.body, received)
+			} else {
+				assert.Empty(t, received)
+			}
+		})
+	}
+}
+
+func TestLoadMaxRequestBodyBytesFromEnv(t *testing.T) {
+	t.Setenv("MCP_MAX_REQUEST_BODY_BYTES", "")
+	assert.Equal(t, DefaultMaxRequestBodyBytes, LoadMaxRequestBodyBytesFromEnv(logger))
+
+	t.Setenv("MCP_MAX_REQUEST_BODY_BYTES", "1024")
+	assert.Equal(t, int64(1024), LoadMaxRequestBodyBytesFromEnv(logger))
+
+	t.Setenv("MCP_MAX_REQUEST_BODY_BYTES", "0")
+	assert.Equal(t, int64(0), LoadMaxRequestBodyBytesFromEnv(logger))
+
+	t.Setenv("MCP_MAX_REQUEST_BODY_BYTES", "lots")
+	assert.Equal(t, DefaultMaxRequestBodyBytes, LoadMaxRequestBodyBytesFromEnv(logger))
+}
@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"regexp"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestIDHeader carries the ID correlating the logs of an MCP request, accepted from clients and echoed in responses
+const RequestIDHeader = "X-Request-ID"
+
+// requestIDKey is the context key of the ID of the current MCP request
+const requestIDKey = contextKey("request-id")
+
+// loggerKey is the context key of the logger of the current MCP request
+const loggerKey = contextKey("logger")
+
+// validRequestID restricts client supplied IDs to a length and character set that cannot forge log lines
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:/+=-]{1,128}$`)
+
+// RequestIDMiddleware assigns every MCP request an ID, reusing a valid X-Request-ID sent by the client and
+// generating one otherwise. The ID is returned in the response headers and stored in the request context with a
+// logger adding it to every entry, which the tool handlers and registry requests log with.
+func RequestIDMiddleware(logger *log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(RequestIDHeader)
+			if !validRequestID.MatchString(requestID) {
+				if requestID != "" {
+					logger.Debugf("Ignoring invalid %s header, generating a new request ID", RequestIDHeader)
+				}
+				requestID = newRequestID()
+			}
+			w.Header().Set(RequestIDHeader, requestID)
+
+			start := time.Now()
+			ctx := context.WithValue(r.Context(), requestIDKey, requestID)
+			ctx = context.WithValue(ctx, loggerKey, newRequestLogger(logger, requestID))
+			next.ServeHTTP(w, r.WithContext(ctx))
+			logger.WithFields(log.Fields{
+				"request_id":  requestID,
+				"method":      r.Method,
+				"path":        r.URL.Path,
+				"duration_ms": time.Since(start).Milliseconds(),
+			}).Debugf("Handled %s %s", r.Method, r.URL.Path)
+		})
+	}
+}
+
+// RequestIDFromContext returns the ID of the MCP request ctx belongs to, empty outside of HTTP requests
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	return requestID
+}
+
+// LoggerFromContext returns the logger of the MCP request ctx belongs to, which adds its request_id to every entry,
+// or logger outside of HTTP requests
+func LoggerFromContext(ctx context.Context, logger *log.Logger) *log.Logger {
+	if requestLogger, ok := ctx.Value(loggerKey).(*log.Logger); ok {
+		return requestLogger
+	}
+	return logger
+}
+
+// requestIDHook adds the ID of an MCP request to the entries of its logger
+type requestIDHook string
+
+func (h requestIDHook) Levels() []log.Level {
+	return log.AllLevels
+}
+
+func (h requestIDHook) Fire(entry *log.Entry) error {
+	if _, ok := entry.Data["request_id"]; !ok {
+		entry.Data["request_id"] = string(h)
+	}
+	return nil
+}
+
+// newRequestLogger returns a logger writing to the same output with the same settings as logger, adding requestID
+// to every entry. It is a *log.Logger rather than an entry so the tool handlers can use it unchanged.
+func newRequestLogger(logger *log.Logger, requestID string) *log.Logger {
+	hooks := make(log.LevelHooks, len(logger.Hooks))
+	for level, levelHooks := range logger.Hooks {
+		hooks[level] = append([]log.Hook(nil), levelHooks...)
+	}
+	hooks.Add(requestIDHook(requestID))
+	return &log.Logger{
+		Out:          logger.Out,
+		Hooks:        hooks,
+		Formatter:    logger.Formatter,
+		ReportCaller: logger.ReportCaller,
+		Level:        logger.GetLevel(),
+		ExitFunc:     logger.ExitFunc,
+		BufferPool:   logger.BufferPool,
+	}
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return time.Now().UTC().Format("20060102T150405.000000000")
+	}
+	return hex.EncodeToString(b)
+}
+
+// callContextTransport carries the context of the tool call a session client is used for, so registry requests
+// made through it can be attributed to the MCP request and trace they belong to. The request ID is forwarded to
+// the registry, so registry side logs can be correlated as well.
+type callContextTransport struct {
+	base   http.RoundTripper
+	parent *http.Client
+	ctx    context.Context
+}
+
+func (t *callContextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if requestID := RequestIDFromContext(t.ctx); requestID != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set(RequestIDHeader, requestID)
+	}
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
+
+// withCallContext returns a copy of the session client tagged with the request ID and trace of ctx
+func withCallContext(ctx context.Context, client *http.Client) *http.Client {
+	if client == nil || (RequestIDFromContext(ctx) == "" && !trace.SpanContextFromContext(ctx).IsValid()) {
+		return client
+	}
+	tagged := *client
+	tagged.Transport = &callContextTransport{base: client.Transport, parent: client, ctx: ctx}
+	return &tagged
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	var seen string
+	handler := RequestIDMiddleware(logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = RequestIDFromContext(r.Context())
+	}))
+
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{name: "client supplied", header: "trace-1234.abc", expected: "trace-1234.abc"},
+		{name: "generated when missing"},
+		{name: "generated when invalid", header: "bad id\nlevel=error"},
+		{name: "generated when too long", header: strings.Repeat("a", 129)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+			if tt.header != "" {
+				req.Header.Set(RequestIDHeader, tt.header)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, seen, recorder.Header().Get(RequestIDHeader))
+			if tt.expected != "" {
+				assert.Equal(t, tt.expected, seen)
+			} else {
+				assert.Len(t, seen, 32)
+				assert.NotEqual(t, tt.header, seen)
+			}
+		})
+	}
+}
+
+func TestRequestIDPropagatesToRegistryCalls(t *testing.T) {
+	var forwarded string
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		forwarded = r.Header.Get(RequestIDHeader)
+		w.Write([]byte(`{}`))
+	}))
+	defer registry.Close()
+
+	session := &http.Client{}
+	ctx := context.WithValue(context.Background(), requestIDKey, "req-42")
+	budget := &registryCallBudget{limit: 5}
+	httpClient := withRegistryCallBudget(context.WithValue(ctx, registryCallBudgetKey, budget), withCallContext(ctx, session))
+
+	assert.Same(t, session, sessionHttpClient(httpClient))
+
+	_, err := SendRegistryCall(ctx, httpClient, http.MethodGet, "providers/hashicorp/aws", logger, "v1", registry.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "req-42", forwarded)
+
+	// Without a request ID the session client is used as is
+	assert.Same(t, session, withCallContext(context.Background(), session))
+}
+
+func TestRequestIDMiddlewareLogger(t *testing.T) {
+	var out bytes.Buffer
+	base := log.New()
+	base.SetOutput(&out)
+	base.SetFormatter(&log.JSONFormatter{})
+
+	handler := RequestIDMiddleware(base)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		LoggerFromContext(r.Context(), base).Info("handled")
+	}))
+	req := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+	req.Header.Set(RequestIDHeader, "req-7")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Contains(t, out.String(), `"msg":"handled","request_id":"req-7"`)
+	assert.Same(t, base, LoggerFromContext(context.Background(), base), "Outside of HTTP requests the logger is used as is")
+}
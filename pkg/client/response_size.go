@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// LoadMaxResponseBytesFromEnv reads MAX_RESPONSE_BYTES, the maximum size of the text of a tool result. 0 means unlimited.
+func LoadMaxResponseBytesFromEnv(logger *log.Logger) int {
+	value := strings.TrimSpace(utils.GetEnv("MAX_RESPONSE_BYTES", ""))
+	if value == "" {
+		return 0
+	}
+	maxBytes, err := strconv.Atoi(value)
+	if err != nil || maxBytes < 0 {
+		logger.Warnf("Invalid MAX_RESPONSE_BYTES value %q, tool responses are not limited", value)
+		return 0
+	}
+	return maxBytes
+}
+
+// ResponseSizeMiddleware truncates the text of tool results larger than maxBytes, ending it with a marker stating how
+// much was cut. Structured content no longer matches the truncated text, so it is dropped from truncated results.
+func ResponseSizeMiddleware(maxBytes int, logger *log.Logger) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			result, err := next(ctx, request)
+			if err != nil || result == nil {
+				return result, err
+			}
+
+			size := 0
+			for _, content := range result.Content {
+				if text, ok := content.(mcp.TextContent); ok {
+					size += len(text.Text)
+				}
+			}
+			if size <= maxBytes {
+				return result, nil
+			}
+
+			marker := fmt.Sprintf("\n\n...truncated (response exceeded %d bytes, %d bytes omitted)", maxBytes, size-maxBytes)
+			budget := max(maxBytes-len(marker), 0)
+			contents := make([]mcp.Content, 0, len(result.Content))
+			for _, content := range result.Content {
+				text, ok := content.(mcp.TextContent)
+				if !ok {
+					contents = append(contents, content)
+					continue
+				}
+				if budget == 0 {
+					continue
+				}
+				text.Text = truncateUTF8(text.Text, budget)
+				budget -= len(text.Text)
+				contents = append(contents, text)
+			}
+			contents = append(contents, mcp.NewTextContent(marker))
+
+			fields := log.Fields{"tool": request.Params.Name, "bytes": size}
+			if requestID := RequestIDFromContext(ctx); requestID != "" {
+				fields["request_id"] = requestID
+			}
+			logger.WithFields(fields).Warnf("Truncated the result of %s from %d to %d bytes (MAX_RESPONSE_BYTES)", request.Params.Name, size, maxBytes)
+			result.Content = contents
+			result.StructuredContent = nil
+			utils.TakeResultData(result)
+			return result, nil
+		}
+	}
+}
+
+// truncateUTF8 returns the longest prefix of s of at most n bytes that does not split a character
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadMaxResponseBytesFromEnv(t *testing.T) {
+	t.Setenv("MAX_RESPONSE_BYTES", "")
+	assert.Equal(t, 0, LoadMaxResponseBytesFromEnv(logger))
+
+	t.Setenv("MAX_RESPONSE_BYTES", "1000")
+	assert.Equal(t, 1000, LoadMaxResponseBytesFromEnv(logger))
+
+	t.Setenv("MAX_RESPONSE_BYTES", "-5")
+	assert.Equal(t, 0, LoadMaxResponseBytesFromEnv(logger))
+
+	t.Setenv("MAX_RESPONSE_BYTES", "1MB")
+	assert.Equal(t, 0, LoadMaxResponseBytesFromEnv(logger))
+}
+
+func TestResponseSizeMiddleware(t *testing.T) {
+	call := func(result *mcp.CallToolResult, maxBytes int) *mcp.CallToolResult {
+		handler := ResponseSizeMiddleware(maxBytes, logger)(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return result, nil
+		})
+		request := mcp.CallToolRequest{}
+		request.Params.Name = "get_provider_details"
+		out, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		return out
+	}
+	text := func(result *mcp.CallToolResult) string {
+		var builder strings.Builder
+		for _, content := range result.Content {
+			builder.WriteString(content.(mcp.TextContent).Text)
+		}
+		return builder.String()
+	}
+
+	t.Run("small result unchanged", func(t *testing.T) {
+		result := call(mcp.NewToolResultText("short"), 100)
+		assert.Equal(t, "short", text(result))
+	})
+
+	t.Run("large result truncated within the limit", func(t *testing.T) {
+		result := call(mcp.NewToolResultText(strings.Repeat("a", 500)), 200)
+		out := text(result)
+		assert.LessOrEqual(t, len(out), 200)
+		assert.True(t, strings.HasPrefix(out, "aaaa"))
+		assert.Contains(t, out, "...truncated (response exceeded 200 bytes, 300 bytes omitted)")
+	})
+
+	t.Run("multiple contents", func(t *testing.T) {
+		result := call(&mcp.CallToolResult{Content: []mcp.Content{
+			mcp.NewTextContent(strings.Repeat("a", 150)),
+			mcp.NewTextContent(strings.Repeat("b", 150)),
+		}}, 200)
+		out := text(result)
+		assert.LessOrEqual(t, len(out), 200)
+		assert.NotContains(t, out, "bb")
+		assert.Contains(t, out, "...truncated")
+	})
+
+	t.Run("multi-byte characters are not split", func(t *testing.T) {
+		result := call(mcp.NewToolResultText(strings.Repeat("é", 200)), 150)
+		out := text(result)
+		assert.True(t, strings.HasPrefix(out, "éé"))
+		assert.True(t, utf8.ValidString(out))
+	})
+
+	t.Run("structured content dropped", func(t *testing.T) {
+		result := call(mcp.NewToolResultStructured(map[string]string{"a": "b"}, strings.Repeat("x", 500)), 200)
+		assert.Nil(t, result.StructuredContent)
+		assert.Contains(t, text(result), "...truncated")
+	})
+}
+
+func TestTruncateUTF8(t *testing.T) {
+	assert.Equal(t, "abc", truncateUTF8("abc", 10))
+	assert.Equal(t, "ab", truncateUTF8("abc", 2))
+	assert.Equal(t, "a", truncateUTF8("aé", 2))
+	assert.Equal(t, "", truncateUTF8("é", 1))
+}
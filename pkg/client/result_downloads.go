@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// ResultDownloadPath is the HTTP path prefix large tool results are downloaded from, followed by the download token
+const ResultDownloadPath = "/downloads/"
+
+const (
+	defaultResultDownloadThreshold = 50000
+	defaultResultDownloadTTL       = 10 * time.Minute
+	defaultResultDownloadMaxBytes  = 100 << 20
+)
+
+// ResultDownloadConfig controls when tool results are offloaded to the download endpoint in HTTP mode
+type ResultDownloadConfig struct {
+	Enabled   bool
+	Threshold int           // results with more characters than this are offloaded
+	TTL       time.Duration // how long a download token stays valid
+	BaseURL   string        // public URL of the server used in download links, empty for a relative link
+	MaxBytes  int           // total size of the stored results, the oldest are evicted beyond it, 0 for no limit
+}
+
+// LoadResultDownloadConfigFromEnv reads MCP_RESULT_DOWNLOADS_ENABLED, MCP_RESULT_DOWNLOAD_THRESHOLD,
+// MCP_RESULT_DOWNLOAD_TTL, MCP_RESULT_DOWNLOAD_MAX_BYTES and MCP_RESULT_DOWNLOAD_BASE_URL
+func LoadResultDownloadConfigFromEnv(logger *log.Logger) ResultDownloadConfig {
+	config := ResultDownloadConfig{
+		Threshold: defaultResultDownloadThreshold,
+		TTL:       defaultResultDownloadTTL,
+		MaxBytes:  defaultResultDownloadMaxBytes,
+		BaseURL:   strings.TrimRight(strings.TrimSpace(utils.GetEnv("MCP_RESULT_DOWNLOAD_BASE_URL", "")), "/"),
+	}
+	enabled, err := strconv.ParseBool(strings.TrimSpace(utils.GetEnv("MCP_RESULT_DOWNLOADS_ENABLED", "false")))
+	config.Enabled = err == nil && enabled
+
+	if value := strings.TrimSpace(utils.GetEnv("MCP_RESULT_DOWNLOAD_THRESHOLD", "")); value != "" {
+		threshold, err := strconv.Atoi(value)
+		if err != nil || threshold <= 0 {
+			logger.Warnf("Invalid MCP_RESULT_DOWNLOAD_THRESHOLD value %q, using default %d", value, defaultResultDownloadThreshold)
+		} else {
+			config.Threshold = threshold
+		}
+	}
+	if value := strings.TrimSpace(utils.GetEnv("MCP_RESULT_DOWNLOAD_TTL", "")); value != "" {
+		ttl, err := time.ParseDuration(value)
+		if err != nil || ttl <= 0 {
+			logger.Warnf("Invalid MCP_RESULT_DOWNLOAD_TTL value %q, using default %v", value, defaultResultDownloadTTL)
+		} else {
+			config.TTL = ttl
+		}
+	}
+	if value := strings.TrimSpace(utils.GetEnv("MCP_RESULT_DOWNLOAD_MAX_BYTES", "")); value != "" {
+		maxBytes, err := strconv.Atoi(value)
+		if err != nil || maxBytes < 0 {
+			logger.Warnf("Invalid MCP_RESULT_DOWNLOAD_MAX_BYTES value %q, using default %d", value, defaultResultDownloadMaxBytes)
+		} else {
+			config.MaxBytes = maxBytes
+		}
+	}
+	return config
+}
+
+type resultDownload struct {
+	filename  string
+	content   string
+	expiresAt time.Time
+}
+
+// ResultDownloadStore holds offloaded tool results until their download token expires
+type ResultDownloadStore struct {
+	config  ResultDownloadConfig
+	mu      sync.Mutex
+	entries map[string]resultDownload
+	size    int // total length of the stored contents
+	now     func() time.Time
+}
+
+// NewResultDownloadStore creates an empty store for the given configuration
+func NewResultDownloadStore(config ResultDownloadConfig) *ResultDownloadStore {
+	return &ResultDownloadStore{
+		config:  config,
+		entries: make(map[string]resultDownload),
+		now:     time.Now,
+	}
+}
+
+// Put stores content and returns the token it can be downloaded with until it expires. The oldest downloads are
+// evicted when the store would grow beyond its size limit.
+func (s *ResultDownloadStore) Put(filename string, content string) (string, error) {
+	if s.config.MaxBytes > 0 && len(content) > s.config.MaxBytes {
+		return "", fmt.Errorf("result of %d bytes exceeds the download store limit of %d bytes", len(content), s.config.MaxBytes)
+	}
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("generating download token: %w", err)
+	}
+	token := hex.EncodeToString(raw)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.removeExpiredLocked()
+	for s.config.MaxBytes > 0 && s.size+len(content) > s.config.MaxBytes {
+		s.removeOldestLocked()
+	}
+	s.entries[token] = resultDownload{filename: filename, content: content, expiresAt: s.now().Add(s.config.TTL)}
+	s.size += len(content)
+	return token, nil
+}
+
+// get returns the download of a token that has not expired
+func (s *ResultDownloadStore) get(token string) (resultDownload, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.removeExpiredLocked()
+	download, ok := s.entries[token]
+	return download, ok
+}
+
+func (s *ResultDownloadStore) removeExpiredLocked() {
+	now := s.now()
+	for token, download := range s.entries {
+		if now.After(download.expiresAt) {
+			s.removeLocked(token)
+		}
+	}
+}
+
+// removeOldestLocked evicts the download stored first, every download lives for the same TTL
+func (s *ResultDownloadStore) removeOldestLocked() {
+	oldestToken := ""
+	var oldest time.Time
+	for token, download := range s.entries {
+		if oldestToken == "" || download.expiresAt.Before(oldest) {
+			oldestToken, oldest = token, download.expiresAt
+		}
+	}
+	s.removeLocked(oldestToken)
+}
+
+func (s *ResultDownloadStore) removeLocked(token string) {
+	s.size -= len(s.entries[token].content)
+	delete(s.entries, token)
+}
+
+// URL returns the download link of a token
+func (s *ResultDownloadStore) URL(token string) string {
+	return s.config.BaseURL + ResultDownloadPath + token
+}
+
+// ServeHTTP serves a stored result as an attachment. Unknown and expired tokens get a 404.
+func (s *ResultDownloadStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	download, ok := s.get(strings.TrimPrefix(r.URL.Path, ResultDownloadPath))
+	if !ok {
+		http.Error(w, "download not found or expired", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", download.filename))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodGet {
+		_, _ = w.Write([]byte(download.content))
+	}
+}
+
+// Middleware replaces text results larger than the configured threshold with a short note linking to the download
+func (s *ResultDownloadStore) Middleware(logger *log.Logger) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			result, err := next(ctx, request)
+			if err != nil || result == nil || result.IsError || result.StructuredContent != nil {
+				return result, err
+			}
+
+			var builder strings.Builder
+			for _, content := range result.Content {
+				text, ok := content.(mcp.TextContent)
+				if !ok {
+					// Only plain text results are offloaded
+					return result, nil
+				}
+				builder.WriteString(text.Text)
+			}
+			if builder.Len() <= s.config.Threshold {
+				return result, nil
+			}
+
+			token, putErr := s.Put(request.Params.Name+"-result.md", builder.String())
+			if putErr != nil {
+				logger.WithError(putErr).Warnf("Unable to offload the result of %s, returning it inline", request.Params.Name)
+				return result, nil
+			}
+			logger.Debugf("Offloaded %d characters of %s result to a download", builder.Len(), request.Params.Name)
+			result.Content = []mcp.Content{mcp.NewTextContent(fmt.Sprintf(
+				"The result of %s is %d characters, too large to return inline. It can be downloaded for the next %v from: %s",
+				request.Params.Name, builder.Len(), s.config.TTL, s.URL(token)))}
+			// The typed data is as large as the text, the JSON output format returns the download note instead
+			utils.TakeResultData(result)
+			return result, nil
+		}
+	}
+}
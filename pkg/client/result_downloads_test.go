@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadResultDownloadConfigFromEnv(t *testing.T) {
+	t.Setenv("MCP_RESULT_DOWNLOADS_ENABLED", "true")
+	t.Setenv("MCP_RESULT_DOWNLOAD_THRESHOLD", "100")
+	t.Setenv("MCP_RESULT_DOWNLOAD_TTL", "1m")
+	t.Setenv("MCP_RESULT_DOWNLOAD_MAX_BYTES", "1000")
+	t.Setenv("MCP_RESULT_DOWNLOAD_BASE_URL", "https://mcp.example.com/terraform/")
+
+	config := LoadResultDownloadConfigFromEnv(logger)
+	assert.True(t, config.Enabled)
+	assert.Equal(t, 100, config.Threshold)
+	assert.Equal(t, time.Minute, config.TTL)
+	assert.Equal(t, 1000, config.MaxBytes)
+	assert.Equal(t, "https://mcp.example.com/terraform", config.BaseURL)
+
+	t.Setenv("MCP_RESULT_DOWNLOADS_ENABLED", "")
+	t.Setenv("MCP_RESULT_DOWNLOAD_THRESHOLD", "-1")
+	t.Setenv("MCP_RESULT_DOWNLOAD_TTL", "soon")
+	t.Setenv("MCP_RESULT_DOWNLOAD_MAX_BYTES", "lots")
+	config = LoadResultDownloadConfigFromEnv(logger)
+	assert.False(t, config.Enabled)
+	assert.Equal(t, defaultResultDownloadThreshold, config.Threshold)
+	assert.Equal(t, defaultResultDownloadTTL, config.TTL)
+	assert.Equal(t, defaultResultDownloadMaxBytes, config.MaxBytes)
+}
+
+func TestResultDownloadMiddleware(t *testing.T) {
+	store := NewResultDownloadStore(ResultDownloadConfig{Enabled: true, Threshold: 10, TTL: time.Minute, BaseURL: "https://mcp.example.com"})
+	large := strings.Repeat("x", 20)
+	handler := store.Middleware(logger)(func(_ context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if request.Params.Name == "small" {
+			return mcp.NewToolResultText("short"), nil
+		}
+		return mcp.NewToolResultText(large), nil
+	})
+
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "small"
+	result, err := handler(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, "short", result.Content[0].(mcp.TextContent).Text)
+
+	request.Params.Name = "get_provider_details"
+	result, err = handler(context.Background(), request)
+	require.NoError(t, err)
+	require.Len(t, result.Content, 1)
+	text := result.Content[0].(mcp.TextContent).Text
+	assert.Contains(t, text, "20 characters")
+	require.Contains(t, text, "https://mcp.example.com"+ResultDownloadPath)
+
+	token := text[strings.LastIndex(text, "/")+1:]
+	recorder := httptest.NewRecorder()
+	store.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, ResultDownloadPath+token, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, large, recorder.Body.String())
+	assert.Equal(t, `attachment; filename="get_provider_details-result.md"`, recorder.Header().Get("Content-Disposition"))
+}
+
+func TestResultDownloadStoreExpiry(t *testing.T) {
+	store := NewResultDownloadStore(ResultDownloadConfig{TTL: time.Minute})
+	now := time.Now()
+	store.now = func() time.Time { return now }
+
+	token, err := store.Put("result.md", "content")
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	store.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, ResultDownloadPath+token, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	now = now.Add(2 * time.Minute)
+	recorder = httptest.NewRecorder()
+	store.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, ResultDownloadPath+token, nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	store.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, ResultDownloadPath+"unknown", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestResultDownloadStoreEvictsOldest(t *testing.T) {
+	store := NewResultDownloadStore(ResultDownloadConfig{TTL: time.Minute, MaxBytes: 10})
+	now := time.Now()
+	store.now = func() time.Time { return now }
+
+	first, err := store.Put("first.md", "aaaa")
+	require.NoError(t, err)
+	now = now.Add(time.Second)
+	second, err := store.Put("second.md", "bbbb")
+	require.NoError(t, err)
+	now = now.Add(time.Second)
+	third, err := store.Put("third.md", "cccc")
+	require.NoError(t, err)
+
+	_, ok := store.get(first)
+	assert.False(t, ok, "the oldest download should be evicted to stay within the limit")
+	_, ok = store.get(second)
+	assert.True(t, ok)
+	_, ok = store.get(third)
+	assert.True(t, ok)
+	assert.Equal(t, 8, store.size)
+
+	_, err = store.Put("large.md", strings.Repeat("x", 11))
+	assert.Error(t, err, "a result larger than the limit should not be stored")
+	assert.Equal(t, 8, store.size)
+}
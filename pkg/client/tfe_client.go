@@ -68,6 +68,9 @@ func DeleteTfeClient(sessionId string) {
 
 // GetTfeClientFromContext extracts TFE client from the MCP context
 func GetTfeClientFromContext(ctx context.Context, logger *log.Logger) (*tfe.Client, error) {
+	if err := stopDryRun(ctx); err != nil {
+		return nil, err
+	}
 	session := server.ClientSessionFromContext(ctx)
 	if session == nil {
 		return nil, fmt.Errorf("no active session")
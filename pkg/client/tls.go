@@ -51,8 +51,13 @@ func GetTLSConfigFromEnv() (*TLSConfig, error) {
 		return nil, fmt.Errorf("invalid TLS certificate/key pair: %w", err)
 	}
 
+	minVersion, err := parseTLSMinVersion(os.Getenv("MCP_TLS_MIN_VERSION"))
+	if err != nil {
+		return nil, err
+	}
+
 	tlsConfig := &tls.Config{
-		MinVersion: tls.VersionTLS12,
+		MinVersion: minVersion,
 		CurvePreferences: []tls.CurveID{
 			tls.X25519,
 			tls.CurveP256,
@@ -76,6 +81,19 @@ func GetTLSConfigFromEnv() (*TLSConfig, error) {
 	}, nil
 }
 
+// parseTLSMinVersion converts the MCP_TLS_MIN_VERSION value into a crypto/tls version constant.
+// TLS 1.2 is used when the value is empty.
+func parseTLSMinVersion(value string) (uint16, error) {
+	switch strings.TrimPrefix(strings.ToLower(strings.TrimSpace(value)), "tls") {
+	case "", "1.2", "12":
+		return tls.VersionTLS12, nil
+	case "1.3", "13":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("unsupported MCP_TLS_MIN_VERSION %q: must be 1.2 or 1.3", value)
+	}
+}
+
 func IsLocalHost(host string) bool {
 	h := strings.ToLower(host)
 	return h == "localhost" ||
@@ -165,3 +165,51 @@ func TestTLSConfigValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTLSMinVersion(t *testing.T) {
+	tests := []struct {
+		value     string
+		expected  uint16
+		wantError bool
+	}{
+		{"", tls.VersionTLS12, false},
+		{"1.2", tls.VersionTLS12, false},
+		{"TLS1.3", tls.VersionTLS13, false},
+		{" 1.3 ", tls.VersionTLS13, false},
+		{"1.1", 0, true},
+		{"invalid", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			version, err := parseTLSMinVersion(tt.value)
+			if tt.wantError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, version)
+		})
+	}
+}
+
+func TestTLSConfigWithMinVersion(t *testing.T) {
+	tmpDir := t.TempDir()
+	certFile := tmpDir + "/cert.pem"
+	keyFile := tmpDir + "/key.pem"
+	require.NoError(t, os.WriteFile(certFile, []byte(certPEM), 0600))
+	require.NoError(t, os.WriteFile(keyFile, []byte(keyPEM), 0600))
+
+	t.Setenv("MCP_TLS_CERT_FILE", certFile)
+	t.Setenv("MCP_TLS_KEY_FILE", keyFile)
+
+	t.Setenv("MCP_TLS_MIN_VERSION", "1.3")
+	tlsConfig, err := GetTLSConfigFromEnv()
+	require.NoError(t, err)
+	require.Equal(t, uint16(tls.VersionTLS13), tlsConfig.Config.MinVersion)
+
+	t.Setenv("MCP_TLS_MIN_VERSION", "1.0")
+	tlsConfig, err = GetTLSConfigFromEnv()
+	require.Error(t, err)
+	require.Nil(t, tlsConfig)
+}
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultMaxConcurrentToolCalls is the number of tool calls run at the same time in HTTP mode when
+// MCP_MAX_CONCURRENT_TOOL_CALLS is unset
+const DefaultMaxConcurrentToolCalls = 64
+
+// toolCallRetryAfter is the Retry-After, in seconds, of tool calls rejected because the server is busy
+const toolCallRetryAfter = 1
+
+// LoadMaxConcurrentToolCallsFromEnv reads MCP_MAX_CONCURRENT_TOOL_CALLS, the number of tool calls handled at the same
+// time in HTTP mode. 0 means unlimited.
+func LoadMaxConcurrentToolCallsFromEnv(logger *log.Logger) int {
+	value := strings.TrimSpace(utils.GetEnv("MCP_MAX_CONCURRENT_TOOL_CALLS", ""))
+	if value == "" {
+		return DefaultMaxConcurrentToolCalls
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 0 {
+		logger.Warnf("Invalid MCP_MAX_CONCURRENT_TOOL_CALLS value %q, using default %d", value, DefaultMaxConcurrentToolCalls)
+		return DefaultMaxConcurrentToolCalls
+	}
+	return limit
+}
+
+// ToolCallLimiter bounds the number of tool calls in flight. Every call holds a slot of a semaphore while its
+// handler runs, and HTTP requests carrying a tool call are rejected with 429 Too Many Requests while all slots are
+// taken, before the transport accepts them.
+type ToolCallLimiter struct {
+	slots  chan struct{}
+	logger *log.Logger
+}
+
+// NewToolCallLimiter creates a limiter allowing limit tool calls at the same time
+func NewToolCallLimiter(limit int, logger *log.Logger) *ToolCallLimiter {
+	return &ToolCallLimiter{slots: make(chan struct{}, limit), logger: logger}
+}
+
+// Full reports whether all slots are taken
+func (l *ToolCallLimiter) Full() bool {
+	return len(l.slots) == cap(l.slots)
+}
+
+// Middleware holds a slot for the duration of each tool call. A call arriving while all slots are taken, which the
+// HTTP check can miss when calls start at the same time, fails with a RATE_LIMITED error.
+func (l *ToolCallLimiter) Middleware() server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			select {
+			case l.slots <- struct{}{}:
+			default:
+				LoggerFromContext(ctx, l.logger).Warnf("Rejected call of %s, %d tool calls are already in flight", request.Params.Name, cap(l.slots))
+				return utils.NewErrorResult(utils.ErrorCodeRateLimited, fmt.Sprintf("server busy: %d tool calls are already in flight, retry in a moment", cap(l.slots))), nil
+			}
+			defer func() { <-l.slots }()
+			return next(ctx, request)
+		}
+	}
+}
+
+// HTTPMiddleware rejects requests carrying a tools/call message with 429 Too Many Requests while all slots are taken.
+// Other messages, such as initialize or tools/list, are always passed on.
+func (l *ToolCallLimiter) HTTPMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.Body == nil || !l.Full() {
+				next.ServeHTTP(w, r)
+				return
+			}
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, "Failed to read request body", http.StatusBadRequest)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			if !isToolCallMessage(body) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			LoggerFromContext(r.Context(), l.logger).Warnf("Rejected tool call with 429, %d tool calls are already in flight (MCP_MAX_CONCURRENT_TOOL_CALLS)", cap(l.slots))
+			w.Header().Set("Retry-After", strconv.Itoa(toolCallRetryAfter))
+			http.Error(w, "Too many concurrent tool calls", http.StatusTooManyRequests)
+		})
+	}
+}
+
+// isToolCallMessage reports whether a JSON-RPC message, or any message of a batch, is a tools/call request
+func isToolCallMessage(body []byte) bool {
+	type message struct {
+		Method string `json:"method"`
+	}
+	body = bytes.TrimSpace(body)
+	if bytes.HasPrefix(body, []byte("[")) {
+		var batch []message
+		if err := json.Unmarshal(body, &batch); err != nil {
+			return false
+		}
+		for _, m := range batch {
+			if m.Method == string(mcp.MethodToolsCall) {
+				return true
+			}
+		}
+		return false
+	}
+	var m message
+	return json.Unmarshal(body, &m) == nil && m.Method == string(mcp.MethodToolsCall)
+}
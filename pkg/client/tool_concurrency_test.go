@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolCallLimiter(t *testing.T) {
+	limiter := NewToolCallLimiter(1, logger)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := limiter.Middleware()(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		close(started)
+		<-release
+		return mcp.NewToolResultText("done"), nil
+	})
+
+	done := make(chan *mcp.CallToolResult)
+	go func() {
+		result, _ := handler(context.Background(), mcp.CallToolRequest{})
+		done <- result
+	}()
+	<-started
+	assert.True(t, limiter.Full())
+
+	// A second tool call is rejected while the first one holds the only slot
+	result, err := handler(context.Background(), mcp.CallToolRequest{})
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	structured, err := json.Marshal(result.StructuredContent)
+	require.NoError(t, err)
+	assert.Contains(t, string(structured), `"code":"`+string(utils.ErrorCodeRateLimited)+`"`)
+
+	var forwarded string
+	httpHandler := limiter.HTTPMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		forwarded = string(body)
+	}))
+	tests := []struct {
+		name     string
+		body     string
+		expected int
+	}{
+		{name: "tool call", body: `{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"search_modules"}}`, expected: http.StatusTooManyRequests},
+		{name: "batch with a tool call", body: `[{"jsonrpc":"2.0","id":3,"method":"tools/list"},{"jsonrpc":"2.0","id":4,"method":"tools/call"}]`, expected: http.StatusTooManyRequests},
+		{name: "other method", body: `{"jsonrpc":"2.0","id":5,"method":"tools/list"}`, expected: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forwarded = ""
+			recorder := httptest.NewRecorder()
+			httpHandler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/mcp", strings.NewReader(tt.body)))
+			assert.Equal(t, tt.expected, recorder.Code)
+			if tt.expected == http.StatusTooManyRequests {
+				assert.Equal(t, "1", recorder.Header().Get("Retry-After"))
+				assert.Empty(t, forwarded)
+			} else {
+				assert.Equal(t, tt.body, forwarded)
+			}
+		})
+	}
+
+	close(release)
+	assert.False(t, (<-done).IsError)
+	assert.False(t, limiter.Full())
+
+	recorder := httptest.NewRecorder()
+	httpHandler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/mcp", strings.NewReader(tests[0].body)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
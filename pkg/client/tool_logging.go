@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// ToolCallLoggingMiddleware logs the name, wall-clock duration and outcome of every tool call on completion
+func ToolCallLoggingMiddleware(logger *log.Logger) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			start := time.Now()
+			result, err := next(ctx, request)
+
+			toolErr := err != nil || (result != nil && result.IsError)
+			fields := log.Fields{
+				"tool":        request.Params.Name,
+				"duration_ms": time.Since(start).Milliseconds(),
+				"error":       toolErr,
+			}
+			if requestID := RequestIDFromContext(ctx); requestID != "" {
+				fields["request_id"] = requestID
+			}
+			logger.WithFields(fields).Infof("Tool call %s completed in %v", request.Params.Name, time.Since(start).Round(time.Millisecond))
+			return result, err
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolCallLoggingMiddleware(t *testing.T) {
+	testLogger, hook := test.NewNullLogger()
+	testLogger.SetLevel(log.InfoLevel)
+
+	tests := []struct {
+		name      string
+		handler   func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		expectErr bool
+	}{
+		{
+			name: "success",
+			handler: func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return mcp.NewToolResultText("ok"), nil
+			},
+		},
+		{
+			name: "error result",
+			handler: func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return mcp.NewToolResultError("failed"), nil
+			},
+			expectErr: true,
+		},
+		{
+			name: "handler error",
+			handler: func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return nil, errors.New("boom")
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook.Reset()
+			request := mcp.CallToolRequest{}
+			request.Params.Name = "get_provider_details"
+
+			_, _ = ToolCallLoggingMiddleware(testLogger)(tt.handler)(context.Background(), request)
+
+			entry := hook.LastEntry()
+			require.NotNil(t, entry)
+			assert.Equal(t, log.InfoLevel, entry.Level)
+			assert.Equal(t, "get_provider_details", entry.Data["tool"])
+			assert.Equal(t, tt.expectErr, entry.Data["error"])
+			assert.Contains(t, entry.Data, "duration_ms")
+			assert.Contains(t, entry.Message, "Tool call get_provider_details completed in")
+		})
+	}
+}
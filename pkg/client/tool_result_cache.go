@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxToolResultCacheEntries bounds the number of tool results kept in memory
+const maxToolResultCacheEntries = 1000
+
+// LoadToolResultCacheTTLFromEnv reads TOOL_RESULT_CACHE_TTL, how long the results of cacheable tools are reused for
+// identical calls, e.g. "5m". Empty, the default, disables the cache.
+func LoadToolResultCacheTTLFromEnv(logger *log.Logger) time.Duration {
+	value := strings.TrimSpace(utils.GetEnv("TOOL_RESULT_CACHE_TTL", ""))
+	if value == "" {
+		return 0
+	}
+	ttl, err := time.ParseDuration(value)
+	if err != nil || ttl <= 0 {
+		logger.Warnf("Invalid TOOL_RESULT_CACHE_TTL value %q, tool result caching is disabled", value)
+		return 0
+	}
+	return ttl
+}
+
+type toolResultCacheEntry struct {
+	result    *mcp.CallToolResult
+	expiresAt time.Time
+}
+
+// toolResultCache keeps successful tool results in memory for a fixed TTL
+type toolResultCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]toolResultCacheEntry
+}
+
+func (c *toolResultCache) get(key string) (*mcp.CallToolResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !time.Now().Before(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.result, true
+}
+
+// set stores a result, making room by dropping the expired entries, or any entry when none has expired
+func (c *toolResultCache) set(key string, result *mcp.CallToolResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if len(c.entries) >= maxToolResultCacheEntries {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	if len(c.entries) >= maxToolResultCacheEntries {
+		for k := range c.entries {
+			delete(c.entries, k)
+			break
+		}
+	}
+	c.entries[key] = toolResultCacheEntry{result: result, expiresAt: now.Add(c.ttl)}
+}
+
+// ToolResultCacheMiddleware answers calls to the tools isCacheable reports as cacheable with the result of an earlier
+// identical call made less than ttl ago. Calls are identical when their canonical arguments are, see
+// toolResultCacheKey. Only complete successful results are cached: dry runs and results cut short by the registry
+// call budget are not. It must wrap the tool handlers directly, so the results it caches are not yet offloaded to
+// downloads or truncated.
+func ToolResultCacheMiddleware(ttl time.Duration, isCacheable func(toolName string) bool, logger *log.Logger) server.ToolHandlerMiddleware {
+	cache := &toolResultCache{ttl: ttl, entries: make(map[string]toolResultCacheEntry)}
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if !isCacheable(request.Params.Name) || utils.IsDryRun(request) {
+				return next(ctx, request)
+			}
+			key, ok := toolResultCacheKey(request.Params.Name, request.GetArguments())
+			if !ok {
+				return next(ctx, request)
+			}
+			if cached, ok := cache.get(key); ok {
+				LoggerFromContext(ctx, logger).Debugf("Tool result cache hit: %s", request.Params.Name)
+				return copyToolResult(cached), nil
+			}
+
+			result, err := next(ctx, request)
+			if err == nil && result != nil && !result.IsError && !registryCallBudgetExceeded(ctx) {
+				cache.set(key, copyToolResult(result))
+			}
+			return result, err
+		}
+	}
+}
+
+// toolResultCacheKey returns the cache key of a tool call: the tool name and the JSON of its canonical arguments, with
+// the object keys sorted and the strings trimmed, so calls that only differ by argument order or surrounding
+// whitespace share an entry. The output format argument is left out as results are cached before being formatted.
+func toolResultCacheKey(toolName string, arguments map[string]any) (string, bool) {
+	canonical := make(map[string]any, len(arguments))
+	for name, value := range arguments {
+		if name == OutputFormatArgument {
+			continue
+		}
+		canonical[name] = canonicalToolArgument(value)
+	}
+	// encoding/json writes the keys of maps in sorted order
+	encoded, err := json.Marshal(canonical)
+	if err != nil {
+		return "", false
+	}
+	return toolName + "\x00" + string(encoded), true
+}
+
+func canonicalToolArgument(value any) any {
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case map[string]any:
+		canonical := make(map[string]any, len(v))
+		for key, item := range v {
+			canonical[strings.TrimSpace(key)] = canonicalToolArgument(item)
+		}
+		return canonical
+	case []any:
+		canonical := make([]any, len(v))
+		for i, item := range v {
+			canonical[i] = canonicalToolArgument(item)
+		}
+		return canonical
+	default:
+		return value
+	}
+}
+
+// copyToolResult copies a result, its content list and metadata, so the middlewares wrapping the cache can change the
+// result they get without changing the cached one
+func copyToolResult(result *mcp.CallToolResult) *mcp.CallToolResult {
+	copied := *result
+	copied.Content = append([]mcp.Content(nil), result.Content...)
+	if result.Meta != nil {
+		meta := *result.Meta
+		meta.AdditionalFields = maps.Clone(result.Meta.AdditionalFields)
+		copied.Meta = &meta
+	}
+	return &copied
+}
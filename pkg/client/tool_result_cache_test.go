@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadToolResultCacheTTLFromEnv(t *testing.T) {
+	t.Setenv("TOOL_RESULT_CACHE_TTL", "")
+	assert.Zero(t, LoadToolResultCacheTTLFromEnv(logger))
+
+	t.Setenv("TOOL_RESULT_CACHE_TTL", "5m")
+	assert.Equal(t, 5*time.Minute, LoadToolResultCacheTTLFromEnv(logger))
+
+	t.Setenv("TOOL_RESULT_CACHE_TTL", "-1s")
+	assert.Zero(t, LoadToolResultCacheTTLFromEnv(logger))
+}
+
+func TestToolResultCacheKey(t *testing.T) {
+	key := func(arguments string) string {
+		var decoded map[string]any
+		require.NoError(t, json.Unmarshal([]byte(arguments), &decoded))
+		k, ok := toolResultCacheKey("get_provider_details", decoded)
+		require.True(t, ok)
+		return k
+	}
+
+	base := key(`{"provider_name": "aws", "provider_namespace": "hashicorp", "filters": {"a": "x", "b": ["y"]}}`)
+	assert.Equal(t, base, key(`{"filters": {"b": ["y"], "a": "x"}, "provider_namespace": "hashicorp", "provider_name": "aws"}`), "argument order")
+	assert.Equal(t, base, key(`{"provider_name": " aws\n", "provider_namespace": "hashicorp ", "filters": {" a": " x", "b": [" y "]}}`), "whitespace")
+	assert.Equal(t, base, key(`{"provider_name": "aws", "provider_namespace": "hashicorp", "filters": {"a": "x", "b": ["y"]}, "format": "json"}`), "output format")
+
+	assert.NotEqual(t, base, key(`{"provider_name": "AWS", "provider_namespace": "hashicorp", "filters": {"a": "x", "b": ["y"]}}`), "case is significant")
+	assert.NotEqual(t, base, key(`{"provider_name": "aws", "provider_namespace": "hashicorp", "filters": {"a": "x", "b": ["y", "z"]}}`))
+	other, _ := toolResultCacheKey("get_latest_provider_version", map[string]any{"provider_name": "aws", "provider_namespace": "hashicorp", "filters": map[string]any{"a": "x", "b": []any{"y"}}})
+	assert.NotEqual(t, base, other, "tool name")
+}
+
+func TestToolResultCacheMiddleware(t *testing.T) {
+	calls := 0
+	handler := ToolResultCacheMiddleware(time.Minute, func(name string) bool { return name != "list_runs" }, logger)(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		calls++
+		if request.GetString("provider_name", "") == "missing" {
+			return mcp.NewToolResultError("not found"), nil
+		}
+		return mcp.NewToolResultText("docs"), nil
+	})
+	call := func(name string, arguments map[string]any) *mcp.CallToolResult {
+		request := mcp.CallToolRequest{}
+		request.Params.Name = name
+		request.Params.Arguments = arguments
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		return result
+	}
+
+	first := call("get_provider_details", map[string]any{"provider_name": "aws", "provider_version": "latest"})
+	first.Content = append(first.Content, mcp.NewTextContent("changed by an outer middleware"))
+	second := call("get_provider_details", map[string]any{"provider_version": "latest ", "provider_name": " aws"})
+	assert.Equal(t, 1, calls, "an identical call is answered from the cache")
+	assert.Len(t, second.Content, 1, "the cached result is not changed through the results handed out")
+
+	call("list_runs", map[string]any{"workspace": "w"})
+	call("list_runs", map[string]any{"workspace": "w"})
+	assert.Equal(t, 3, calls, "results of tools that are not cacheable are not reused")
+
+	call("get_provider_details", map[string]any{"provider_name": "missing"})
+	call("get_provider_details", map[string]any{"provider_name": "missing"})
+	assert.Equal(t, 5, calls, "error results are not cached")
+}
+
+func TestToolResultCacheMiddlewareSkipsDryRunsAndPartialResults(t *testing.T) {
+	calls := 0
+	handler := ToolResultCacheMiddleware(time.Minute, func(string) bool { return true }, logger)(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		calls++
+		if request.GetString("provider_name", "") == "partial" {
+			// A request over the budget of the invocation
+			ctx.Value(registryCallBudgetKey).(*registryCallBudget).take()
+		}
+		return mcp.NewToolResultText("docs"), nil
+	})
+	call := func(ctx context.Context, providerName string, dryRun bool) {
+		request := mcp.CallToolRequest{}
+		request.Params.Name = "get_provider_details"
+		request.Params.Arguments = map[string]any{"provider_name": providerName}
+		if dryRun {
+			request.Params.Meta = &mcp.Meta{AdditionalFields: map[string]any{"dry_run": true}}
+		}
+		_, err := handler(ctx, request)
+		require.NoError(t, err)
+	}
+
+	call(context.Background(), "aws", true)
+	call(context.Background(), "aws", false)
+	assert.Equal(t, 2, calls, "a dry run is neither answered from nor stored in the cache")
+	call(context.Background(), "aws", true)
+	assert.Equal(t, 3, calls, "a dry run is not answered with the result of a real call")
+
+	budgetCtx := func() context.Context {
+		return context.WithValue(context.Background(), registryCallBudgetKey, &registryCallBudget{limit: 0})
+	}
+	call(budgetCtx(), "partial", false)
+	call(budgetCtx(), "partial", false)
+	assert.Equal(t, 5, calls, "results cut short by the registry call budget are not cached")
+}
+
+func TestToolResultCacheExpiry(t *testing.T) {
+	cache := &toolResultCache{ttl: time.Millisecond, entries: make(map[string]toolResultCacheEntry)}
+	cache.set("key", mcp.NewToolResultText("docs"))
+	time.Sleep(5 * time.Millisecond)
+	_, ok := cache.get("key")
+	assert.False(t, ok)
+}
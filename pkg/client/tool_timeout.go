@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultToolCallTimeout is the time a tool call may take when MCP_TOOL_CALL_TIMEOUT is unset
+const DefaultToolCallTimeout = 60 * time.Second
+
+// ToolCallTimeoutConfig bounds the time a single tool call may take. A timeout of 0 means unlimited.
+type ToolCallTimeoutConfig struct {
+	GlobalTimeout time.Duration
+	ToolTimeouts  map[string]time.Duration
+}
+
+// TimeoutFor returns the timeout of a tool, preferring the per-tool timeout over the global one
+func (c ToolCallTimeoutConfig) TimeoutFor(toolName string) time.Duration {
+	if timeout, ok := c.ToolTimeouts[toolName]; ok {
+		return timeout
+	}
+	return c.GlobalTimeout
+}
+
+// Enabled reports whether any timeout is configured
+func (c ToolCallTimeoutConfig) Enabled() bool {
+	if c.GlobalTimeout > 0 {
+		return true
+	}
+	for _, timeout := range c.ToolTimeouts {
+		if timeout > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Longest returns the longest timeout a tool call can get, and false when some tool calls have no timeout
+func (c ToolCallTimeoutConfig) Longest() (time.Duration, bool) {
+	if c.GlobalTimeout <= 0 {
+		return 0, false
+	}
+	longest := c.GlobalTimeout
+	for _, timeout := range c.ToolTimeouts {
+		if timeout <= 0 {
+			return 0, false
+		}
+		longest = max(longest, timeout)
+	}
+	return longest, true
+}
+
+// LoadToolCallTimeoutConfigFromEnv reads MCP_TOOL_CALL_TIMEOUT (global, e.g. "90s", 0 for no timeout) and
+// MCP_TOOL_CALL_TIMEOUT_PER_TOOL (comma-separated tool=duration pairs, e.g. "get_provider_dependency_lock=5m")
+func LoadToolCallTimeoutConfigFromEnv(logger *log.Logger) ToolCallTimeoutConfig {
+	config := ToolCallTimeoutConfig{GlobalTimeout: DefaultToolCallTimeout, ToolTimeouts: make(map[string]time.Duration)}
+
+	if value := strings.TrimSpace(utils.GetEnv("MCP_TOOL_CALL_TIMEOUT", "")); value != "" {
+		timeout, err := parseToolCallTimeout(value)
+		if err != nil {
+			logger.Warnf("Invalid MCP_TOOL_CALL_TIMEOUT value %q, using default %v", value, DefaultToolCallTimeout)
+		} else {
+			config.GlobalTimeout = timeout
+		}
+	}
+
+	for _, pair := range splitCommaList(utils.GetEnv("MCP_TOOL_CALL_TIMEOUT_PER_TOOL", "")) {
+		name, value, found := strings.Cut(pair, "=")
+		timeout, err := parseToolCallTimeout(value)
+		if !found || strings.TrimSpace(name) == "" || err != nil {
+			logger.Warnf("Ignoring invalid MCP_TOOL_CALL_TIMEOUT_PER_TOOL entry %q, expected tool=duration", pair)
+			continue
+		}
+		config.ToolTimeouts[strings.TrimSpace(name)] = timeout
+	}
+
+	// A timeout below a single registry attempt cancels registry requests before their own timeout and retries apply
+	if config.GlobalTimeout > 0 && config.GlobalTimeout < registryRequestTimeout {
+		logger.Warnf("MCP_TOOL_CALL_TIMEOUT of %v is shorter than the %v timeout of a registry request, slow registry responses will fail the tool call without a retry", config.GlobalTimeout, registryRequestTimeout)
+	}
+	return config
+}
+
+// parseToolCallTimeout parses a timeout as a Go duration, accepting "0" for no timeout
+func parseToolCallTimeout(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if value == "0" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if timeout < 0 {
+		return 0, fmt.Errorf("negative timeout %v", timeout)
+	}
+	return timeout, nil
+}
+
+// toolCallOutcome is what a tool handler returned
+type toolCallOutcome struct {
+	result *mcp.CallToolResult
+	err    error
+}
+
+// ToolCallTimeoutMiddleware cancels the context of a tool call once its timeout passes and returns a TIMEOUT error.
+// Registry requests made with the context are aborted with it, between retries included, so a call never outlives
+// its deadline waiting on the registry. A handler that ignores its context keeps running in the background, but
+// its result is discarded. Middlewares holding resources for the duration of a call, such as the ToolCallLimiter,
+// must run inside this one so they are only released once the handler has returned.
+func ToolCallTimeoutMiddleware(config ToolCallTimeoutConfig, logger *log.Logger) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			timeout := config.TimeoutFor(request.Params.Name)
+			if timeout <= 0 {
+				return next(ctx, request)
+			}
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			done := make(chan toolCallOutcome, 1)
+			go func() {
+				result, err := next(ctx, request)
+				done <- toolCallOutcome{result: result, err: err}
+			}()
+
+			select {
+			case outcome := <-done:
+				// A handler that returns the failure of a cancelled registry request also ran out of time
+				if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					return outcome.result, outcome.err
+				}
+			case <-ctx.Done():
+				if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					// The client cancelled the call, there is no one left to answer
+					return nil, ctx.Err()
+				}
+			}
+			LoggerFromContext(ctx, logger).Warnf("Tool call %s timed out after %v", request.Params.Name, timeout)
+			return utils.NewErrorResult(utils.ErrorCodeTimeout, fmt.Sprintf("tool call %s timed out after %v - retry with narrower arguments or later", request.Params.Name, timeout)), nil
+		}
+	}
+}
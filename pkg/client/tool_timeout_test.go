@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadToolCallTimeoutConfigFromEnv(t *testing.T) {
+	t.Setenv("MCP_TOOL_CALL_TIMEOUT", "")
+	t.Setenv("MCP_TOOL_CALL_TIMEOUT_PER_TOOL", "")
+	config := LoadToolCallTimeoutConfigFromEnv(logger)
+	assert.Equal(t, DefaultToolCallTimeout, config.GlobalTimeout)
+	assert.True(t, config.Enabled())
+
+	t.Setenv("MCP_TOOL_CALL_TIMEOUT", "90s")
+	t.Setenv("MCP_TOOL_CALL_TIMEOUT_PER_TOOL", "get_provider_dependency_lock=5m, search_providers=0, invalid, bad=soon")
+	config = LoadToolCallTimeoutConfigFromEnv(logger)
+	assert.Equal(t, 90*time.Second, config.GlobalTimeout)
+	assert.Equal(t, 5*time.Minute, config.TimeoutFor("get_provider_dependency_lock"))
+	assert.Equal(t, time.Duration(0), config.TimeoutFor("search_providers"))
+	assert.Equal(t, 90*time.Second, config.TimeoutFor("get_module_details"))
+	assert.Len(t, config.ToolTimeouts, 2)
+	_, bounded := config.Longest()
+	assert.False(t, bounded, "search_providers has no timeout")
+	delete(config.ToolTimeouts, "search_providers")
+	longest, bounded := config.Longest()
+	assert.True(t, bounded)
+	assert.Equal(t, 5*time.Minute, longest)
+
+	t.Setenv("MCP_TOOL_CALL_TIMEOUT", "0")
+	t.Setenv("MCP_TOOL_CALL_TIMEOUT_PER_TOOL", "")
+	assert.False(t, LoadToolCallTimeoutConfigFromEnv(logger).Enabled())
+
+	t.Setenv("MCP_TOOL_CALL_TIMEOUT", "-5s")
+	assert.Equal(t, DefaultToolCallTimeout, LoadToolCallTimeoutConfigFromEnv(logger).GlobalTimeout)
+}
+
+func TestToolCallTimeoutMiddleware(t *testing.T) {
+	config := ToolCallTimeoutConfig{GlobalTimeout: 20 * time.Millisecond, ToolTimeouts: map[string]time.Duration{"unbounded_tool": 0}}
+	middleware := ToolCallTimeoutMiddleware(config, logger)
+
+	// A handler that ignores its context is abandoned once the timeout passes
+	stuck := middleware(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		time.Sleep(time.Second)
+		return mcp.NewToolResultText("too late"), nil
+	})
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "slow_tool"
+	start := time.Now()
+	result, err := stuck(context.Background(), request)
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assertTimeoutResult(t, result)
+
+	// A handler returning the failure of a request cancelled by the deadline is reported as a timeout as well
+	cancelled := middleware(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		<-ctx.Done()
+		return utils.NewErrorResult(RegistryErrorCode(ctx.Err()), "registry request failed"), nil
+	})
+	result, err = cancelled(context.Background(), request)
+	require.NoError(t, err)
+	assertTimeoutResult(t, result)
+
+	// Fast calls, and tools without a timeout, return their own result
+	fast := middleware(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		_, hasDeadline := ctx.Deadline()
+		return mcp.NewToolResultText(map[bool]string{true: "deadline", false: "no deadline"}[hasDeadline]), nil
+	})
+	result, err = fast(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, "deadline", result.Content[0].(mcp.TextContent).Text)
+	request.Params.Name = "unbounded_tool"
+	result, err = fast(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, "no deadline", result.Content[0].(mcp.TextContent).Text)
+
+	// A call cancelled by the client is not reported as a timeout
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	request.Params.Name = "slow_tool"
+	_, err = stuck(ctx, request)
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestToolCallTimeoutMiddlewareHoldsLimiterSlot(t *testing.T) {
+	limiter := NewToolCallLimiter(1, logger)
+	release := make(chan struct{})
+	handler := ToolCallTimeoutMiddleware(ToolCallTimeoutConfig{GlobalTimeout: 10 * time.Millisecond}, logger)(
+		limiter.Middleware()(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			<-release
+			return mcp.NewToolResultText("too late"), nil
+		}))
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "slow_tool"
+
+	result, err := handler(context.Background(), request)
+	require.NoError(t, err)
+	assertTimeoutResult(t, result)
+	assert.True(t, limiter.Full(), "the slot stays taken while the timed out handler is still running")
+
+	close(release)
+	assert.Eventually(t, func() bool { return !limiter.Full() }, time.Second, 5*time.Millisecond, "the slot is released once the handler returns")
+}
+
+func assertTimeoutResult(t *testing.T, result *mcp.CallToolResult) {
+	t.Helper()
+	require.NotNil(t, result)
+	assert.True(t, result.IsError)
+	structured, err := json.Marshal(result.StructuredContent)
+	require.NoError(t, err)
+	assert.Contains(t, string(structured), `"code":"`+string(utils.ErrorCodeTimeout)+`"`)
+}
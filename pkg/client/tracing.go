@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/hashicorp/terraform-mcp-server/version"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies the spans of the server, independent of the configured service name
+const tracerName = "github.com/hashicorp/terraform-mcp-server"
+
+// IsTracingEnabled reports whether spans are exported, which is the case once an OTLP endpoint is configured
+func IsTracingEnabled() bool {
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// InitTracing installs a tracer provider exporting spans over OTLP/HTTP. The exporter reads the standard
+// OTEL_EXPORTER_OTLP_* variables, the service name defaults to terraform-mcp-server unless OTEL_SERVICE_NAME is set.
+// The returned function flushes pending spans and must be called on shutdown.
+func InitTracing(ctx context.Context, logger *log.Logger) (func(), error) {
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
+	}
+
+	// Detectors applied later take precedence, so OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES override the defaults
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			attribute.String("service.name", "terraform-mcp-server"),
+			attribute.String("service.version", version.GetHumanVersion()),
+		),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return func() {
+		logger.Infof("Shutting down trace exporter..")
+		if err := provider.Shutdown(context.Background()); err != nil {
+			logger.Errorf("Error shutting down tracer provider: %v", err)
+		}
+	}, nil
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// TraceContextMiddleware continues the trace of the calling agent, read from the traceparent header of the request
+func TraceContextMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// ToolTracingMiddleware wraps every tool call in a span, the parent of the spans of its registry requests
+func ToolTracingMiddleware() server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			attributes := []attribute.KeyValue{attribute.String("mcp.tool.name", request.Params.Name)}
+			if requestID := RequestIDFromContext(ctx); requestID != "" {
+				attributes = append(attributes, attribute.String("mcp.request.id", requestID))
+			}
+			ctx, span := tracer().Start(ctx, "tools/call "+request.Params.Name,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(attributes...),
+			)
+			defer span.End()
+
+			result, err := next(ctx, request)
+			switch {
+			case err != nil:
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			case result != nil && result.IsError:
+				span.SetStatus(codes.Error, "tool returned an error result")
+			}
+			return result, err
+		}
+	}
+}
+
+// startRegistrySpan starts the client span of a registry request
+func startRegistrySpan(ctx context.Context, method string, url string) (context.Context, trace.Span) {
+	return tracer().Start(ctx, "registry "+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", method),
+			attribute.String("url.full", url),
+		),
+	)
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestToolTracingMiddleware(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previousProvider, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	}()
+
+	var traceparent string
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		if r.URL.Path == "/v1/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer registry.Close()
+
+	handler := ToolTracingMiddleware()(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		httpClient := withCallContext(ctx, &http.Client{})
+		if _, err := SendRegistryCall(ctx, httpClient, http.MethodGet, "found", logger, "v1", registry.URL); err != nil {
+			return nil, err
+		}
+		if _, err := SendRegistryCall(ctx, httpClient, http.MethodGet, "missing", logger, "v1", registry.URL); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		return mcp.NewToolResultText("ok"), nil
+	})
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "search_providers"
+	_, err := handler(context.Background(), request)
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	toolSpan := spans[2]
+	assert.Equal(t, "tools/call search_providers", toolSpan.Name())
+	assert.Equal(t, codes.Error, toolSpan.Status().Code)
+	for _, span := range spans[:2] {
+		assert.Equal(t, "registry GET", span.Name())
+		assert.Equal(t, toolSpan.SpanContext().SpanID(), span.Parent().SpanID())
+		assert.Equal(t, toolSpan.SpanContext().TraceID(), span.SpanContext().TraceID())
+	}
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Contains(t, traceparent, toolSpan.SpanContext().TraceID().String())
+}
@@ -63,6 +63,7 @@ type ModuleInput struct {
 type ModuleOutput struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
+	Sensitive   bool   `json:"sensitive"`
 }
 
 // ModuleDependency represents a Terraform module dependency.
@@ -143,6 +144,25 @@ type ProviderVersionLatest struct {
 	Versions    []string  `json:"versions"`
 }
 
+// ProviderVersionProtocols lists the versions of a provider with the plugin protocols each one supports.
+// https://registry.terraform.io/v1/providers/hashicorp/aws/versions
+type ProviderVersionProtocols struct {
+	Versions []struct {
+		Version   string   `json:"version"`
+		Protocols []string `json:"protocols"`
+	} `json:"versions"`
+}
+
+// ProviderPackage is the package of a provider version for one platform, returned by the v1 provider download endpoint
+type ProviderPackage struct {
+	OS          string `json:"os"`
+	Arch        string `json:"arch"`
+	Filename    string `json:"filename"`
+	DownloadURL string `json:"download_url"`
+	ShasumsURL  string `json:"shasums_url"`
+	Shasum      string `json:"shasum"`
+}
+
 // ProviderDoc represents a single documentation item.
 type ProviderDoc struct {
 	ID          string `json:"id"`
@@ -432,6 +452,8 @@ type TerraformPolicyDetails struct {
 			Shasum      string `json:"shasum"`
 			ShasumType  string `json:"shasum-type"`
 			Title       string `json:"title"`
+			// EnforcementLevel is the enforcement level the policy set recommends for a policy, empty when it recommends none
+			EnforcementLevel string `json:"enforcement-level"`
 		} `json:"attributes"`
 		Links struct {
 			Self string `json:"self"`
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// dryRunResult is the structured content returned for a dry run with valid arguments
+type dryRunResult struct {
+	Valid bool   `json:"valid"`
+	Tool  string `json:"tool"`
+}
+
+// withArgumentValidation checks the arguments of every call against the input schema of the tool before the
+// handler runs, and rejects malformed calls with a structured list of the offending fields. Calls with
+// "dry_run": true in their _meta also run the argument checks of the handler, which is stopped at its first network
+// access, so orchestrators can check arguments for free.
+func withArgumentValidation(tool server.ServerTool) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if errs := utils.ValidateArguments(tool.Tool.InputSchema, request.GetArguments()); len(errs) > 0 {
+			return utils.NewArgumentErrorResult(errs), nil
+		}
+		if utils.IsDryRun(request) {
+			dryRunCtx, probe := client.WithDryRun(ctx)
+			result, err := tool.Handler(dryRunCtx, request)
+			// The handler rejected the call before it got to the network
+			if !probe.Reached() && (err != nil || (result != nil && result.IsError)) {
+				return result, err
+			}
+			return mcp.NewToolResultStructured(dryRunResult{Valid: true, Tool: tool.Tool.Name}, "Arguments are valid, the tool was not run (dry run)"), nil
+		}
+		return tool.Handler(ctx, request)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithArgumentValidation(t *testing.T) {
+	calls, ran := 0, 0
+	handler := withArgumentValidation(server.ServerTool{
+		Tool: mcp.NewTool("example", mcp.WithString("module_id", mcp.Required())),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			calls++
+			// The own argument check of the handler, which the schema cannot express
+			if strings.Count(request.GetString("module_id", ""), "/") != 2 {
+				return utils.NewArgumentErrorResult(utils.ArgumentErrors{{Field: "module_id", Reason: "must be namespace/name/provider"}}), nil
+			}
+			// Without a session the client cannot be created, which only matters to dry runs
+			if _, err := client.GetHttpClientFromContext(ctx, log.New()); errors.Is(err, client.ErrDryRun) {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ran++
+			return mcp.NewToolResultText("ran"), nil
+		},
+	})
+	call := func(args map[string]any, meta *mcp.Meta) (*mcp.CallToolResult, string) {
+		request := mcp.CallToolRequest{}
+		request.Params.Name = "example"
+		request.Params.Arguments = args
+		request.Params.Meta = meta
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		structured, err := json.Marshal(result.StructuredContent)
+		require.NoError(t, err)
+		return result, string(structured)
+	}
+	dryRun := &mcp.Meta{AdditionalFields: map[string]any{"dry_run": true}}
+
+	result, structured := call(map[string]any{}, nil)
+	assert.True(t, result.IsError)
+	assert.JSONEq(t, `{"error":"invalid_arguments","code":"INVALID_ARGUMENT","arguments":[{"field":"module_id","reason":"is required"}]}`, structured)
+
+	result, _ = call(map[string]any{}, dryRun)
+	assert.True(t, result.IsError, "dry runs report invalid arguments as well")
+	assert.Equal(t, 0, calls, "the handler must not run for arguments the schema rejects")
+
+	result, structured = call(map[string]any{"module_id": "hashicorp/consul"}, dryRun)
+	assert.True(t, result.IsError, "dry runs report the arguments the handler rejects")
+	assert.JSONEq(t, `{"error":"invalid_arguments","code":"INVALID_ARGUMENT","arguments":[{"field":"module_id","reason":"must be namespace/name/provider"}]}`, structured)
+
+	result, structured = call(map[string]any{"module_id": "hashicorp/consul/aws"}, dryRun)
+	assert.False(t, result.IsError)
+	assert.JSONEq(t, `{"valid":true,"tool":"example"}`, structured)
+	assert.Equal(t, 0, ran, "dry runs must stop before the network")
+
+	result, _ = call(map[string]any{"module_id": "hashicorp/consul/aws"}, nil)
+	assert.False(t, result.IsError)
+	assert.Equal(t, 1, ran)
+}
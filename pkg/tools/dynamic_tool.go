@@ -108,78 +108,78 @@ func (r *DynamicToolRegistry) registerTFETools() {
 	// Terraform toolset - Organization and Project tools
 	if toolsets.IsToolEnabled("list_terraform_orgs", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("list_terraform_orgs", tfeTools.ListTerraformOrgs)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("list_terraform_projects", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("list_terraform_projects", tfeTools.ListTerraformProjects)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	// Terraform toolset - Workspace management tools
 	if toolsets.IsToolEnabled("list_workspaces", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("list_workspaces", tfeTools.ListWorkspaces)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("get_workspace_details", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_workspace_details", tfeTools.GetWorkspaceDetails)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("create_workspace", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("create_workspace", tfeTools.CreateWorkspace)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("update_workspace", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("update_workspace", tfeTools.UpdateWorkspace)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	// Only register delete_workspace_safely if TF operations are enabled AND toolset is enabled
 	if isTerraformOperationsEnabled() && toolsets.IsToolEnabled("delete_workspace_safely", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("delete_workspace_safely", tfeTools.DeleteWorkspaceSafely)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	// Registry-private toolset - Private provider tools
 	if toolsets.IsToolEnabled("search_private_providers", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("search_private_providers", tfeTools.SearchPrivateProviders)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("get_private_provider_details", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_private_provider_details", tfeTools.GetPrivateProviderDetails)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	// Registry-private toolset - Private module tools
 	if toolsets.IsToolEnabled("search_private_modules", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("search_private_modules", tfeTools.SearchPrivateModules)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("get_private_module_details", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_private_module_details", tfeTools.GetPrivateModuleDetails)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	// Terraform toolset - Workspace tags tools
 	if toolsets.IsToolEnabled("create_workspace_tags", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("create_workspace_tags", tfeTools.CreateWorkspaceTags)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("read_workspace_tags", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("read_workspace_tags", tfeTools.ReadWorkspaceTags)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	// Terraform toolset - Run tools
 	if toolsets.IsToolEnabled("list_runs", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("list_runs", tfeTools.ListRuns)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	// Create run tool with conditional options based on TF operations setting
@@ -190,125 +190,125 @@ func (r *DynamicToolRegistry) registerTFETools() {
 		} else {
 			tool = r.createDynamicTFETool("create_run", tfeTools.CreateRunSafe)
 		}
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	// Only register action_run if TF operations are enabled AND toolset is enabled
 	if isTerraformOperationsEnabled() && toolsets.IsToolEnabled("action_run", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("action_run", tfeTools.ActionRun)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("create_no_code_workspace", r.enabledToolsets) {
 		tool := r.createDynamicTFEToolWithElicitation("create_no_code_workspace", tfeTools.CreateNoCodeWorkspace)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("get_run_details", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_run_details", tfeTools.GetRunDetails)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("get_plan_details", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_plan_details", tfeTools.GetPlanDetails)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("get_plan_logs", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_plan_logs", tfeTools.GetPlanLogs)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("get_plan_json_output", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_plan_json_output", tfeTools.GetPlanJSONOutput)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("get_apply_details", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_apply_details", tfeTools.GetApplyDetails)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("get_apply_logs", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_apply_logs", tfeTools.GetApplyLogs)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 	if toolsets.IsToolEnabled("get_sentinel_mock", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_sentinel_mock", tfeTools.GetSentinelMock)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	// Terraform toolset - Variable set tools
 	if toolsets.IsToolEnabled("list_variable_sets", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("list_variable_sets", tfeTools.ListVariableSets)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("create_variable_set", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("create_variable_set", tfeTools.CreateVariableSet)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("create_variable_in_variable_set", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("create_variable_in_variable_set", tfeTools.CreateVariableInVariableSet)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("delete_variable_in_variable_set", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("delete_variable_in_variable_set", tfeTools.DeleteVariableInVariableSet)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	// Attach/detach variable sets to/from workspaces
 	if toolsets.IsToolEnabled("attach_variable_set_to_workspaces", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("attach_variable_set_to_workspaces", tfeTools.AttachVariableSetToWorkspaces)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("detach_variable_set_from_workspaces", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("detach_variable_set_from_workspaces", tfeTools.DetachVariableSetFromWorkspaces)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("attach_policy_set_to_workspaces", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("attach_policy_set_to_workspaces", tfeTools.AttachPolicySetToWorkspaces)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("list_workspace_policy_sets", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("list_workspace_policy_sets", tfeTools.ListWorkspacePolicySets)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	// Terraform toolset - Variable tools
 	if toolsets.IsToolEnabled("list_workspace_variables", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("list_workspace_variables", tfeTools.ListWorkspaceVariables)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("create_workspace_variable", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("create_workspace_variable", tfeTools.CreateWorkspaceVariable)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("update_workspace_variable", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("update_workspace_variable", tfeTools.UpdateWorkspaceVariable)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	if toolsets.IsToolEnabled("get_token_permissions", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_token_permissions", tfeTools.GetTokenPermissions)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	// Terraform toolset - Stacks
 	if toolsets.IsToolEnabled("list_stacks", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("list_stacks", tfeTools.ListStacks)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 	if toolsets.IsToolEnabled("get_stack_details", r.enabledToolsets) {
 		tool := r.createDynamicTFETool("get_stack_details", tfeTools.GetStackDetails)
-		r.mcpServer.AddTool(tool.Tool, tool.Handler)
+		addTool(r.mcpServer, tool, tfeToolCost)
 	}
 
 	r.tfeToolsRegistered = true
@@ -0,0 +1,302 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// moduleInputChange is an input added, removed or changed between two module versions, Old is nil for an added
+// input and New is nil for a removed one
+type moduleInputChange struct {
+	Name string              `json:"name"`
+	Old  *client.ModuleInput `json:"old,omitempty"`
+	New  *client.ModuleInput `json:"new,omitempty"`
+}
+
+// moduleOutputChange is an output added, removed or changed between two module versions, Old is nil for an added
+// output and New is nil for a removed one
+type moduleOutputChange struct {
+	Name string               `json:"name"`
+	Old  *client.ModuleOutput `json:"old,omitempty"`
+	New  *client.ModuleOutput `json:"new,omitempty"`
+}
+
+// moduleVersionComparison is the typed result of compare_module_versions
+type moduleVersionComparison struct {
+	ModuleID        string               `json:"module_id"`
+	FromVersion     string               `json:"from_version"`
+	ToVersion       string               `json:"to_version"`
+	Inputs          []moduleInputChange  `json:"inputs"`
+	Outputs         []moduleOutputChange `json:"outputs"`
+	BreakingChanges []string             `json:"breaking_changes"`
+}
+
+// CompareModuleVersions creates a tool that diffs the inputs and outputs of two versions of a module.
+func CompareModuleVersions(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("compare_module_versions",
+			mcp.WithDescription(`Compares the root module inputs and outputs of two versions of a Terraform module, e.g. when planning a module upgrade.
+Lists the breaking changes first (removed inputs, especially required ones, new required inputs, changed input types and removed outputs), then a unified-style diff of the added (+), removed (-) and changed (~) inputs and outputs. You must call 'search_modules' first to obtain a valid module_id.`),
+			mcp.WithTitleAnnotation("Compare the inputs and outputs of two versions of a Terraform module"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("module_id",
+				mcp.Required(),
+				mcp.Description("The module as 'namespace/name/provider' (e.g., 'terraform-aws-modules/vpc/aws'), a version segment is ignored in favour of from_version and to_version"),
+			),
+			mcp.WithString("from_version",
+				mcp.Required(),
+				mcp.Description("The version to compare from in the format 'x.y.z', e.g. the version currently in use"),
+			),
+			mcp.WithString("to_version",
+				mcp.Required(),
+				mcp.Description("The version to compare to in the format 'x.y.z', or 'latest' for the latest version"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return compareModuleVersionsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func compareModuleVersionsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	rawModuleID, err := request.RequireString("module_id")
+	if err != nil || strings.TrimSpace(rawModuleID) == "" {
+		return ToolArgumentError(logger, "module_id", "is required")
+	}
+	moduleID, err := utils.ParseModuleID(strings.ToLower(rawModuleID), false)
+	if err != nil {
+		return ToolArgumentError(logger, "module_id", fmt.Sprintf("%v. Use search_modules to find valid module IDs", err))
+	}
+	moduleID.Version = ""
+
+	versions := make([]string, 2)
+	for i, name := range []string{"from_version", "to_version"} {
+		version, err := request.RequireString(name)
+		if err != nil || strings.TrimSpace(version) == "" {
+			return ToolArgumentError(logger, name, "is required")
+		}
+		if versions[i], err = utils.ResolveVersionInput(version); err != nil {
+			return ToolArgumentError(logger, name, fmt.Sprintf("'%s' is not a version in the format 'x.y.z'", version))
+		}
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	// Without a version segment the registry returns the latest version of the module
+	results := client.ParallelRegistryCalls(versions, 0, func(version string) (client.TerraformModuleVersionDetails, error) {
+		versionedID := moduleID
+		if version != "latest" {
+			versionedID.Version = version
+		}
+		return getModuleVersionDetails(ctx, httpClient, versionedID.String(), logger)
+	})
+	for i, result := range results {
+		if result.Err != nil {
+			return ToolErrorf(logger, "failed to get module %s version %s: %v - use search_modules to find valid module IDs and get_latest_module_version for the latest version", moduleID, versions[i], result.Err)
+		}
+	}
+	from, to := results[0].Value, results[1].Value
+
+	inputChanges := diffModuleInputs(from.Root.Inputs, to.Root.Inputs)
+	outputChanges := diffModuleOutputs(from.Root.Outputs, to.Root.Outputs)
+	return utils.NewToolResultData(formatModuleVersionChanges(moduleID.String(), from.Version, to.Version, inputChanges, outputChanges), moduleVersionComparison{
+		ModuleID:        moduleID.String(),
+		FromVersion:     from.Version,
+		ToVersion:       to.Version,
+		Inputs:          append([]moduleInputChange{}, inputChanges...),
+		Outputs:         append([]moduleOutputChange{}, outputChanges...),
+		BreakingChanges: append([]string{}, moduleBreakingChanges(inputChanges, outputChanges)...),
+	}), nil
+}
+
+// diffModuleInputs returns the inputs removed or changed from the old version in its order, followed by the inputs
+// added in the new version in its order. Descriptions are not compared.
+func diffModuleInputs(oldInputs, newInputs []client.ModuleInput) []moduleInputChange {
+	newByName := make(map[string]*client.ModuleInput, len(newInputs))
+	for i := range newInputs {
+		newByName[newInputs[i].Name] = &newInputs[i]
+	}
+	var changes []moduleInputChange
+	oldNames := make(map[string]bool, len(oldInputs))
+	for i := range oldInputs {
+		old := &oldInputs[i]
+		oldNames[old.Name] = true
+		updated, ok := newByName[old.Name]
+		if !ok {
+			changes = append(changes, moduleInputChange{Name: old.Name, Old: old})
+			continue
+		}
+		if old.Type != updated.Type || old.Required != updated.Required || !reflect.DeepEqual(old.Default, updated.Default) {
+			changes = append(changes, moduleInputChange{Name: old.Name, Old: old, New: updated})
+		}
+	}
+	for i := range newInputs {
+		if !oldNames[newInputs[i].Name] {
+			changes = append(changes, moduleInputChange{Name: newInputs[i].Name, New: &newInputs[i]})
+		}
+	}
+	return changes
+}
+
+// diffModuleOutputs returns the outputs removed or changed from the old version in its order, followed by the
+// outputs added in the new version in its order. Descriptions are not compared.
+func diffModuleOutputs(oldOutputs, newOutputs []client.ModuleOutput) []moduleOutputChange {
+	newByName := make(map[string]*client.ModuleOutput, len(newOutputs))
+	for i := range newOutputs {
+		newByName[newOutputs[i].Name] = &newOutputs[i]
+	}
+	var changes []moduleOutputChange
+	oldNames := make(map[string]bool, len(oldOutputs))
+	for i := range oldOutputs {
+		old := &oldOutputs[i]
+		oldNames[old.Name] = true
+		updated, ok := newByName[old.Name]
+		if !ok {
+			changes = append(changes, moduleOutputChange{Name: old.Name, Old: old})
+			continue
+		}
+		if old.Sensitive != updated.Sensitive {
+			changes = append(changes, moduleOutputChange{Name: old.Name, Old: old, New: updated})
+		}
+	}
+	for i := range newOutputs {
+		if !oldNames[newOutputs[i].Name] {
+			changes = append(changes, moduleOutputChange{Name: newOutputs[i].Name, New: &newOutputs[i]})
+		}
+	}
+	return changes
+}
+
+// moduleBreakingChanges describes the changes that can break an existing module call, removed required inputs first
+func moduleBreakingChanges(inputChanges []moduleInputChange, outputChanges []moduleOutputChange) []string {
+	var removedRequired, others []string
+	for _, change := range inputChanges {
+		switch {
+		case change.New == nil && change.Old.Required:
+			removedRequired = append(removedRequired, fmt.Sprintf("**Removed required input `%s`**: every module call sets it and fails with an unsupported argument error until it is removed", change.Name))
+		case change.New == nil:
+			others = append(others, fmt.Sprintf("Removed input `%s`: module calls that set it fail with an unsupported argument error", change.Name))
+		case change.Old == nil && change.New.Required:
+			others = append(others, fmt.Sprintf("New required input `%s` (%s): every module call must set it", change.Name, change.New.Type))
+		case change.Old == nil:
+			// An optional input added with a default does not affect existing calls
+		default:
+			if change.New.Required && !change.Old.Required {
+				others = append(others, fmt.Sprintf("Input `%s` is now required: module calls relying on its default must set it", change.Name))
+			}
+			if change.Old.Type != change.New.Type {
+				others = append(others, fmt.Sprintf("Input `%s` changed type from %s to %s: values set for it may no longer be valid", change.Name, change.Old.Type, change.New.Type))
+			}
+		}
+	}
+	for _, change := range outputChanges {
+		if change.New == nil {
+			others = append(others, fmt.Sprintf("Removed output `%s`: references to it fail", change.Name))
+		}
+	}
+	return append(removedRequired, others...)
+}
+
+func formatModuleVersionChanges(moduleID, fromVersion, toVersion string, inputChanges []moduleInputChange, outputChanges []moduleOutputChange) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "# %s: %s -> %s\n\n", moduleID, fromVersion, toVersion)
+	if len(inputChanges) == 0 && len(outputChanges) == 0 {
+		builder.WriteString("No inputs or outputs changed between these versions.\n")
+		return builder.String()
+	}
+
+	if breaking := moduleBreakingChanges(inputChanges, outputChanges); len(breaking) > 0 {
+		fmt.Fprintf(&builder, "## Breaking changes (%d)\n\n", len(breaking))
+		for _, change := range breaking {
+			builder.WriteString("- " + change + "\n")
+		}
+		builder.WriteString("\n")
+	} else {
+		builder.WriteString("No breaking changes: no input or output was removed, no input became required or changed type.\n\n")
+	}
+
+	builder.WriteString("## Inputs\n\n")
+	if len(inputChanges) == 0 {
+		builder.WriteString("No inputs changed.\n")
+	} else {
+		added, removed, changed := 0, 0, 0
+		var diff strings.Builder
+		for _, change := range inputChanges {
+			switch {
+			case change.Old == nil:
+				added++
+				diff.WriteString("+ " + formatModuleInput(*change.New) + "\n")
+			case change.New == nil:
+				removed++
+				diff.WriteString("- " + formatModuleInput(*change.Old) + "\n")
+			default:
+				changed++
+				diff.WriteString("~ " + change.Name + "\n")
+				diff.WriteString("-   " + formatModuleInput(*change.Old) + "\n")
+				diff.WriteString("+   " + formatModuleInput(*change.New) + "\n")
+			}
+		}
+		fmt.Fprintf(&builder, "**Added:** %d, **Removed:** %d, **Changed:** %d\n\n```diff\n%s```\n", added, removed, changed, diff.String())
+	}
+
+	builder.WriteString("\n## Outputs\n\n")
+	if len(outputChanges) == 0 {
+		builder.WriteString("No outputs changed.\n")
+	} else {
+		added, removed, changed := 0, 0, 0
+		var diff strings.Builder
+		for _, change := range outputChanges {
+			switch {
+			case change.Old == nil:
+				added++
+				diff.WriteString("+ " + formatModuleOutput(*change.New) + "\n")
+			case change.New == nil:
+				removed++
+				diff.WriteString("- " + formatModuleOutput(*change.Old) + "\n")
+			default:
+				changed++
+				diff.WriteString("~ " + change.Name + "\n")
+				diff.WriteString("-   " + formatModuleOutput(*change.Old) + "\n")
+				diff.WriteString("+   " + formatModuleOutput(*change.New) + "\n")
+			}
+		}
+		fmt.Fprintf(&builder, "**Added:** %d, **Removed:** %d, **Changed:** %d\n\n```diff\n%s```\n", added, removed, changed, diff.String())
+	}
+	return builder.String()
+}
+
+func formatModuleInput(input client.ModuleInput) string {
+	var attributes []string
+	if input.Type != "" {
+		attributes = append(attributes, input.Type)
+	}
+	if input.Required {
+		attributes = append(attributes, "required")
+	} else {
+		attributes = append(attributes, "default = "+hclDefaultExpression(input.Default))
+	}
+	return fmt.Sprintf("%s (%s)", input.Name, strings.Join(attributes, ", "))
+}
+
+func formatModuleOutput(output client.ModuleOutput) string {
+	if output.Sensitive {
+		return output.Name + " (sensitive)"
+	}
+	return output.Name
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestDiffModuleInputs(t *testing.T) {
+	oldInputs := []client.ModuleInput{
+		{Name: "name", Type: "string", Required: true},
+		{Name: "cidr", Type: "string", Default: "10.0.0.0/16"},
+		{Name: "azs", Type: "list(string)", Default: []any{}},
+		{Name: "legacy", Type: "bool", Default: false},
+	}
+	newInputs := []client.ModuleInput{
+		{Name: "name", Type: "string", Required: true, Description: "A new description"},
+		{Name: "cidr", Type: "string", Required: true},
+		{Name: "azs", Type: "list(string)", Default: []any{}},
+		{Name: "region", Type: "string", Required: true},
+		{Name: "tags", Type: "map(string)", Default: map[string]any{}},
+	}
+
+	changes := diffModuleInputs(oldInputs, newInputs)
+	var names []string
+	for _, change := range changes {
+		names = append(names, change.Name)
+	}
+	if got := strings.Join(names, ","); got != "cidr,legacy,region,tags" {
+		t.Fatalf("changed inputs = %s, want cidr,legacy,region,tags", got)
+	}
+	if changes[0].Old == nil || changes[0].New == nil || changes[1].New != nil || changes[2].Old != nil {
+		t.Errorf("unexpected changes: %+v", changes)
+	}
+}
+
+func TestModuleBreakingChanges(t *testing.T) {
+	inputChanges := diffModuleInputs(
+		[]client.ModuleInput{
+			{Name: "legacy", Type: "bool", Default: false},
+			{Name: "subnet_id", Type: "string", Required: true},
+			{Name: "count_limit", Type: "number", Default: 1},
+		},
+		[]client.ModuleInput{
+			{Name: "count_limit", Type: "string", Default: "1"},
+			{Name: "region", Type: "string", Required: true},
+			{Name: "tags", Type: "map(string)", Default: map[string]any{}},
+		},
+	)
+	outputChanges := diffModuleOutputs(
+		[]client.ModuleOutput{{Name: "id"}, {Name: "arn"}},
+		[]client.ModuleOutput{{Name: "id", Sensitive: true}, {Name: "name"}},
+	)
+
+	breaking := moduleBreakingChanges(inputChanges, outputChanges)
+	if len(breaking) != 5 {
+		t.Fatalf("expected 5 breaking changes, got %d: %v", len(breaking), breaking)
+	}
+	if !strings.HasPrefix(breaking[0], "**Removed required input `subnet_id`**") {
+		t.Errorf("removed required inputs should be listed first, got %q", breaking[0])
+	}
+	for i, expected := range []string{"Removed input `legacy`", "Input `count_limit` changed type from number to string", "New required input `region`", "Removed output `arn`"} {
+		if !strings.HasPrefix(breaking[i+1], expected) {
+			t.Errorf("breaking change %d = %q, want prefix %q", i+1, breaking[i+1], expected)
+		}
+	}
+}
+
+func TestFormatModuleVersionChanges(t *testing.T) {
+	inputChanges := diffModuleInputs(
+		[]client.ModuleInput{{Name: "name", Type: "string", Required: true}, {Name: "cidr", Type: "string", Default: "10.0.0.0/16"}},
+		[]client.ModuleInput{{Name: "cidr", Type: "string", Default: "10.1.0.0/16"}, {Name: "tags", Type: "map(string)", Default: map[string]any{}}},
+	)
+	outputChanges := diffModuleOutputs([]client.ModuleOutput{{Name: "id"}}, []client.ModuleOutput{{Name: "id"}, {Name: "password", Sensitive: true}})
+
+	output := formatModuleVersionChanges("terraform-aws-modules/vpc/aws", "4.0.0", "5.0.0", inputChanges, outputChanges)
+	for _, expected := range []string{
+		"# terraform-aws-modules/vpc/aws: 4.0.0 -> 5.0.0",
+		"## Breaking changes (1)\n\n- **Removed required input `name`**",
+		"- name (string, required)\n",
+		"~ cidr\n-   cidr (string, default = \"10.0.0.0/16\")\n+   cidr (string, default = \"10.1.0.0/16\")\n",
+		"+ tags (map(string), default = {})\n",
+		"**Added:** 1, **Removed:** 1, **Changed:** 1",
+		"+ password (sensitive)\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("output does not contain %q:\n%s", expected, output)
+		}
+	}
+
+	unchanged := formatModuleVersionChanges("terraform-aws-modules/vpc/aws", "5.0.0", "5.0.1", nil, nil)
+	if !strings.Contains(unchanged, "No inputs or outputs changed") {
+		t.Errorf("unexpected output for identical versions:\n%s", unchanged)
+	}
+}
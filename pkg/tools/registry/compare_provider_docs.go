@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// CompareProviderDocs creates a tool that diffs the documented schema of a resource between two provider versions.
+func CompareProviderDocs(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("compare_provider_docs",
+			mcp.WithDescription(`Compares the documented arguments and attributes of a Terraform resource or data source between two provider versions, e.g. when planning a provider upgrade.
+Returns a unified-style diff listing the added (+), removed (-) and changed (~) fields, including the arguments of nested blocks as 'block.argument'.`),
+			mcp.WithTitleAnnotation("Compare the documentation of a Terraform resource between two provider versions"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("resource_name",
+				mcp.Required(),
+				mcp.Description("The resource or data source name, with or without the provider prefix, e.g. 'aws_instance' or 'instance'"),
+			),
+			mcp.WithString("from_version",
+				mcp.Required(),
+				mcp.Description("The version to compare from in the format 'x.y.z', e.g. the version currently in use"),
+			),
+			mcp.WithString("to_version",
+				mcp.Required(),
+				mcp.Description("The version to compare to in the format 'x.y.z', or 'latest' for the latest version"),
+			),
+			mcp.WithString("provider_document_type",
+				mcp.Description("Whether the name refers to a resource or a data source"),
+				mcp.Enum("resources", "data-sources"),
+				mcp.DefaultString("resources"),
+			),
+			withContentFallback(),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return compareProviderDocsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func compareProviderDocsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	providerName, err := request.RequireString("provider_name")
+	if err != nil || strings.TrimSpace(providerName) == "" {
+		return ToolArgumentError(logger, "provider_name", "is required")
+	}
+	resourceName, err := request.RequireString("resource_name")
+	if err != nil || strings.TrimSpace(resourceName) == "" {
+		return ToolArgumentError(logger, "resource_name", "is required")
+	}
+	fromVersion, err := request.RequireString("from_version")
+	if err != nil || strings.TrimSpace(fromVersion) == "" {
+		return ToolArgumentError(logger, "from_version", "is required")
+	}
+	toVersion, err := request.RequireString("to_version")
+	if err != nil || strings.TrimSpace(toVersion) == "" {
+		return ToolArgumentError(logger, "to_version", "is required")
+	}
+
+	providerDetail := client.ProviderDetail{
+		ProviderName:         strings.ToLower(strings.TrimSpace(providerName)),
+		ProviderNamespace:    providerNamespaceArgument(request),
+		ProviderDocumentType: "resources",
+	}
+	if request.GetString("provider_document_type", "resources") == "data-sources" {
+		providerDetail.ProviderDocumentType = "data-sources"
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	fromDetail, err := withComparedVersion(ctx, httpClient, providerDetail, fromVersion, logger)
+	if err != nil {
+		return ToolArgumentError(logger, "from_version", err.Error())
+	}
+	toDetail, err := withComparedVersion(ctx, httpClient, providerDetail, toVersion, logger)
+	if err != nil {
+		return ToolArgumentError(logger, "to_version", err.Error())
+	}
+
+	fromDoc, fromContent, err := getProviderDocContentBySlug(ctx, httpClient, fromDetail, resourceName, logger)
+	if err != nil {
+		return ToolError(logger, fmt.Sprintf("failed to fetch documentation for version %s", fromDetail.ProviderVersion), err)
+	}
+	toDoc, toContent, err := getProviderDocContentBySlug(ctx, httpClient, toDetail, resourceName, logger)
+	if err != nil {
+		return ToolError(logger, fmt.Sprintf("failed to fetch documentation for version %s", toDetail.ProviderVersion), err)
+	}
+
+	fromFields, toFields := utils.DocFields(fromContent), utils.DocFields(toContent)
+	if len(fromFields) == 0 && len(toFields) == 0 {
+		// Without an argument reference an empty diff would wrongly suggest that nothing changed
+		reason := fmt.Sprintf("neither version documents its arguments in a structured way, so a field diff is not possible (version %s doc id: %s)", fromDetail.ProviderVersion, fromDoc.ID)
+		if request.GetBool("content_fallback", true) {
+			return utils.NewToolResultData(formatUnstructuredDoc(toDoc, toContent, reason)), nil
+		}
+		return ToolErrorf(logger, "no arguments could be extracted from the documentation of %s: %s", toDoc.Title, reason)
+	}
+
+	changes := utils.DiffDocFields(fromFields, toFields)
+	return utils.NewToolResultData(formatDocFieldChanges(fromDoc.Title, fromDetail.ProviderVersion, toDetail.ProviderVersion, changes), providerDocComparison{
+		Title:       fromDoc.Title,
+		FromVersion: fromDetail.ProviderVersion,
+		ToVersion:   toDetail.ProviderVersion,
+		Changes:     append([]utils.DocFieldChange{}, changes...),
+	}), nil
+}
+
+// providerDocComparison is the typed result of compare_provider_docs
+type providerDocComparison struct {
+	Title       string                 `json:"title"`
+	FromVersion string                 `json:"from_version"`
+	ToVersion   string                 `json:"to_version"`
+	Changes     []utils.DocFieldChange `json:"changes"`
+}
+
+// withComparedVersion returns the provider detail pinned to the given version, resolving 'latest'
+func withComparedVersion(ctx context.Context, httpClient *http.Client, providerDetail client.ProviderDetail, version string, logger *log.Logger) (client.ProviderDetail, error) {
+	resolved, err := utils.ResolveVersionInput(version)
+	if err != nil {
+		return providerDetail, fmt.Errorf("'%s' is not a version in the format 'x.y.z'", version)
+	}
+	if resolved == "latest" {
+		resolved, err = client.GetLatestProviderVersion(ctx, httpClient, providerDetail.ProviderNamespace, providerDetail.ProviderName, logger)
+		if err != nil {
+			return providerDetail, fmt.Errorf("getting the latest version of %s/%s: %w", providerDetail.ProviderNamespace, providerDetail.ProviderName, err)
+		}
+	}
+	providerDetail.ProviderVersion = resolved
+	return providerDetail, nil
+}
+
+func formatDocFieldChanges(title, fromVersion, toVersion string, changes []utils.DocFieldChange) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# %s: %s -> %s\n\n", title, fromVersion, toVersion))
+	if len(changes) == 0 {
+		builder.WriteString("No documented arguments or attributes changed between these versions.\n")
+		return builder.String()
+	}
+
+	added, removed, changed := 0, 0, 0
+	var diff strings.Builder
+	for _, change := range changes {
+		switch {
+		case change.Added():
+			added++
+			diff.WriteString("+ " + formatDocField(change.Path, *change.New) + "\n")
+		case change.Removed():
+			removed++
+			diff.WriteString("- " + formatDocField(change.Path, *change.Old) + "\n")
+		default:
+			changed++
+			diff.WriteString("~ " + change.Path + "\n")
+			diff.WriteString("-   " + formatDocField(change.Path, *change.Old) + "\n")
+			diff.WriteString("+   " + formatDocField(change.Path, *change.New) + "\n")
+		}
+	}
+
+	builder.WriteString(fmt.Sprintf("**Added:** %d, **Removed:** %d, **Changed:** %d\n\n", added, removed, changed))
+	builder.WriteString("```diff\n")
+	builder.WriteString(diff.String())
+	builder.WriteString("```\n")
+	return builder.String()
+}
+
+func formatDocField(path string, field utils.DocArgument) string {
+	var attributes []string
+	if field.Type != "" {
+		attributes = append(attributes, field.Type)
+	}
+	if field.Qualifier != "" {
+		attributes = append(attributes, field.Qualifier)
+	}
+	line := path
+	if len(attributes) > 0 {
+		line += " (" + strings.Join(attributes, ", ") + ")"
+	}
+	if field.Description != "" {
+		line += ": " + field.Description
+	}
+	return line
+}
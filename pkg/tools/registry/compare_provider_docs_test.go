@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+)
+
+func TestFormatDocFieldChanges(t *testing.T) {
+	oldDoc := "## Argument Reference\n\n" +
+		"* `name` - (Required) The name.\n" +
+		"* `size` - (Optional) The size.\n" +
+		"* `legacy` - (Optional) Removed later.\n"
+	newDoc := "## Argument Reference\n\n" +
+		"* `name` - (Required) The name.\n" +
+		"* `size` - (Required) The size.\n" +
+		"* `tags` - (Optional) Tags to assign.\n"
+
+	changes := utils.DiffDocFields(utils.DocFields(oldDoc), utils.DocFields(newDoc))
+	out := formatDocFieldChanges("aws_example", "4.0.0", "5.0.0", changes)
+
+	for _, expected := range []string{
+		"# aws_example: 4.0.0 -> 5.0.0",
+		"**Added:** 1, **Removed:** 1, **Changed:** 1",
+		"- legacy (Optional): Removed later.\n",
+		"~ size\n-   size (Optional): The size.\n+   size (Required): The size.\n",
+		"+ tags (Optional): Tags to assign.\n",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, out)
+		}
+	}
+	if strings.Contains(out, "name (Required)") {
+		t.Errorf("unchanged fields should not be listed, got:\n%s", out)
+	}
+
+	out = formatDocFieldChanges("aws_example", "4.0.0", "4.0.0", nil)
+	if !strings.Contains(out, "No documented arguments or attributes changed") {
+		t.Errorf("expected no-change message, got:\n%s", out)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// DownloadPolicyModule creates a tool that returns the Sentinel source of a policy module after verifying its checksum.
+func DownloadPolicyModule(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("download_policy_module",
+			mcp.WithDescription(`Downloads the Sentinel source of a policy module referenced by a policy set, so its logic can be inspected rather than just referenced.
+The file is only returned if its SHA-256 matches the checksum advertised by the registry. Call 'get_policy_details' first to find the module names of a policy set.`),
+			mcp.WithTitleAnnotation("Download the Sentinel source of a Terraform policy module"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_policy_id",
+				mcp.Required(),
+				mcp.Description("Matching terraform_policy_id retrieved from the 'search_policies' tool (e.g., 'policies/hashicorp/CIS-Policy-Set-for-AWS-Terraform/1.0.1')"),
+			),
+			mcp.WithString("module_name",
+				mcp.Required(),
+				mcp.Description("The name of the policy module as listed by 'get_policy_details', with or without the .sentinel extension"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return downloadPolicyModuleHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func downloadPolicyModuleHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	terraformPolicyID, err := request.RequireString("terraform_policy_id")
+	if err != nil || strings.TrimSpace(terraformPolicyID) == "" {
+		return ToolArgumentError(logger, "terraform_policy_id", "is required - use search_policies first to find valid policy IDs")
+	}
+	moduleName, err := request.RequireString("module_name")
+	if err != nil || strings.TrimSpace(moduleName) == "" {
+		return ToolArgumentError(logger, "module_name", "is required - use get_policy_details to find the module names")
+	}
+	moduleName = strings.TrimSuffix(strings.TrimSpace(moduleName), ".sentinel")
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	policyDetails, err := fetchPolicyDetails(ctx, httpClient, terraformPolicyID, logger)
+	if err != nil {
+		return ToolErrorf(logger, "%v", err)
+	}
+
+	var shasum string
+	var available []string
+	for _, included := range policyDetails.Included {
+		if included.Type != "policy-modules" {
+			continue
+		}
+		available = append(available, included.Attributes.Name)
+		if included.Attributes.Name == moduleName {
+			shasum = included.Attributes.Shasum
+		}
+	}
+	if shasum == "" {
+		if len(available) == 0 {
+			return ToolNotFoundErrorf(logger, "policy %s has no policy modules", terraformPolicyID)
+		}
+		return ToolNotFoundErrorf(logger, "policy module '%s' not found in %s, available modules: %s", moduleName, terraformPolicyID, strings.Join(available, ", "))
+	}
+
+	policyPath := strings.Trim(terraformPolicyID, "/")
+	content, err := client.SendRegistryCall(ctx, httpClient, http.MethodGet, path.Join(policyPath, policyFileKinds["policy-modules"], moduleName+".sentinel"), logger, client.RegistryAPIVersions().Policies)
+	if err != nil {
+		return ToolError(logger, fmt.Sprintf("failed to download policy module %s", moduleName), err)
+	}
+	actual, ok := policyChecksumMatches(content, shasum)
+	if !ok {
+		return ToolErrorf(logger, "checksum mismatch for policy module %s: registry advertises sha256:%s but the downloaded file hashes to sha256:%s - the file may have been tampered with", moduleName, shasum, actual)
+	}
+
+	return utils.NewToolResultData(formatPolicyModuleSource(terraformPolicyID, moduleName, actual, string(content)), policyModuleSource{
+		TerraformPolicyID: terraformPolicyID,
+		Name:              moduleName,
+		Sha256:            actual,
+		Source:            string(content),
+	}), nil
+}
+
+// policyModuleSource is the typed result of download_policy_module
+type policyModuleSource struct {
+	TerraformPolicyID string `json:"terraform_policy_id"`
+	Name              string `json:"name"`
+	// Sha256 is the verified checksum of the source
+	Sha256 string `json:"sha256"`
+	Source string `json:"source"`
+}
+
+func formatPolicyModuleSource(terraformPolicyID, moduleName, checksum, content string) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("## Policy module %s from %s\n\n", moduleName, terraformPolicyID))
+	builder.WriteString(fmt.Sprintf("Checksum verified: sha256:%s\n\n", checksum))
+	builder.WriteString("```sentinel\n")
+	builder.WriteString(strings.TrimRight(content, "\n"))
+	builder.WriteString("\n```\n")
+	return builder.String()
+}
@@ -0,0 +1,23 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatPolicyModuleSource(t *testing.T) {
+	out := formatPolicyModuleSource("policies/hashicorp/example/1.0.0", "helpers", "abc123", "import \"tfplan/v2\"\n\n")
+
+	expected := "## Policy module helpers from policies/hashicorp/example/1.0.0\n\n" +
+		"Checksum verified: sha256:abc123\n\n" +
+		"```sentinel\nimport \"tfplan/v2\"\n```\n"
+	if out != expected {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+	if strings.Count(out, "```") != 2 {
+		t.Errorf("expected a single code block, got:\n%s", out)
+	}
+}
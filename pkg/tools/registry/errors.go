@@ -6,25 +6,66 @@ package tools
 import (
 	"fmt"
 
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	"github.com/mark3labs/mcp-go/mcp"
 	log "github.com/sirupsen/logrus"
 )
 
+// ToolError fails a tool call, with an error code derived from the registry error err
 func ToolError(logger *log.Logger, message string, err error) (*mcp.CallToolResult, error) {
 	fullMessage := message
 	if err != nil {
 		fullMessage = fmt.Sprintf("%s: %v", message, err)
 	}
+	return toolErrorWithCode(logger, errorCodeOf(err), fullMessage)
+}
+
+// ToolErrorf fails a tool call, with an error code derived from the first error among args
+func ToolErrorf(logger *log.Logger, format string, args ...interface{}) (*mcp.CallToolResult, error) {
+	var err error
+	for _, arg := range args {
+		if argErr, ok := arg.(error); ok {
+			err = argErr
+			break
+		}
+	}
+	return toolErrorWithCode(logger, errorCodeOf(err), fmt.Sprintf(format, args...))
+}
+
+// ToolNotFoundErrorf fails a tool call for a module, provider or doc that does not exist
+func ToolNotFoundErrorf(logger *log.Logger, format string, args ...interface{}) (*mcp.CallToolResult, error) {
+	return toolErrorWithCode(logger, utils.ErrorCodeNotFound, fmt.Sprintf(format, args...))
+}
+
+// notFoundHint returns the hint for a failed lookup when the registry did not find it, rate limits, timeouts and
+// upstream failures are not caused by the input
+func notFoundHint(err error, hint string) string {
+	if errorCodeOf(err) != utils.ErrorCodeNotFound {
+		return ""
+	}
+	return " - " + hint
+}
+
+func toolErrorWithCode(logger *log.Logger, code utils.ErrorCode, message string) (*mcp.CallToolResult, error) {
 	if logger != nil {
-		logger.Errorf("Tool error: %s", fullMessage)
+		logger.Errorf("Tool error (%s): %s", code, message)
 	}
-	return mcp.NewToolResultError(fullMessage), nil
+	return utils.NewErrorResult(code, message), nil
 }
 
-func ToolErrorf(logger *log.Logger, format string, args ...interface{}) (*mcp.CallToolResult, error) {
-	message := fmt.Sprintf(format, args...)
+func errorCodeOf(err error) utils.ErrorCode {
+	if err == nil {
+		return utils.ErrorCodeUpstreamError
+	}
+	return client.RegistryErrorCode(err)
+}
+
+// ToolArgumentError rejects a missing or malformed argument with a structured error naming the field
+func ToolArgumentError(logger *log.Logger, field string, reason string) (*mcp.CallToolResult, error) {
+	errs := utils.ArgumentErrors{{Field: field, Reason: reason}}
 	if logger != nil {
-		logger.Errorf("Tool error: %s", message)
+		logger.Errorf("Tool error: %v", errs)
 	}
-	return mcp.NewToolResultError(message), nil
+	return utils.NewArgumentErrorResult(errs), nil
 }
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestToolErrorCodes(t *testing.T) {
+	notFound := &client.RegistryStatusError{StatusCode: 404, Status: "404 Not Found"}
+	tests := []struct {
+		name     string
+		result   func() (*mcp.CallToolResult, error)
+		code     utils.ErrorCode
+		expected string
+	}{
+		{
+			name:     "registry status",
+			result:   func() (*mcp.CallToolResult, error) { return ToolError(nil, "failed to fetch module", notFound) },
+			code:     utils.ErrorCodeNotFound,
+			expected: "failed to fetch module: error: 404 Not Found",
+		},
+		{
+			name:     "error argument",
+			result:   func() (*mcp.CallToolResult, error) { return ToolErrorf(nil, "failed: %v", client.ErrRegistryThrottled) },
+			code:     utils.ErrorCodeRateLimited,
+			expected: "failed: outbound registry rate limit exceeded",
+		},
+		{
+			name: "not found",
+			result: func() (*mcp.CallToolResult, error) {
+				return ToolNotFoundErrorf(nil, "module not found: %s", "a/b/c/1.0.0")
+			},
+			code:     utils.ErrorCodeNotFound,
+			expected: "module not found: a/b/c/1.0.0",
+		},
+		{
+			name:     "other",
+			result:   func() (*mcp.CallToolResult, error) { return ToolError(nil, "failed", errors.New("connection reset")) },
+			code:     utils.ErrorCodeUpstreamError,
+			expected: "failed: connection reset",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.result()
+			if err != nil || result == nil || !result.IsError {
+				t.Fatalf("expected an error result, got %v, %v", result, err)
+			}
+			if text := result.Content[0].(mcp.TextContent).Text; text != tt.expected {
+				t.Errorf("expected message %q, got %q", tt.expected, text)
+			}
+			structured, err := json.Marshal(result.StructuredContent)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if expected := fmt.Sprintf(`{"code":"%s","message":"%s"}`, tt.code, tt.expected); string(structured) != expected {
+				t.Errorf("expected structured content %s, got %s", expected, structured)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// numericLiteral matches HCL number literals the registry returns as default values
+var numericLiteral = regexp.MustCompile(`^-?\d+(\.\d+)?([eE][+-]?\d+)?$`)
+
+// moduleVariables is the typed result of generate_module_variables
+type moduleVariables struct {
+	ModuleID string               `json:"module_id"`
+	Inputs   []client.ModuleInput `json:"inputs"`
+	// HCL is the rendered variables.tf
+	HCL string `json:"hcl"`
+}
+
+// GenerateModuleVariables creates a tool that renders a variables.tf for a wrapper around a public registry module.
+func GenerateModuleVariables(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("generate_module_variables",
+			mcp.WithDescription(`Generates the content of a variables.tf file that declares one variable per input of a Terraform module, including type, description and default.
+Use this to scaffold a wrapper configuration that exposes the module's interface. You must call 'search_modules' first to obtain a valid module_id.`),
+			mcp.WithTitleAnnotation("Generate variables.tf from a Terraform module's inputs"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("module_id",
+				mcp.Required(),
+				mcp.Description("Exact valid and compatible module_id retrieved from search_modules (e.g., 'terraform-aws-modules/vpc/aws/5.0.0')"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return generateModuleVariablesHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func generateModuleVariablesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	moduleID, err := request.RequireString("module_id")
+	if err != nil {
+		return ToolArgumentError(logger, "module_id", "is required")
+	}
+	if moduleID == "" {
+		return ToolArgumentError(logger, "module_id", "cannot be empty")
+	}
+	if err := validateModuleID(moduleID); err != nil {
+		return ToolArgumentError(logger, "module_id", err.Error())
+	}
+	moduleID = strings.ToLower(moduleID)
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	inputs, err := getModuleInputs(ctx, httpClient, moduleID, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to get module %s: %v - use search_modules first to find valid module IDs", moduleID, err)
+	}
+	if len(inputs) == 0 {
+		return ToolNotFoundErrorf(logger, "module %s does not declare any inputs", moduleID)
+	}
+
+	variables := generateVariablesTF(inputs)
+	return utils.NewToolResultData(variables, moduleVariables{ModuleID: moduleID, Inputs: inputs, HCL: variables}), nil
+}
+
+// getModuleInputs fetches the root module inputs for a specific module version
+func getModuleInputs(ctx context.Context, httpClient *http.Client, moduleID string, logger *log.Logger) ([]client.ModuleInput, error) {
+	moduleDetails, err := getModuleVersionDetails(ctx, httpClient, moduleID, logger)
+	if err != nil {
+		return nil, err
+	}
+	return moduleDetails.Root.Inputs, nil
+}
+
+// generateVariablesTF renders a variable block for each module input
+func generateVariablesTF(inputs []client.ModuleInput) string {
+	var builder strings.Builder
+	for i, input := range inputs {
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+		builder.WriteString(fmt.Sprintf("variable %q {\n", input.Name))
+		builder.WriteString(fmt.Sprintf("  type        = %s\n", hclTypeExpression(input.Type)))
+		if description := strings.TrimSpace(input.Description); description != "" {
+			builder.WriteString(fmt.Sprintf("  description = %s\n", hclDescription(description)))
+		}
+		if input.Required {
+			builder.WriteString("  # Required: no default, a value must be supplied by the caller\n")
+		} else {
+			builder.WriteString(fmt.Sprintf("  default     = %s\n", hclDefaultExpression(input.Default)))
+		}
+		builder.WriteString("}\n")
+	}
+	return builder.String()
+}
+
+// hclTypeExpression converts a registry type string into a type constraint expression.
+// Legacy quoted types and bare collection types from Terraform 0.11 are normalised.
+func hclTypeExpression(typeString string) string {
+	t := strings.TrimSpace(typeString)
+	t = strings.Trim(t, `"`)
+	switch t {
+	case "":
+		return "any"
+	case "map", "list", "set":
+		return t + "(any)"
+	}
+	return t
+}
+
+// hclDescription renders a description as a quoted string, or a heredoc for multi-line text
+func hclDescription(description string) string {
+	description = strings.ReplaceAll(description, "${", "$${")
+	description = strings.ReplaceAll(description, "%{", "%%{")
+	if strings.Contains(description, "\n") {
+		return "<<-EOT\n" + description + "\nEOT"
+	}
+	return strconv.Quote(description)
+}
+
+// hclDefaultExpression converts a registry default value into an HCL expression.
+// The registry usually returns defaults as HCL literals encoded in a string, e.g. "\"us-east-1\"" or "[]".
+func hclDefaultExpression(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		trimmed := strings.TrimSpace(v)
+		switch {
+		case trimmed == "true", trimmed == "false", trimmed == "null":
+			return trimmed
+		case numericLiteral.MatchString(trimmed):
+			return trimmed
+		case strings.HasPrefix(trimmed, `"`) && strings.HasSuffix(trimmed, `"`) && len(trimmed) >= 2,
+			strings.HasPrefix(trimmed, "[") && strings.HasSuffix(trimmed, "]"),
+			strings.HasPrefix(trimmed, "{") && strings.HasSuffix(trimmed, "}"):
+			return trimmed
+		}
+		return strconv.Quote(v)
+	default:
+		// JSON is a valid subset of HCL expression syntax for numbers, bools, lists and objects
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "null"
+		}
+		return string(encoded)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestHclTypeExpression(t *testing.T) {
+	tests := map[string]string{
+		"":                                "any",
+		"string":                          "string",
+		`"string"`:                        "string",
+		"map":                             "map(any)",
+		"list":                            "list(any)",
+		"set":                             "set(any)",
+		"map(string)":                     "map(string)",
+		"list(object({ name = string }))": "list(object({ name = string }))",
+	}
+	for input, expected := range tests {
+		if got := hclTypeExpression(input); got != expected {
+			t.Errorf("hclTypeExpression(%q) = %q, want %q", input, got, expected)
+		}
+	}
+}
+
+func TestHclDefaultExpression(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{"nil", nil, "null"},
+		{"quoted string literal", `"us-east-1"`, `"us-east-1"`},
+		{"empty string literal", `""`, `""`},
+		{"bool literal", "true", "true"},
+		{"number literal", "3", "3"},
+		{"list literal", "[]", "[]"},
+		{"map literal", "{}", "{}"},
+		{"null literal", "null", "null"},
+		{"bare string", "us-east-1", `"us-east-1"`},
+		{"native bool", false, "false"},
+		{"native number", float64(10), "10"},
+		{"native list", []any{"a", "b"}, `["a","b"]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hclDefaultExpression(tt.value); got != tt.expected {
+				t.Errorf("hclDefaultExpression(%v) = %q, want %q", tt.value, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGenerateVariablesTF(t *testing.T) {
+	inputs := []client.ModuleInput{
+		{Name: "name", Type: "string", Description: "Name to be used on all resources", Required: true},
+		{Name: "cidr", Type: "string", Description: "The IPv4 CIDR block", Default: `"10.0.0.0/16"`},
+		{Name: "tags", Type: "map(string)", Description: "A map of ${var} tags", Default: "{}"},
+		{Name: "azs", Type: "", Description: "Line one\nLine two", Default: nil},
+	}
+
+	out := generateVariablesTF(inputs)
+
+	expected := []string{
+		"variable \"name\" {\n  type        = string\n  description = \"Name to be used on all resources\"\n  # Required",
+		"variable \"cidr\" {\n  type        = string\n  description = \"The IPv4 CIDR block\"\n  default     = \"10.0.0.0/16\"\n}",
+		"type        = map(string)",
+		"description = \"A map of $${var} tags\"",
+		"default     = {}",
+		"type        = any",
+		"description = <<-EOT\nLine one\nLine two\nEOT",
+		"default     = null",
+	}
+	for _, want := range expected {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+
+	// Required inputs must not have a default value
+	requiredBlock := out[:strings.Index(out, "variable \"cidr\"")]
+	if strings.Contains(requiredBlock, "default     =") {
+		t.Errorf("required input should not declare a default, got:\n%s", requiredBlock)
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxRequiredProviders bounds the providers of one call, each one is resolved against the registry
+const maxRequiredProviders = 20
+
+// requiredProviderVersionStyles are the version constraints generated for resolved versions
+var requiredProviderVersionStyles = []string{"pessimistic", "exact", "minimum"}
+
+// requiredProviderRequest is a provider entry of the call, "source[@version]"
+type requiredProviderRequest struct {
+	Source string
+	// Version is empty for the latest version
+	Version string
+	// Constraint is set instead of Version when the entry gives a version constraint, used as is
+	Constraint string
+}
+
+// requiredProvider is an entry of the generated required_providers block
+type requiredProvider struct {
+	LocalName string `json:"local_name"`
+	Source    string `json:"source"`
+	Version   string `json:"version,omitempty"`
+}
+
+// requiredProviders is the typed result of generate_required_providers
+type requiredProviders struct {
+	Providers []requiredProvider `json:"providers"`
+	HCL       string             `json:"hcl"`
+}
+
+// GenerateRequiredProviders creates a tool that generates the required_providers block of several providers.
+func GenerateRequiredProviders(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("generate_required_providers",
+			mcp.WithDescription(`Generates a ready-to-paste terraform block with the required_providers entries of several providers at once, with the source addresses and version constraints resolved against the public registry.
+Providers are given as 'name' (e.g. 'aws', or a common name such as 'gcp'), 'namespace/name' (e.g. 'integrations/github'), optionally followed by '@' and a version or constraint (e.g. 'aws@5.31.0' or 'azurerm@~> 3.0'). Providers without a version get a constraint on their latest version.`),
+			mcp.WithTitleAnnotation("Generate the required_providers block of Terraform providers"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithArray("providers",
+				mcp.Required(),
+				mcp.Description(fmt.Sprintf("The providers, as 'name' or 'namespace/name' optionally followed by '@version' or '@constraint', e.g. ['aws', 'hashicorp/google@5.0.0', 'azurerm@~> 3.0'], at most %d", maxRequiredProviders)),
+				mcp.WithStringItems(),
+			),
+			mcp.WithString("version_style",
+				mcp.Description("The constraint generated for a version: 'pessimistic' (default, '~> 5.31' for 5.31.0, allowing newer minor and patch releases), 'exact' ('5.31.0') or 'minimum' ('>= 5.31.0')"),
+				mcp.Enum(requiredProviderVersionStyles...),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return generateRequiredProvidersHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func generateRequiredProvidersHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	entries := request.GetStringSlice("providers", nil)
+	if len(entries) == 0 {
+		return ToolArgumentError(logger, "providers", "must list at least one provider")
+	}
+	if len(entries) > maxRequiredProviders {
+		return ToolArgumentError(logger, "providers", fmt.Sprintf("lists %d providers, at most %d are allowed", len(entries), maxRequiredProviders))
+	}
+	requests := make([]requiredProviderRequest, 0, len(entries))
+	for _, entry := range entries {
+		providerRequest, err := parseRequiredProviderRequest(entry)
+		if err != nil {
+			return ToolArgumentError(logger, "providers", err.Error())
+		}
+		requests = append(requests, providerRequest)
+	}
+	style := strings.ToLower(strings.TrimSpace(request.GetString("version_style", "pessimistic")))
+	if !slices.Contains(requiredProviderVersionStyles, style) {
+		return ToolArgumentError(logger, "version_style", fmt.Sprintf("must be one of %s", strings.Join(requiredProviderVersionStyles, ", ")))
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	results := client.ParallelRegistryCalls(requests, 0, func(providerRequest requiredProviderRequest) (providerNameResolution, error) {
+		return resolveProviderName(ctx, httpClient, providerRequest.Source, logger)
+	})
+	var failures []string
+	// lookupErr is the first resolution that failed for another reason than the provider not existing
+	var lookupErr error
+	providers := make([]requiredProvider, 0, len(requests))
+	for i, result := range results {
+		resolution := result.Value
+		switch {
+		case result.Err != nil:
+			failures = append(failures, fmt.Sprintf("%s: %v", requests[i].Source, result.Err))
+			if lookupErr == nil && errorCodeOf(result.Err) != utils.ErrorCodeNotFound {
+				lookupErr = result.Err
+			}
+		case resolution.ProviderID == "" && len(resolution.Candidates) > 0:
+			var candidates []string
+			for _, candidate := range resolution.Candidates {
+				candidates = append(candidates, candidate.ProviderID)
+			}
+			failures = append(failures, fmt.Sprintf("%s is ambiguous, use one of %s", requests[i].Source, strings.Join(candidates, ", ")))
+		case resolution.ProviderID == "":
+			failures = append(failures, fmt.Sprintf("%s was not found in the registry", requests[i].Source))
+		default:
+			providers = append(providers, requiredProvider{
+				LocalName: resolution.Name,
+				Source:    resolution.ProviderID,
+				Version:   requiredProviderConstraint(requests[i], resolution.LatestVersion, style),
+			})
+		}
+	}
+	if lookupErr != nil {
+		return toolErrorWithCode(logger, errorCodeOf(lookupErr), "failed to resolve providers - "+strings.Join(failures, "; "))
+	}
+	if len(failures) > 0 {
+		return ToolNotFoundErrorf(logger, "failed to resolve providers - %s", strings.Join(failures, "; "))
+	}
+	if duplicate := duplicateLocalName(providers); duplicate != "" {
+		return ToolArgumentError(logger, "providers", fmt.Sprintf("lists several providers named '%s', a required_providers block can only hold one of them", duplicate))
+	}
+
+	block := formatRequiredProviders(providers)
+	return utils.NewToolResultData(block, requiredProviders{Providers: providers, HCL: block}), nil
+}
+
+// parseRequiredProviderRequest parses a "source[@version]" entry, where the version is "latest", a version or a
+// version constraint
+func parseRequiredProviderRequest(entry string) (requiredProviderRequest, error) {
+	source, versionInput, _ := strings.Cut(entry, "@")
+	providerRequest := requiredProviderRequest{Source: normalizeProviderName(source)}
+	if providerRequest.Source == "" || strings.Count(providerRequest.Source, "/") > 1 {
+		return requiredProviderRequest{}, fmt.Errorf("entry '%s' must be 'name' or 'namespace/name', optionally followed by '@version'", entry)
+	}
+
+	versionInput = strings.TrimSpace(versionInput)
+	if resolved, err := utils.ResolveVersionInput(versionInput); err == nil {
+		if resolved != "latest" {
+			providerRequest.Version = resolved
+		}
+		return providerRequest, nil
+	}
+	if _, err := utils.ExplainVersionConstraint(versionInput); err != nil {
+		return requiredProviderRequest{}, fmt.Errorf("entry '%s' has an invalid version or constraint '%s'", entry, versionInput)
+	}
+	providerRequest.Constraint = versionInput
+	return providerRequest, nil
+}
+
+// requiredProviderConstraint returns the version constraint of a provider: the constraint of the request as is, or
+// one in the given style on the version of the request, or on the latest version
+func requiredProviderConstraint(providerRequest requiredProviderRequest, latestVersion string, style string) string {
+	if providerRequest.Constraint != "" {
+		return providerRequest.Constraint
+	}
+	version := providerRequest.Version
+	if version == "" {
+		version = latestVersion
+	}
+	parsed, err := utils.ParseVersion(version)
+	if err != nil {
+		return ""
+	}
+	switch style {
+	case "exact":
+		return parsed.String()
+	case "minimum":
+		return ">= " + parsed.String()
+	}
+	// A pre-release is never selected by a pessimistic constraint, so it is pinned exactly
+	if parsed.Prerelease() != "" {
+		return parsed.String()
+	}
+	segments := parsed.Segments()
+	return fmt.Sprintf("~> %d.%d", segments[0], segments[1])
+}
+
+func duplicateLocalName(providers []requiredProvider) string {
+	seen := make(map[string]string)
+	for _, provider := range providers {
+		if source, ok := seen[provider.LocalName]; ok && source != provider.Source {
+			return provider.LocalName
+		}
+		seen[provider.LocalName] = provider.Source
+	}
+	return ""
+}
+
+func formatRequiredProviders(providers []requiredProvider) string {
+	var builder strings.Builder
+	builder.WriteString("terraform {\n  required_providers {\n")
+	written := make(map[string]bool)
+	for _, provider := range providers {
+		if written[provider.LocalName] {
+			continue
+		}
+		written[provider.LocalName] = true
+		fmt.Fprintf(&builder, "    %s = {\n", provider.LocalName)
+		if provider.Version == "" {
+			fmt.Fprintf(&builder, "      source = %q\n", provider.Source)
+		} else {
+			fmt.Fprintf(&builder, "      source  = %q\n", provider.Source)
+			fmt.Fprintf(&builder, "      version = %q\n", provider.Version)
+		}
+		builder.WriteString("    }\n")
+	}
+	builder.WriteString("  }\n}\n")
+	return builder.String()
+}
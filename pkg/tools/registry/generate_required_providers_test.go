@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import "testing"
+
+func TestParseRequiredProviderRequest(t *testing.T) {
+	tests := []struct {
+		entry    string
+		expected requiredProviderRequest
+		wantErr  bool
+	}{
+		{entry: "aws", expected: requiredProviderRequest{Source: "aws"}},
+		{entry: " Hashicorp/Google@latest", expected: requiredProviderRequest{Source: "hashicorp/google"}},
+		{entry: "integrations/github@v6.2", expected: requiredProviderRequest{Source: "integrations/github", Version: "6.2.0"}},
+		{entry: "azurerm@~> 3.0", expected: requiredProviderRequest{Source: "azurerm", Constraint: "~> 3.0"}},
+		{entry: "azurerm@>= 3.0, < 4.0", expected: requiredProviderRequest{Source: "azurerm", Constraint: ">= 3.0, < 4.0"}},
+		{entry: "aws@newest", wantErr: true},
+		{entry: "@5.0.0", wantErr: true},
+		{entry: "a/b/c", wantErr: true},
+	}
+	for _, tt := range tests {
+		actual, err := parseRequiredProviderRequest(tt.entry)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseRequiredProviderRequest(%q) error = %v, wantErr %v", tt.entry, err, tt.wantErr)
+			continue
+		}
+		if actual != tt.expected {
+			t.Errorf("parseRequiredProviderRequest(%q) = %+v, expected %+v", tt.entry, actual, tt.expected)
+		}
+	}
+}
+
+func TestRequiredProviderConstraint(t *testing.T) {
+	tests := []struct {
+		request  requiredProviderRequest
+		latest   string
+		style    string
+		expected string
+	}{
+		{request: requiredProviderRequest{Source: "aws"}, latest: "5.31.0", style: "pessimistic", expected: "~> 5.31"},
+		{request: requiredProviderRequest{Source: "aws"}, latest: "5.31.0", style: "exact", expected: "5.31.0"},
+		{request: requiredProviderRequest{Source: "aws"}, latest: "5.31.0", style: "minimum", expected: ">= 5.31.0"},
+		{request: requiredProviderRequest{Source: "aws", Version: "4.67.0"}, latest: "5.31.0", style: "pessimistic", expected: "~> 4.67"},
+		{request: requiredProviderRequest{Source: "aws", Constraint: "~> 4.0"}, latest: "5.31.0", style: "exact", expected: "~> 4.0"},
+		{request: requiredProviderRequest{Source: "aws"}, latest: "6.0.0-beta1", style: "pessimistic", expected: "6.0.0-beta1"},
+		{request: requiredProviderRequest{Source: "aws"}, latest: "", style: "pessimistic", expected: ""},
+	}
+	for _, tt := range tests {
+		if actual := requiredProviderConstraint(tt.request, tt.latest, tt.style); actual != tt.expected {
+			t.Errorf("requiredProviderConstraint(%+v, %q, %q) = %q, expected %q", tt.request, tt.latest, tt.style, actual, tt.expected)
+		}
+	}
+}
+
+func TestFormatRequiredProviders(t *testing.T) {
+	providers := []requiredProvider{
+		{LocalName: "aws", Source: "hashicorp/aws", Version: "~> 5.31"},
+		{LocalName: "github", Source: "integrations/github"},
+		{LocalName: "aws", Source: "hashicorp/aws", Version: "~> 5.31"},
+	}
+	expected := `terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.31"
+    }
+    github = {
+      source = "integrations/github"
+    }
+  }
+}
+`
+	if actual := formatRequiredProviders(providers); actual != expected {
+		t.Errorf("unexpected block:\n%s", actual)
+	}
+
+	if duplicate := duplicateLocalName(providers); duplicate != "" {
+		t.Errorf("expected the same provider listed twice to be accepted, got duplicate %q", duplicate)
+	}
+	if duplicate := duplicateLocalName(append(providers, requiredProvider{LocalName: "github", Source: "acme/github"})); duplicate != "github" {
+		t.Errorf("expected github to be reported as a duplicate, got %q", duplicate)
+	}
+}
@@ -5,17 +5,25 @@ package tools
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	log "github.com/sirupsen/logrus"
 )
 
+// latestModuleVersion is the typed result of get_latest_module_version
+type latestModuleVersion struct {
+	ModuleID    string    `json:"module_id"`
+	Version     string    `json:"version"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
 // GetLatestModuleVersion creates a tool to get the latest module version from the public registry.
 func GetLatestModuleVersion(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
@@ -36,7 +44,7 @@ func GetLatestModuleVersion(logger *log.Logger) server.ServerTool {
 				mcp.Description("The name of the Terraform provider for the module, e.g., 'aws', 'google', 'azurerm' etc.")),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getLatestModuleVersionHandler(ctx, req, logger)
+			return getLatestModuleVersionHandler(ctx, req, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -44,22 +52,26 @@ func GetLatestModuleVersion(logger *log.Logger) server.ServerTool {
 func getLatestModuleVersionHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	modulePublisher, err := request.RequireString("module_publisher")
 	if err != nil {
-		return ToolError(logger, "required input: 'module_publisher' (the publisher of the module)", err)
+		return ToolArgumentError(logger, "module_publisher", "is required (the publisher of the module)")
 	}
 	modulePublisher = strings.ToLower(modulePublisher)
 
 	moduleName, err := request.RequireString("module_name")
 	if err != nil {
-		return ToolError(logger, "required input: 'module_name' (the name of the module)", err)
+		return ToolArgumentError(logger, "module_name", "is required (the name of the module)")
 	}
 	moduleName = strings.ToLower(moduleName)
 
 	moduleProvider, err := request.RequireString("module_provider")
 	if err != nil {
-		return ToolError(logger, "required input: 'module_provider' (the provider of the module)", err)
+		return ToolArgumentError(logger, "module_provider", "is required (the provider of the module)")
 	}
 	moduleProvider = strings.ToLower(moduleProvider)
 
+	if _, err := utils.ParseModuleID(fmt.Sprintf("%s/%s/%s", modulePublisher, moduleName, moduleProvider), false); err != nil {
+		return toolErrorWithCode(logger, utils.ErrorCodeInvalidArgument, err.Error())
+	}
+
 	// Get a simple http client to access the public Terraform registry from context
 	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
 	if err != nil {
@@ -67,15 +79,19 @@ func getLatestModuleVersionHandler(ctx context.Context, request mcp.CallToolRequ
 	}
 
 	uri := fmt.Sprintf("modules/%s/%s/%s", modulePublisher, moduleName, moduleProvider)
-	response, err := client.SendRegistryCall(httpClient, http.MethodGet, uri, logger)
+	response, err := client.SendRegistryCall(ctx, httpClient, http.MethodGet, uri, logger, client.RegistryAPIVersions().Modules)
 	if err != nil {
 		return ToolErrorf(logger, "fetching module information for %s/%s from the %s provider: %v", modulePublisher, moduleName, moduleProvider, err)
 	}
 
 	var moduleVersionDetails client.TerraformModuleVersionDetails
-	if err := json.Unmarshal(response, &moduleVersionDetails); err != nil {
+	if err := client.DecodeRegistryResponse(response, &moduleVersionDetails); err != nil {
 		return ToolErrorf(logger, "unmarshalling module information for %s/%s from the %s provider: %v", modulePublisher, moduleName, moduleProvider, err)
 	}
 
-	return mcp.NewToolResultText(moduleVersionDetails.Version), nil
+	return utils.NewToolResultData(moduleVersionDetails.Version, latestModuleVersion{
+		ModuleID:    fmt.Sprintf("%s/%s/%s", modulePublisher, moduleName, moduleProvider),
+		Version:     moduleVersionDetails.Version,
+		PublishedAt: moduleVersionDetails.PublishedAt,
+	}), nil
 }
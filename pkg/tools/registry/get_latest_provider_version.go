@@ -5,9 +5,12 @@ package tools
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	log "github.com/sirupsen/logrus"
@@ -17,7 +20,8 @@ import (
 func GetLatestProviderVersion(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("get_latest_provider_version",
-			mcp.WithDescription("Fetches the latest version of a Terraform provider from the public registry"),
+			mcp.WithDescription(`Fetches the latest version of a Terraform provider from the public registry, together with its release date.
+Pre-release versions (e.g. '6.0.0-beta1') are skipped unless 'include_prerelease' is true.`),
 			mcp.WithTitleAnnotation("Get Latest Provider Version"),
 			mcp.WithOpenWorldHintAnnotation(true),
 			mcp.WithReadOnlyHintAnnotation(true),
@@ -28,9 +32,13 @@ func GetLatestProviderVersion(logger *log.Logger) server.ServerTool {
 			mcp.WithString("name",
 				mcp.Required(),
 				mcp.Description("The name of the Terraform provider, e.g., 'aws', 'azurerm', 'google', etc.")),
+			mcp.WithBoolean("include_prerelease",
+				mcp.Description("Whether pre-release versions such as betas and release candidates may be returned"),
+				mcp.DefaultBool(false),
+			),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getLatestProviderVersionHandler(ctx, req, logger)
+			return getLatestProviderVersionHandler(ctx, req, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -38,13 +46,13 @@ func GetLatestProviderVersion(logger *log.Logger) server.ServerTool {
 func getLatestProviderVersionHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	namespace, err := request.RequireString("namespace")
 	if err != nil {
-		return ToolError(logger, "missing required input: namespace", err)
+		return ToolArgumentError(logger, "namespace", "is required")
 	}
 	namespace = strings.ToLower(namespace)
 
 	name, err := request.RequireString("name")
 	if err != nil {
-		return ToolError(logger, "missing required input: name", err)
+		return ToolArgumentError(logger, "name", "is required")
 	}
 	name = strings.ToLower(name)
 
@@ -53,10 +61,30 @@ func getLatestProviderVersionHandler(ctx context.Context, request mcp.CallToolRe
 		return ToolError(logger, "failed to get http client for public Terraform registry", err)
 	}
 
-	version, err := client.GetLatestProviderVersion(httpClient, namespace, name, logger)
+	includePrerelease := request.GetBool("include_prerelease", false)
+	release, err := client.GetLatestProviderRelease(ctx, httpClient, namespace, name, includePrerelease, logger)
 	if err != nil {
-		return ToolErrorf(logger, "provider not found: %s/%s - verify the namespace and provider name are correct", namespace, name)
+		return ToolErrorf(logger, "failed to get provider %s/%s: %v%s", namespace, name, err, notFoundHint(err, "verify the namespace and provider name are correct"))
 	}
 
-	return mcp.NewToolResultText(version), nil
+	latest := latestProviderVersion{Provider: namespace + "/" + name, Version: release.Version}
+	if !release.PublishedAt.IsZero() {
+		latest.PublishedAt = &release.PublishedAt
+	}
+	return utils.NewToolResultData(formatLatestProviderRelease(release), latest), nil
+}
+
+// latestProviderVersion is the typed result of get_latest_provider_version
+type latestProviderVersion struct {
+	Provider    string     `json:"provider"`
+	Version     string     `json:"version"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+}
+
+func formatLatestProviderRelease(release client.ProviderVersionLatest) string {
+	published := "unknown"
+	if !release.PublishedAt.IsZero() {
+		published = release.PublishedAt.UTC().Format(time.RFC3339)
+	}
+	return fmt.Sprintf("Version: %s\nPublished: %s", release.Version, published)
 }
@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// moduleDependencies is the structured result of get_module_dependencies
+type moduleDependencies struct {
+	ModuleID          string                            `json:"module_id"`
+	RequiredProviders map[string]moduleRequiredProvider `json:"required_providers"`
+	Root              modulePartDependencies            `json:"root"`
+	Submodules        []modulePartDependencies          `json:"submodules"`
+}
+
+// moduleRequiredProvider is an entry of a required_providers block, with the constraints of the root module
+// and all its submodules combined
+type moduleRequiredProvider struct {
+	Source  string `json:"source"`
+	Version string `json:"version,omitempty"`
+}
+
+// modulePartDependencies lists the providers and module calls declared by the root module or a submodule
+type modulePartDependencies struct {
+	Path      string                            `json:"path"`
+	Providers []client.ModuleProviderDependency `json:"providers"`
+	Modules   []client.ModuleDependency         `json:"modules"`
+}
+
+// GetModuleDependencies creates a tool that returns the provider and module dependencies of a module version.
+func GetModuleDependencies(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_module_dependencies",
+			mcp.WithDescription(`Returns the providers (with version constraints) and child modules a Terraform module depends on, for the root module and each of its submodules, as JSON.
+'required_providers' combines the provider requirements of the root module and all submodules, ready to be turned into a required_providers block. You must call 'search_modules' first to obtain a valid module_id.`),
+			mcp.WithTitleAnnotation("Get the provider and module dependencies of a Terraform module"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("module_id",
+				mcp.Required(),
+				mcp.Description("Exact valid and compatible module_id retrieved from search_modules (e.g., 'terraform-aws-modules/vpc/aws/5.0.0')"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getModuleDependenciesHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getModuleDependenciesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	moduleID, err := request.RequireString("module_id")
+	if err != nil || moduleID == "" {
+		return ToolArgumentError(logger, "module_id", "is required")
+	}
+	if err := validateModuleID(moduleID); err != nil {
+		return ToolArgumentError(logger, "module_id", err.Error())
+	}
+	moduleID = strings.ToLower(moduleID)
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	moduleDetails, err := getModuleVersionDetails(ctx, httpClient, moduleID, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to get module %s: %v%s", moduleID, err, notFoundHint(err, "use search_modules first to find valid module IDs"))
+	}
+
+	dependencies := collectModuleDependencies(moduleID, moduleDetails)
+	result, err := json.MarshalIndent(dependencies, "", "  ")
+	if err != nil {
+		return ToolError(logger, "failed to marshal module dependencies", err)
+	}
+	return mcp.NewToolResultStructured(dependencies, string(result)), nil
+}
+
+// collectModuleDependencies gathers the dependencies of the root module and its submodules
+func collectModuleDependencies(moduleID string, moduleDetails client.TerraformModuleVersionDetails) moduleDependencies {
+	dependencies := moduleDependencies{
+		ModuleID:          moduleID,
+		RequiredProviders: make(map[string]moduleRequiredProvider),
+		Root:              modulePartDependenciesOf(moduleDetails.Root),
+		Submodules:        make([]modulePartDependencies, 0, len(moduleDetails.Submodules)),
+	}
+	constraints := make(map[string][]string)
+	addProviders := func(providers []client.ModuleProviderDependency) {
+		for _, provider := range providers {
+			if _, ok := dependencies.RequiredProviders[provider.Name]; !ok {
+				dependencies.RequiredProviders[provider.Name] = moduleRequiredProvider{Source: moduleProviderSource(provider)}
+			}
+			for _, constraint := range strings.Split(provider.Version, ",") {
+				constraint = strings.TrimSpace(constraint)
+				if constraint != "" && !slices.Contains(constraints[provider.Name], constraint) {
+					constraints[provider.Name] = append(constraints[provider.Name], constraint)
+				}
+			}
+		}
+	}
+
+	addProviders(moduleDetails.Root.ProviderDependencies)
+	for _, submodule := range moduleDetails.Submodules {
+		dependencies.Submodules = append(dependencies.Submodules, modulePartDependenciesOf(submodule))
+		addProviders(submodule.ProviderDependencies)
+	}
+	for name, requirement := range dependencies.RequiredProviders {
+		requirement.Version = strings.Join(constraints[name], ", ")
+		dependencies.RequiredProviders[name] = requirement
+	}
+	return dependencies
+}
+
+func modulePartDependenciesOf(part client.ModulePart) modulePartDependencies {
+	dependencies := modulePartDependencies{
+		Path:      part.Path,
+		Providers: part.ProviderDependencies,
+		Modules:   part.Dependencies,
+	}
+	// Return empty lists rather than null so the JSON shape does not depend on the module
+	if dependencies.Providers == nil {
+		dependencies.Providers = []client.ModuleProviderDependency{}
+	}
+	if dependencies.Modules == nil {
+		dependencies.Modules = []client.ModuleDependency{}
+	}
+	return dependencies
+}
+
+// moduleProviderSource returns the "namespace/name" source address of a provider dependency
+func moduleProviderSource(provider client.ModuleProviderDependency) string {
+	if provider.Source != "" {
+		return provider.Source
+	}
+	namespace := provider.Namespace
+	if namespace == "" {
+		namespace = "hashicorp"
+	}
+	return namespace + "/" + provider.Name
+}
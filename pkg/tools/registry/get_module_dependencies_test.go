@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestCollectModuleDependencies(t *testing.T) {
+	details := client.TerraformModuleVersionDetails{
+		Root: client.ModulePart{
+			Path: "",
+			ProviderDependencies: []client.ModuleProviderDependency{
+				{Name: "aws", Namespace: "hashicorp", Source: "hashicorp/aws", Version: ">= 4.0"},
+			},
+			Dependencies: []client.ModuleDependency{
+				{Name: "label", Source: "cloudposse/label/null", Version: "0.25.0"},
+			},
+		},
+		Submodules: []client.ModulePart{
+			{
+				Path: "modules/vpc-endpoints",
+				ProviderDependencies: []client.ModuleProviderDependency{
+					{Name: "aws", Namespace: "hashicorp", Source: "hashicorp/aws", Version: ">= 4.0, < 6.0"},
+					{Name: "random", Namespace: "hashicorp", Version: ""},
+				},
+			},
+		},
+	}
+
+	dependencies := collectModuleDependencies("terraform-aws-modules/vpc/aws/5.0.0", details)
+
+	if got := dependencies.RequiredProviders["aws"]; got.Source != "hashicorp/aws" || got.Version != ">= 4.0, < 6.0" {
+		t.Errorf("expected the aws constraints to be combined, got %+v", got)
+	}
+	if got := dependencies.RequiredProviders["random"]; got.Source != "hashicorp/random" || got.Version != "" {
+		t.Errorf("expected random without a constraint, got %+v", got)
+	}
+	if len(dependencies.Root.Modules) != 1 || dependencies.Root.Modules[0].Source != "cloudposse/label/null" {
+		t.Errorf("expected the root module call, got %+v", dependencies.Root.Modules)
+	}
+	if len(dependencies.Submodules) != 1 || dependencies.Submodules[0].Path != "modules/vpc-endpoints" {
+		t.Fatalf("expected one submodule, got %+v", dependencies.Submodules)
+	}
+
+	out, err := json.Marshal(dependencies)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		`"module_id":"terraform-aws-modules/vpc/aws/5.0.0"`,
+		`"random":{"source":"hashicorp/random"}`,
+		`"path":"modules/vpc-endpoints"`,
+		`"modules":[]`,
+	}
+	for _, want := range expected {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("expected %s in JSON, got %s", want, out)
+		}
+	}
+}
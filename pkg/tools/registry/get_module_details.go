@@ -5,12 +5,12 @@ package tools
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -31,9 +31,13 @@ func ModuleDetails(logger *log.Logger) server.ServerTool {
 				mcp.Required(),
 				mcp.Description("Exact valid and compatible module_id retrieved from search_modules (e.g., 'squareops/terraform-kubernetes-mongodb/mongodb/2.1.1', 'GoogleCloudPlatform/vertex-ai/google/0.2.0')"),
 			),
+			mcp.WithBoolean("include_submodules",
+				mcp.Description("Also return the inputs and outputs of each submodule of the module, in a section per submodule"),
+				mcp.DefaultBool(false),
+			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getModuleDetailsHandler(ctx, request, logger)
+			return getModuleDetailsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -41,15 +45,15 @@ func ModuleDetails(logger *log.Logger) server.ServerTool {
 func getModuleDetailsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	moduleID, err := request.RequireString("module_id")
 	if err != nil {
-		return ToolError(logger, "missing required input: module_id", err)
+		return ToolArgumentError(logger, "module_id", "is required")
 	}
 	if moduleID == "" {
-		return ToolError(logger, "module_id cannot be empty", nil)
+		return ToolArgumentError(logger, "module_id", "cannot be empty")
 	}
 
 	// Validate module ID format
 	if err := validateModuleID(moduleID); err != nil {
-		return ToolError(logger, err.Error(), nil)
+		return ToolArgumentError(logger, "module_id", err.Error())
 	}
 
 	moduleID = strings.ToLower(moduleID)
@@ -59,12 +63,12 @@ func getModuleDetailsHandler(ctx context.Context, request mcp.CallToolRequest, l
 		return ToolError(logger, "failed to get http client for public Terraform registry", err)
 	}
 
-	response, err := getModuleDetails(httpClient, moduleID, 0, logger)
+	response, err := getModuleDetails(ctx, httpClient, moduleID, 0, logger)
 	if err != nil {
-		return ToolErrorf(logger, "module not found: %s - use search_modules first to find valid module IDs", moduleID)
+		return ToolErrorf(logger, "failed to get module %s: %v%s", moduleID, err, notFoundHint(err, "use search_modules first to find valid module IDs"))
 	}
 
-	moduleData, err := unmarshalTerraformModule(response)
+	moduleData, details, err := unmarshalTerraformModule(response, request.GetBool("include_submodules", false))
 	if err != nil {
 		return ToolError(logger, "failed to parse module details", err)
 	}
@@ -72,29 +76,42 @@ func getModuleDetailsHandler(ctx context.Context, request mcp.CallToolRequest, l
 		return ToolErrorf(logger, "no module data returned for %s - try a different module_id", moduleID)
 	}
 
-	return mcp.NewToolResultText(moduleData), nil
+	return utils.NewToolResultData(moduleData, details), nil
 }
 
-func getModuleDetails(httpClient *http.Client, moduleID string, currentOffset int, logger *log.Logger) ([]byte, error) {
+func getModuleDetails(ctx context.Context, httpClient *http.Client, moduleID string, currentOffset int, logger *log.Logger) ([]byte, error) {
 	uri := "modules"
 	if moduleID != "" {
 		uri = fmt.Sprintf("modules/%s", moduleID)
 	}
 
 	uri = fmt.Sprintf("%s?offset=%v", uri, currentOffset)
-	response, err := client.SendRegistryCall(httpClient, "GET", uri, logger)
+	response, err := client.SendRegistryCall(ctx, httpClient, "GET", uri, logger, client.RegistryAPIVersions().Modules)
 	if err != nil {
-		return nil, fmt.Errorf("getting module(s) for: %v, please provide a different provider name like aws, azurerm or google etc", moduleID)
+		return nil, fmt.Errorf("getting module(s) for: %v, please provide a different provider name like aws, azurerm or google etc: %w", moduleID, err)
 	}
 
 	return response, nil
 }
 
-func unmarshalTerraformModule(response []byte) (string, error) {
+// getModuleVersionDetails fetches and decodes the registry metadata of a module version
+func getModuleVersionDetails(ctx context.Context, httpClient *http.Client, moduleID string, logger *log.Logger) (client.TerraformModuleVersionDetails, error) {
+	var moduleDetails client.TerraformModuleVersionDetails
+	response, err := getModuleDetails(ctx, httpClient, moduleID, 0, logger)
+	if err != nil {
+		return moduleDetails, err
+	}
+	if err := client.DecodeRegistryResponse(response, &moduleDetails); err != nil {
+		return moduleDetails, fmt.Errorf("unmarshalling module details: %w", err)
+	}
+	return moduleDetails, nil
+}
+
+func unmarshalTerraformModule(response []byte, includeSubmodules bool) (string, client.TerraformModuleVersionDetails, error) {
 	var terraformModules client.TerraformModuleVersionDetails
-	err := json.Unmarshal(response, &terraformModules)
+	err := client.DecodeRegistryResponse(response, &terraformModules)
 	if err != nil {
-		return "", fmt.Errorf("unmarshalling module details: %w", err)
+		return "", client.TerraformModuleVersionDetails{}, fmt.Errorf("unmarshalling module details: %w", err)
 	}
 
 	var builder strings.Builder
@@ -104,36 +121,8 @@ func unmarshalTerraformModule(response []byte) (string, error) {
 	builder.WriteString(fmt.Sprintf("**Namespace:** %s\n\n", terraformModules.Namespace))
 	builder.WriteString(fmt.Sprintf("**Source:** %s\n\n", terraformModules.Source))
 
-	// Format Inputs
-	if len(terraformModules.Root.Inputs) > 0 {
-		builder.WriteString("### Inputs\n\n")
-		builder.WriteString("| Name | Type | Description | Default | Required |\n")
-		builder.WriteString("|---|---|---|---|---|\n")
-		for _, input := range terraformModules.Root.Inputs {
-			builder.WriteString(fmt.Sprintf("| %s | %s | %s | `%v` | %t |\n",
-				input.Name,
-				input.Type,
-				input.Description,
-				input.Default,
-				input.Required,
-			))
-		}
-		builder.WriteString("\n")
-	}
-
-	// Format Outputs
-	if len(terraformModules.Root.Outputs) > 0 {
-		builder.WriteString("### Outputs\n\n")
-		builder.WriteString("| Name | Description |\n")
-		builder.WriteString("|---|---|\n")
-		for _, output := range terraformModules.Root.Outputs {
-			builder.WriteString(fmt.Sprintf("| %s | %s |\n",
-				output.Name,
-				output.Description,
-			))
-		}
-		builder.WriteString("\n")
-	}
+	writeModuleInputs(&builder, "###", terraformModules.Root.Inputs)
+	writeModuleOutputs(&builder, "###", terraformModules.Root.Outputs)
 
 	// Format Provider Dependencies
 	if len(terraformModules.Root.ProviderDependencies) > 0 {
@@ -165,14 +154,76 @@ func unmarshalTerraformModule(response []byte) (string, error) {
 		builder.WriteString("\n")
 	}
 
+	if includeSubmodules {
+		writeModuleSubmodules(&builder, terraformModules.Submodules)
+	} else {
+		terraformModules.Submodules = nil
+	}
+
+	// The README is returned by get_module_readme, the details match the rendered output without it
+	terraformModules.Root.Readme = ""
 	content := builder.String()
-	return content, nil
+	return content, terraformModules, nil
+}
+
+// writeModuleSubmodules writes a section with the inputs and outputs of each submodule, headed by its path
+func writeModuleSubmodules(builder *strings.Builder, submodules []client.ModulePart) {
+	builder.WriteString("### Submodules\n\n")
+	if len(submodules) == 0 {
+		builder.WriteString("This module has no submodules.\n\n")
+		return
+	}
+	for _, submodule := range submodules {
+		builder.WriteString(fmt.Sprintf("#### Submodule: %s\n\n", submodule.Path))
+		builder.WriteString(fmt.Sprintf("**Source:** `//%s`\n\n", submodule.Path))
+		if len(submodule.Inputs) == 0 && len(submodule.Outputs) == 0 {
+			builder.WriteString("No inputs or outputs.\n\n")
+			continue
+		}
+		writeModuleInputs(builder, "#####", submodule.Inputs)
+		writeModuleOutputs(builder, "#####", submodule.Outputs)
+	}
+}
+
+func writeModuleInputs(builder *strings.Builder, heading string, inputs []client.ModuleInput) {
+	if len(inputs) == 0 {
+		return
+	}
+	builder.WriteString(heading + " Inputs\n\n")
+	builder.WriteString("| Name | Type | Description | Default | Required |\n")
+	builder.WriteString("|---|---|---|---|---|\n")
+	for _, input := range inputs {
+		builder.WriteString(fmt.Sprintf("| %s | %s | %s | `%v` | %t |\n",
+			input.Name,
+			input.Type,
+			input.Description,
+			input.Default,
+			input.Required,
+		))
+	}
+	builder.WriteString("\n")
+}
+
+func writeModuleOutputs(builder *strings.Builder, heading string, outputs []client.ModuleOutput) {
+	if len(outputs) == 0 {
+		return
+	}
+	builder.WriteString(heading + " Outputs\n\n")
+	builder.WriteString("| Name | Description |\n")
+	builder.WriteString("|---|---|\n")
+	for _, output := range outputs {
+		builder.WriteString(fmt.Sprintf("| %s | %s |\n",
+			output.Name,
+			output.Description,
+		))
+	}
+	builder.WriteString("\n")
 }
 
+// validateModuleID checks a versioned module ID before any registry call is made
 func validateModuleID(moduleID string) error {
-	parts := strings.Split(moduleID, "/")
-	if len(parts) != 4 {
-		return fmt.Errorf("invalid module ID format '%s'. Expected format: namespace/name/provider/version (4 parts). Use search_modules to find valid module IDs", moduleID)
+	if _, err := utils.ParseModuleID(moduleID, true); err != nil {
+		return fmt.Errorf("%w. Use search_modules to find valid module IDs", err)
 	}
 	return nil
 }
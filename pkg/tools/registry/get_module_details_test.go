@@ -4,8 +4,13 @@
 package tools
 
 import (
+	"context"
+	"net/http"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
 )
 
 // --- UnmarshalModuleSingular ---
@@ -49,7 +54,7 @@ func TestUnmarshalModuleSingular_ValidAllFields(t *testing.T) {
 		"versions": ["1.0.0"],
 		"deprecation": null
 	}`)
-	out, err := unmarshalTerraformModule(resp)
+	out, _, err := unmarshalTerraformModule(resp, false)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -96,7 +101,7 @@ func TestUnmarshalModuleSingular_EmptySections(t *testing.T) {
 		"versions": ["1.0.0"],
 		"deprecation": null
 	}`)
-	out, err := unmarshalTerraformModule(resp)
+	out, _, err := unmarshalTerraformModule(resp, false)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -107,12 +112,53 @@ func TestUnmarshalModuleSingular_EmptySections(t *testing.T) {
 
 func TestUnmarshalModuleSingular_InvalidJSON(t *testing.T) {
 	resp := []byte(`not a json`)
-	_, err := unmarshalTerraformModule(resp)
+	_, _, err := unmarshalTerraformModule(resp, false)
 	if err == nil || !strings.Contains(err.Error(), "unmarshalling module details") {
 		t.Errorf("expected unmarshalling error, got %v", err)
 	}
 }
 
+func TestUnmarshalModuleSingular_Submodules(t *testing.T) {
+	resp := []byte(`{
+		"id": "namespace/name/provider/1.0.0",
+		"namespace": "namespace",
+		"name": "name",
+		"version": "1.0.0",
+		"root": {"path": "", "inputs": [{"name": "root_input", "type": "string", "required": true}], "outputs": []},
+		"submodules": [
+			{"path": "modules/endpoints", "name": "endpoints", "inputs": [{"name": "vpc_id", "type": "string", "description": "ID of the VPC", "required": true}], "outputs": [{"name": "endpoints", "description": "The created endpoints"}]},
+			{"path": "modules/empty", "name": "empty", "inputs": [], "outputs": []}
+		]
+	}`)
+
+	out, _, err := unmarshalTerraformModule(resp, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if strings.Contains(out, "Submodule") || strings.Contains(out, "vpc_id") {
+		t.Errorf("expected no submodule sections unless requested, got %q", out)
+	}
+
+	out, _, err = unmarshalTerraformModule(resp, true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, expected := range []string{
+		"#### Submodule: modules/endpoints\n\n**Source:** `//modules/endpoints`\n\n##### Inputs",
+		"| vpc_id | string | ID of the VPC |",
+		"##### Outputs",
+		"| endpoints | The created endpoints |",
+		"#### Submodule: modules/empty\n\n**Source:** `//modules/empty`\n\nNo inputs or outputs.",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected output to contain %q, got %q", expected, out)
+		}
+	}
+	if strings.Index(out, "root_input") > strings.Index(out, "### Submodules") {
+		t.Errorf("expected the root module before the submodules, got %q", out)
+	}
+}
+
 // --- ValidateModuleID ---
 func TestValidateModuleID_ValidFormat(t *testing.T) {
 	validIDs := []string{
@@ -154,3 +200,22 @@ func TestValidateModuleID_InvalidFormat(t *testing.T) {
 		}
 	}
 }
+
+func TestGetModuleDetailsErrorCode(t *testing.T) {
+	tests := []struct {
+		status   int
+		expected utils.ErrorCode
+	}{
+		{status: http.StatusNotFound, expected: utils.ErrorCodeNotFound},
+		{status: http.StatusInternalServerError, expected: utils.ErrorCodeUpstreamError},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			httpClient := &http.Client{Transport: policyStatusTransport(tt.status)}
+			_, err := getModuleDetails(context.Background(), httpClient, "acme/vpc/aws/1.0.0", 0, log.New())
+			if code := errorCodeOf(err); code != tt.expected {
+				t.Errorf("expected %s, got %s for %v", tt.expected, code, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// moduleInputValidationsNote explains where the constraints come from, as the registry does not publish the
+// validation blocks of module variables
+const moduleInputValidationsNote = "The registry does not publish the validation blocks of module variables. The constraints are derived from the declared types, whether an input is required, and the allowed values and rules documented in the input descriptions. Use get_module_source to read the validation blocks in the module source when exact conditions matter."
+
+// constraintSentenceRegex matches the sentences of an input description that state a rule on its value, e.g. "Must be
+// between 1 and 10 characters"
+var constraintSentenceRegex = regexp.MustCompile(`(?i)\b(?:must|must not|cannot|can only|should be|between|at least|at most|no more than|maximum|minimum|regex|pattern|format|lowercase|uppercase)\b`)
+
+// moduleInputValidations is the structured list of the constraints of the root module inputs of a module version
+type moduleInputValidations struct {
+	ModuleID string                   `json:"module_id"`
+	Note     string                   `json:"note"`
+	Inputs   []moduleInputConstraints `json:"inputs"`
+}
+
+type moduleInputConstraints struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Required bool   `json:"required"`
+	Default  any    `json:"default,omitempty"`
+	// AllowedValues are the values listed in the description, set when it documents at least two
+	AllowedValues []string `json:"allowed_values,omitempty"`
+	// DocumentedRules are the sentences of the description stating a rule on the value
+	DocumentedRules []string `json:"documented_rules,omitempty"`
+}
+
+// GetModuleInputValidations creates a tool that returns the known constraints on the input variables of a module version.
+func GetModuleInputValidations(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_module_input_validations",
+			mcp.WithDescription(`Lists the constraints on the input variables of a Terraform module as structured data, to produce valid values for a module call on the first try: the declared type, whether the input is required, its default, the allowed values documented in its description and the description sentences stating rules on the value (e.g. length, format or pattern).
+The registry does not publish the validation blocks of module variables, so the constraints are derived from what it does publish; use 'get_module_source' to read the exact validation conditions in the module source. You must call 'search_modules' first to obtain a valid module_id.`),
+			mcp.WithTitleAnnotation("List the validation constraints of the inputs of a Terraform module"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("module_id",
+				mcp.Required(),
+				mcp.Description("Exact valid and compatible module_id retrieved from search_modules (e.g., 'terraform-aws-modules/vpc/aws/5.0.0')"),
+			),
+			mcp.WithBoolean("constrained_only",
+				mcp.Description("Only list the inputs that are required or have documented allowed values or rules, defaults to false"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getModuleInputValidationsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getModuleInputValidationsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	moduleID, err := request.RequireString("module_id")
+	if err != nil {
+		return ToolArgumentError(logger, "module_id", "is required")
+	}
+	if moduleID == "" {
+		return ToolArgumentError(logger, "module_id", "cannot be empty")
+	}
+	if err := validateModuleID(moduleID); err != nil {
+		return ToolArgumentError(logger, "module_id", err.Error())
+	}
+	moduleID = strings.ToLower(moduleID)
+	constrainedOnly := request.GetBool("constrained_only", false)
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	inputs, err := getModuleInputs(ctx, httpClient, moduleID, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to get module %s: %v - use search_modules first to find valid module IDs", moduleID, err)
+	}
+
+	validations := listModuleInputValidations(moduleID, inputs, constrainedOnly)
+	result, err := json.MarshalIndent(validations, "", "  ")
+	if err != nil {
+		return ToolError(logger, "failed to marshal module input validations", err)
+	}
+	return mcp.NewToolResultStructured(validations, string(result)), nil
+}
+
+// listModuleInputValidations collects the constraints of the module inputs, in registry order
+func listModuleInputValidations(moduleID string, inputs []client.ModuleInput, constrainedOnly bool) moduleInputValidations {
+	validations := moduleInputValidations{ModuleID: moduleID, Note: moduleInputValidationsNote, Inputs: []moduleInputConstraints{}}
+	for _, input := range inputs {
+		constraints := moduleInputConstraints{
+			Name:            input.Name,
+			Type:            input.Type,
+			Required:        input.Required,
+			AllowedValues:   documentedAllowedValues(input.Description),
+			DocumentedRules: documentedConstraintRules(input.Description),
+		}
+		if !input.Required {
+			constraints.Default = input.Default
+		}
+		if constrainedOnly && !constraints.Required && len(constraints.AllowedValues) == 0 && len(constraints.DocumentedRules) == 0 {
+			continue
+		}
+		validations.Inputs = append(validations.Inputs, constraints)
+	}
+	return validations
+}
+
+// documentedConstraintRules returns the sentences of an input description that state a rule on its value
+func documentedConstraintRules(description string) []string {
+	var rules []string
+	for _, line := range strings.Split(description, "\n") {
+		for _, sentence := range strings.SplitAfter(line, ". ") {
+			sentence = strings.TrimSpace(sentence)
+			if sentence != "" && constraintSentenceRegex.MatchString(sentence) {
+				rules = append(rules, sentence)
+			}
+		}
+	}
+	return rules
+}
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestListModuleInputValidations(t *testing.T) {
+	inputs := []client.ModuleInput{
+		{Name: "name", Type: "string", Description: "Name of the cluster. Must be lowercase and at most 40 characters.", Required: true},
+		{Name: "instance_tenancy", Type: "string", Description: "Tenancy of the instances. Valid values are `default` or `dedicated`", Default: "default"},
+		{Name: "tags", Type: "map(string)", Description: "A map of tags to add to all resources", Default: map[string]any{}},
+	}
+
+	validations := listModuleInputValidations("terraform-aws-modules/vpc/aws/5.0.0", inputs, false)
+	if len(validations.Inputs) != 3 || validations.Note == "" {
+		t.Fatalf("expected 3 inputs and a note, got %+v", validations)
+	}
+	if rules := validations.Inputs[0].DocumentedRules; !reflect.DeepEqual(rules, []string{"Must be lowercase and at most 40 characters."}) {
+		t.Errorf("unexpected documented rules: %v", rules)
+	}
+	if validations.Inputs[0].Default != nil {
+		t.Errorf("expected no default for a required input, got %v", validations.Inputs[0].Default)
+	}
+	if allowed := validations.Inputs[1].AllowedValues; !reflect.DeepEqual(allowed, []string{"default", "dedicated"}) {
+		t.Errorf("unexpected allowed values: %v", allowed)
+	}
+
+	constrained := listModuleInputValidations("terraform-aws-modules/vpc/aws/5.0.0", inputs, true)
+	if len(constrained.Inputs) != 2 || constrained.Inputs[1].Name != "instance_tenancy" {
+		t.Errorf("expected the unconstrained tags input to be left out, got %+v", constrained.Inputs)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// moduleOutputs is the structured list of the root module outputs of a module version
+type moduleOutputs struct {
+	ModuleID string               `json:"module_id"`
+	Outputs  []moduleOutputDetail `json:"outputs"`
+}
+
+type moduleOutputDetail struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Sensitive   bool   `json:"sensitive"`
+	Reference   string `json:"reference"` // expression referencing the output from a calling module
+}
+
+// GetModuleOutputs creates a tool that returns only the outputs of a module version.
+func GetModuleOutputs(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_module_outputs",
+			mcp.WithDescription(`Lists the outputs of a Terraform module as structured data (name, description, whether the output is sensitive and the expression referencing it), without the inputs, dependencies and examples returned by 'get_module_details'.
+Use it to wire modules together. You must call 'search_modules' first to obtain a valid module_id.`),
+			mcp.WithTitleAnnotation("List the outputs of a Terraform module"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("module_id",
+				mcp.Required(),
+				mcp.Description("Exact valid and compatible module_id retrieved from search_modules (e.g., 'terraform-aws-modules/vpc/aws/5.0.0')"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getModuleOutputsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getModuleOutputsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	moduleID, err := request.RequireString("module_id")
+	if err != nil {
+		return ToolArgumentError(logger, "module_id", "is required")
+	}
+	if moduleID == "" {
+		return ToolArgumentError(logger, "module_id", "cannot be empty")
+	}
+	if err := validateModuleID(moduleID); err != nil {
+		return ToolArgumentError(logger, "module_id", err.Error())
+	}
+	moduleID = strings.ToLower(moduleID)
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	moduleDetails, err := getModuleVersionDetails(ctx, httpClient, moduleID, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to get module %s: %v - use search_modules first to find valid module IDs", moduleID, err)
+	}
+
+	outputs := listModuleOutputs(moduleID, moduleDetails)
+	result, err := json.MarshalIndent(outputs, "", "  ")
+	if err != nil {
+		return ToolError(logger, "failed to marshal module outputs", err)
+	}
+	return mcp.NewToolResultStructured(outputs, string(result)), nil
+}
+
+// listModuleOutputs collects the root module outputs, referenced from a module block labelled with the module name
+func listModuleOutputs(moduleID string, moduleDetails client.TerraformModuleVersionDetails) moduleOutputs {
+	outputs := moduleOutputs{ModuleID: moduleID, Outputs: []moduleOutputDetail{}}
+	label := strings.ReplaceAll(moduleDetails.Name, "-", "_")
+	for _, output := range moduleDetails.Root.Outputs {
+		outputs.Outputs = append(outputs.Outputs, moduleOutputDetail{
+			Name:        output.Name,
+			Description: output.Description,
+			Sensitive:   output.Sensitive,
+			Reference:   fmt.Sprintf("module.%s.%s", label, output.Name),
+		})
+	}
+	return outputs
+}
@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestListModuleOutputs(t *testing.T) {
+	response := `{
+		"id": "terraform-aws-modules/rds-aurora/aws/9.0.0",
+		"namespace": "terraform-aws-modules",
+		"name": "rds-aurora",
+		"root": {
+			"inputs": [{"name": "engine", "type": "string", "required": true}],
+			"outputs": [
+				{"name": "cluster_endpoint", "description": "Writer endpoint for the cluster"},
+				{"name": "cluster_master_password", "description": "The database master password", "sensitive": true}
+			]
+		}
+	}`
+	var moduleDetails client.TerraformModuleVersionDetails
+	if err := json.Unmarshal([]byte(response), &moduleDetails); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	outputs := listModuleOutputs("terraform-aws-modules/rds-aurora/aws/9.0.0", moduleDetails)
+	expected := moduleOutputs{
+		ModuleID: "terraform-aws-modules/rds-aurora/aws/9.0.0",
+		Outputs: []moduleOutputDetail{
+			{Name: "cluster_endpoint", Description: "Writer endpoint for the cluster", Reference: "module.rds_aurora.cluster_endpoint"},
+			{Name: "cluster_master_password", Description: "The database master password", Sensitive: true, Reference: "module.rds_aurora.cluster_master_password"},
+		},
+	}
+	if !reflect.DeepEqual(outputs, expected) {
+		t.Errorf("expected %+v, got %+v", expected, outputs)
+	}
+
+	empty := listModuleOutputs("acme/empty/aws/1.0.0", client.TerraformModuleVersionDetails{Name: "empty"})
+	if empty.Outputs == nil || len(empty.Outputs) != 0 {
+		t.Errorf("expected an empty list of outputs, got %+v", empty.Outputs)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// moduleReadmeResult is the typed result of get_module_readme
+type moduleReadmeResult struct {
+	ModuleID string `json:"module_id"`
+	Readme   string `json:"readme"`
+}
+
+// GetModuleReadme creates a tool that returns only the README of a module version from the public registry.
+func GetModuleReadme(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_module_readme",
+			mcp.WithDescription(`Fetches the whole human-written README of a Terraform module version as markdown, every section included, without the inputs, outputs and dependency tables returned by 'get_module_details'. You must call 'search_modules' first to obtain the exact valid and compatible module_id required to use this tool.`),
+			mcp.WithTitleAnnotation("Retrieve the README of a specific Terraform module"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("module_id",
+				mcp.Required(),
+				mcp.Description("Exact valid and compatible module_id retrieved from search_modules (e.g., 'terraform-aws-modules/vpc/aws/5.21.0')"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getModuleReadmeHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getModuleReadmeHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	moduleID, err := request.RequireString("module_id")
+	if err != nil {
+		return ToolArgumentError(logger, "module_id", "is required")
+	}
+	if moduleID == "" {
+		return ToolArgumentError(logger, "module_id", "cannot be empty")
+	}
+	if err := validateModuleID(moduleID); err != nil {
+		return ToolArgumentError(logger, "module_id", err.Error())
+	}
+	moduleID = strings.ToLower(moduleID)
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	moduleDetails, err := getModuleVersionDetails(ctx, httpClient, moduleID, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to get module %s: %v%s", moduleID, err, notFoundHint(err, "use search_modules first to find valid module IDs"))
+	}
+
+	readme := moduleReadme(moduleDetails)
+	if readme == "" {
+		return ToolNotFoundErrorf(logger, "module %s has no README - use get_module_details for its inputs and outputs", moduleID)
+	}
+	return utils.NewToolResultData(readme, moduleReadmeResult{ModuleID: moduleID, Readme: readme}), nil
+}
+
+// moduleReadme returns the whole README of the root module, every section included
+func moduleReadme(moduleDetails client.TerraformModuleVersionDetails) string {
+	return strings.TrimSpace(moduleDetails.Root.Readme)
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestModuleReadme(t *testing.T) {
+	tests := []struct {
+		name     string
+		readme   string
+		expected string
+	}{
+		{
+			name:     "empty",
+			readme:   "",
+			expected: "",
+		},
+		{
+			name:     "whitespace only",
+			readme:   "\n  \n",
+			expected: "",
+		},
+		{
+			name:     "every section",
+			readme:   "\n# AWS VPC Terraform module\n\nCreates a VPC.\n\n## Usage\n\n```hcl\nmodule \"vpc\" {}\n```\n",
+			expected: "# AWS VPC Terraform module\n\nCreates a VPC.\n\n## Usage\n\n```hcl\nmodule \"vpc\" {}\n```",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			details := client.TerraformModuleVersionDetails{Root: client.ModulePart{Readme: tc.readme}}
+			if actual := moduleReadme(details); actual != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
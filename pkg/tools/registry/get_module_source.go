@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// moduleSource is the structured source repository information of a module version
+type moduleSource struct {
+	ModuleID     string `json:"module_id"`
+	Version      string `json:"version"`
+	Repository   string `json:"repository"`
+	Tag          string `json:"tag"`
+	GitSource    string `json:"git_source"`
+	PublishedAt  string `json:"published_at,omitempty"`
+	Verified     bool   `json:"verified"`
+	RegistryPath string `json:"registry_path"`
+}
+
+// GetModuleSource creates a tool that returns the source repository and git tag of a module version.
+func GetModuleSource(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_module_source",
+			mcp.WithDescription(`Returns the source git repository URL of a public registry module version and the git tag it was published from, as structured data, to trace a module back to its source code.
+'git_source' can be used as a module source to pin the exact code outside of the registry. You must call 'search_modules' first to obtain the exact valid and compatible module_id required to use this tool.`),
+			mcp.WithTitleAnnotation("Resolve the source repository of a Terraform module"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("module_id",
+				mcp.Required(),
+				mcp.Description("Exact valid and compatible module_id retrieved from search_modules (e.g., 'terraform-aws-modules/vpc/aws/5.21.0')"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getModuleSourceHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getModuleSourceHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	moduleID, err := request.RequireString("module_id")
+	if err != nil {
+		return ToolArgumentError(logger, "module_id", "is required")
+	}
+	if moduleID == "" {
+		return ToolArgumentError(logger, "module_id", "cannot be empty")
+	}
+	if err := validateModuleID(moduleID); err != nil {
+		return ToolArgumentError(logger, "module_id", err.Error())
+	}
+	moduleID = strings.ToLower(moduleID)
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	moduleDetails, err := getModuleVersionDetails(ctx, httpClient, moduleID, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to get module %s: %v%s", moduleID, err, notFoundHint(err, "use search_modules first to find valid module IDs"))
+	}
+	if moduleDetails.Source == "" {
+		return ToolNotFoundErrorf(logger, "the registry has no source repository for module %s", moduleID)
+	}
+
+	source := newModuleSource(moduleDetails)
+	result, err := json.MarshalIndent(source, "", "  ")
+	if err != nil {
+		return ToolError(logger, "failed to marshal module source", err)
+	}
+	return mcp.NewToolResultStructured(source, string(result)), nil
+}
+
+// newModuleSource collects the source repository information of the module version metadata
+func newModuleSource(moduleDetails client.TerraformModuleVersionDetails) moduleSource {
+	repository := strings.TrimSuffix(strings.TrimSpace(moduleDetails.Source), "/")
+	source := moduleSource{
+		ModuleID:     moduleDetails.ID,
+		Version:      moduleDetails.Version,
+		Repository:   repository,
+		Tag:          moduleDetails.Tag,
+		GitSource:    "git::" + strings.TrimSuffix(repository, ".git") + ".git",
+		Verified:     moduleDetails.Verified,
+		RegistryPath: fmt.Sprintf("%s/%s/%s", moduleDetails.Namespace, moduleDetails.Name, moduleDetails.Provider),
+	}
+	if moduleDetails.Tag != "" {
+		source.GitSource += "?ref=" + moduleDetails.Tag
+	}
+	if !moduleDetails.PublishedAt.IsZero() {
+		source.PublishedAt = moduleDetails.PublishedAt.UTC().Format(time.RFC3339)
+	}
+	return source
+}
@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestNewModuleSource(t *testing.T) {
+	details := client.TerraformModuleVersionDetails{
+		ID:          "terraform-aws-modules/vpc/aws/5.21.0",
+		Namespace:   "terraform-aws-modules",
+		Name:        "vpc",
+		Provider:    "aws",
+		Version:     "5.21.0",
+		Source:      "https://github.com/terraform-aws-modules/terraform-aws-vpc/",
+		Tag:         "v5.21.0",
+		PublishedAt: time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC),
+		Verified:    true,
+	}
+	expected := moduleSource{
+		ModuleID:     "terraform-aws-modules/vpc/aws/5.21.0",
+		Version:      "5.21.0",
+		Repository:   "https://github.com/terraform-aws-modules/terraform-aws-vpc",
+		Tag:          "v5.21.0",
+		GitSource:    "git::https://github.com/terraform-aws-modules/terraform-aws-vpc.git?ref=v5.21.0",
+		PublishedAt:  "2025-04-01T12:00:00Z",
+		Verified:     true,
+		RegistryPath: "terraform-aws-modules/vpc/aws",
+	}
+	if actual := newModuleSource(details); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+
+	// Without a tag the git source is not pinned, and a .git suffix is not doubled
+	details.Tag = ""
+	details.Source = "https://github.com/acme/terraform-aws-vpc.git"
+	details.PublishedAt = time.Time{}
+	actual := newModuleSource(details)
+	if actual.GitSource != "git::https://github.com/acme/terraform-aws-vpc.git" || actual.PublishedAt != "" {
+		t.Errorf("unexpected source without tag: %+v", actual)
+	}
+}
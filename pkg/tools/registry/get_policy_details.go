@@ -5,9 +5,13 @@ package tools
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
+	"path"
 	"strings"
 	"text/template"
 
@@ -19,6 +23,23 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 )
 
+// policySetDetails is the typed result of get_policy_details
+type policySetDetails struct {
+	TerraformPolicyID string               `json:"terraform_policy_id"`
+	Readme            string               `json:"readme"`
+	Policies          []policyDetailsEntry `json:"policies"`
+	PolicyModules     []policyEntry        `json:"policy_modules"`
+	// HCLTemplate is the policies.hcl template the policies are filled into
+	HCLTemplate    string `json:"hcl_template"`
+	ChecksumReport string `json:"checksum_report,omitempty"`
+}
+
+type policyDetailsEntry struct {
+	Name             string `json:"name"`
+	Sha256           string `json:"sha256"`
+	EnforcementLevel string `json:"enforcement_level"`
+}
+
 func PolicyDetails(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("get_policy_details",
@@ -31,9 +52,13 @@ func PolicyDetails(logger *log.Logger) server.ServerTool {
 				mcp.Required(),
 				mcp.Description("Matching terraform_policy_id retrieved from the 'search_policies' tool (e.g., 'policies/hashicorp/CIS-Policy-Set-for-AWS-Terraform/1.0.1')"),
 			),
+			mcp.WithBoolean("verify_checksums",
+				mcp.Description("Download every policy and policy module file and verify that its SHA-256 matches the checksum advertised by the registry. Makes one additional request per file"),
+				mcp.DefaultBool(false),
+			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getPolicyDetailsHandler(ctx, request, logger)
+			return getPolicyDetailsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -41,10 +66,10 @@ func PolicyDetails(logger *log.Logger) server.ServerTool {
 func getPolicyDetailsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	terraformPolicyID, err := request.RequireString("terraform_policy_id")
 	if err != nil {
-		return ToolError(logger, "missing required input: terraform_policy_id - use search_policies first to find valid policy IDs", err)
+		return ToolArgumentError(logger, "terraform_policy_id", "is required - use search_policies first to find valid policy IDs")
 	}
 	if terraformPolicyID == "" {
-		return ToolError(logger, "terraform_policy_id cannot be empty - use search_policies first to find valid policy IDs", nil)
+		return ToolArgumentError(logger, "terraform_policy_id", "cannot be empty - use search_policies first to find valid policy IDs")
 	}
 
 	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
@@ -52,62 +77,65 @@ func getPolicyDetailsHandler(ctx context.Context, request mcp.CallToolRequest, l
 		return ToolError(logger, "failed to get http client for public Terraform registry", err)
 	}
 
-	policyResp, err := client.SendRegistryCall(httpClient, "GET", (&url.URL{Path: terraformPolicyID, RawQuery: url.Values{"include": {"policies,policy-modules,policy-library"}}.Encode()}).String(), logger, "v2")
+	policyDetails, err := fetchPolicyDetails(ctx, httpClient, terraformPolicyID, logger)
 	if err != nil {
-		return ToolErrorf(logger, "policy not found: %s - verify the terraform_policy_id is correct or use search_policies to find valid IDs", terraformPolicyID)
-	}
-
-	var policyDetails client.TerraformPolicyDetails
-	if err := json.Unmarshal(policyResp, &policyDetails); err != nil {
-		return ToolErrorf(logger, "failed to parse policy details for %s", terraformPolicyID)
+		return ToolErrorf(logger, "%v", err)
 	}
 
+	policyPath := strings.Trim(terraformPolicyID, "/")
 	readme := utils.ExtractReadme(policyDetails.Data.Attributes.Readme)
 	var builder strings.Builder
 	builder.WriteString(fmt.Sprintf("## Policy details about %s \n\n%s", terraformPolicyID, readme))
+	policySet := listPolicySet(terraformPolicyID, policyDetails)
 	policyList := ""
 	moduleList := ""
-	for _, policy := range policyDetails.Included {
-		if policy.Type == "policy-modules" {
-			var moduleBuilder strings.Builder
-			tmpl := `
+	for _, policyModule := range policySet.PolicyModules {
+		var moduleBuilder strings.Builder
+		tmpl := `
 module "{{.Name}}" {
-	source = "https://registry.terraform.io/v2{{.PolicyID}}/policy-module/{{.Name}}.sentinel?checksum=sha256:{{.Shasum}}"
+	source = "https://registry.terraform.io/v2/{{.PolicyID}}/policy-module/{{.Name}}.sentinel?checksum=sha256:{{.Shasum}}"
 }
 `
-			type moduleData struct {
-				Name     string
-				PolicyID string
-				Shasum   string
-			}
-			t := template.Must(template.New("module").Parse(tmpl))
-			err := t.Execute(&moduleBuilder, moduleData{
-				Name:     policy.Attributes.Name,
-				PolicyID: terraformPolicyID,
-				Shasum:   policy.Attributes.Shasum,
-			})
-			if err != nil {
-				logger.WithError(err).Error("failed to render module template")
-			}
-			moduleList += moduleBuilder.String()
+		type moduleData struct {
+			Name     string
+			PolicyID string
+			Shasum   string
 		}
-
-		if policy.Type == "policies" {
-			policyList += fmt.Sprintf("- POLICY_NAME: %s\n- POLICY_CHECKSUM: sha256:%s\n", policy.Attributes.Name, policy.Attributes.Shasum)
-			policyList += "\n---\n"
+		t := template.Must(template.New("module").Parse(tmpl))
+		err := t.Execute(&moduleBuilder, moduleData{
+			Name:     policyModule.Name,
+			PolicyID: policyPath,
+			Shasum:   policyModule.Sha256,
+		})
+		if err != nil {
+			logger.WithError(err).Error("failed to render module template")
 		}
+		moduleList += moduleBuilder.String()
+	}
+	enforcementLevels := listPolicyEnforcementLevels(terraformPolicyID, policyDetails).Policies
+	details := policySetDetails{
+		TerraformPolicyID: terraformPolicyID,
+		Readme:            readme,
+		Policies:          []policyDetailsEntry{},
+		PolicyModules:     policySet.PolicyModules,
+	}
+	for i, policy := range policySet.Policies {
+		policyList += fmt.Sprintf("- POLICY_NAME: %s\n- POLICY_CHECKSUM: sha256:%s\n", policy.Name, policy.Sha256)
+		policyList += fmt.Sprintf("- ENFORCEMENT_LEVEL: %s\n", enforcementLevels[i].EnforcementLevel)
+		policyList += "\n---\n"
+		details.Policies = append(details.Policies, policyDetailsEntry{Name: policy.Name, Sha256: policy.Sha256, EnforcementLevel: enforcementLevels[i].EnforcementLevel})
 	}
 	builder.WriteString("---\n")
 	builder.WriteString("## Usage\n\n")
-	builder.WriteString("Generate the content for a HashiCorp Configuration Language (HCL) file named policies.hcl. This file should define a set of policies. For each policy provided, create a distinct policy block using the following template.\n")
+	builder.WriteString("Generate the content for a HashiCorp Configuration Language (HCL) file named policies.hcl. This file should define a set of policies. For each policy provided, create a distinct policy block using the following template, with the enforcement level recommended for the policy (advisory when the registry recommends none).\n")
 	builder.WriteString("\n```hcl\n")
 	hclTmpl := `
 {{- if .ModuleList }}
 {{ .ModuleList }}
 {{- end }}
 policy "<<POLICY_NAME>>" {
-  source = "https://registry.terraform.io/v2{{ .TerraformPolicyID }}/policy/<<POLICY_NAME>>.sentinel?checksum=<<POLICY_CHECKSUM>>"
-  enforcement_level = "advisory"
+  source = "https://registry.terraform.io/v2/{{ .TerraformPolicyID }}/policy/<<POLICY_NAME>>.sentinel?checksum=<<POLICY_CHECKSUM>>"
+  enforcement_level = "<<ENFORCEMENT_LEVEL>>"
 }
 `
 	type hclTemplateData struct {
@@ -118,17 +146,102 @@ policy "<<POLICY_NAME>>" {
 	t := template.Must(template.New("hclPolicy").Parse(hclTmpl))
 	err = t.Execute(&hclBuilder, hclTemplateData{
 		ModuleList:        moduleList,
-		TerraformPolicyID: terraformPolicyID,
+		TerraformPolicyID: policyPath,
 	})
 	if err != nil {
 		logger.WithError(err).Error("failed to render HCL policy template")
 	}
 	hclTemplate := hclBuilder.String()
+	details.HCLTemplate = hclTemplate
 	builder.WriteString(hclTemplate)
 	builder.WriteString("\n```\n")
 	builder.WriteString(fmt.Sprintf("Available policies with SHA for %s are: \n\n", terraformPolicyID))
 	builder.WriteString(policyList)
 
+	if request.GetBool("verify_checksums", false) {
+		details.ChecksumReport = verifyPolicyChecksums(ctx, httpClient, policyPath, policyDetails, logger)
+		builder.WriteString(details.ChecksumReport)
+	}
+
 	policyData := builder.String()
-	return mcp.NewToolResultText(policyData), nil
+	return utils.NewToolResultData(policyData, details), nil
+}
+
+// errPolicyAPIUnavailable is returned when the policy API fails for another reason than an unknown policy
+var errPolicyAPIUnavailable = errors.New("policy API unavailable")
+
+// fetchPolicyDetails gets a policy set including its policies and policy modules. Policies are only served by the
+// v2 API, there is no v1 endpoint to fall back to, so failures other than 404 are reported as the policy API being
+// unavailable rather than the policy not existing.
+func fetchPolicyDetails(ctx context.Context, httpClient *http.Client, terraformPolicyID string, logger *log.Logger) (client.TerraformPolicyDetails, error) {
+	var policyDetails client.TerraformPolicyDetails
+	policyResp, err := client.SendRegistryCall(ctx, httpClient, "GET", (&url.URL{Path: terraformPolicyID, RawQuery: url.Values{"include": {"policies,policy-modules,policy-library"}}.Encode()}).String(), logger, client.RegistryAPIVersions().Policies)
+	if err != nil {
+		if client.RegistryErrorCode(err) == utils.ErrorCodeNotFound {
+			return policyDetails, fmt.Errorf("policy not found: %s - verify the terraform_policy_id is correct or use search_policies to find valid IDs: %w", terraformPolicyID, err)
+		}
+		logger.Warnf("v2 policy API request for %s failed: %v", terraformPolicyID, err)
+		return policyDetails, fmt.Errorf("%w: %s could not be fetched from the v2 policy API, the policy may exist - retry later: %w", errPolicyAPIUnavailable, terraformPolicyID, err)
+	}
+	logger.Debugf("Fetched policy %s from the v2 policy API", terraformPolicyID)
+	if err := client.DecodeRegistryResponse(policyResp, &policyDetails); err != nil {
+		return policyDetails, fmt.Errorf("failed to parse policy details for %s", terraformPolicyID)
+	}
+	return policyDetails, nil
+}
+
+// policyFileKinds maps the included policy types to the path segment of their downloadable .sentinel files
+var policyFileKinds = map[string]string{
+	"policies":       "policy",
+	"policy-modules": "policy-module",
+}
+
+// verifyPolicyChecksums downloads the policy and policy module files of a policy set and reports whether their
+// SHA-256 matches the checksum advertised by the registry
+func verifyPolicyChecksums(ctx context.Context, httpClient *http.Client, policyPath string, policyDetails client.TerraformPolicyDetails, logger *log.Logger) string {
+	var builder strings.Builder
+	verified, mismatched, failed := 0, 0, 0
+	// The included entries are anonymous structs, so the policy files are referenced by their index
+	var policies []int
+	for i, policy := range policyDetails.Included {
+		if _, ok := policyFileKinds[policy.Type]; ok {
+			policies = append(policies, i)
+		}
+	}
+	contents := client.ParallelRegistryCalls(policies, 0, func(index int) ([]byte, error) {
+		policy := policyDetails.Included[index]
+		return client.SendRegistryCall(ctx, httpClient, http.MethodGet, path.Join(policyPath, policyFileKinds[policy.Type], policy.Attributes.Name+".sentinel"), logger, client.RegistryAPIVersions().Policies)
+	})
+	for i, index := range policies {
+		policy := policyDetails.Included[index]
+		kind, name := policyFileKinds[policy.Type], policy.Attributes.Name
+		if err := contents[i].Err; err != nil {
+			failed++
+			logger.WithError(err).Warnf("failed to download %s %s for checksum verification", kind, name)
+			builder.WriteString(fmt.Sprintf("- %s %s: NOT VERIFIED, download failed: %v\n", kind, name, err))
+			continue
+		}
+		if actual, ok := policyChecksumMatches(contents[i].Value, policy.Attributes.Shasum); ok {
+			verified++
+			builder.WriteString(fmt.Sprintf("- %s %s: verified (sha256:%s)\n", kind, name, actual))
+		} else {
+			mismatched++
+			builder.WriteString(fmt.Sprintf("- %s %s: MISMATCH, registry advertises sha256:%s but the file hashes to sha256:%s\n", kind, name, policy.Attributes.Shasum, actual))
+		}
+	}
+
+	summary := fmt.Sprintf("\n## Checksum verification\n\n**Verified:** %d, **Mismatched:** %d, **Failed:** %d\n\n", verified, mismatched, failed)
+	if mismatched > 0 {
+		summary += "WARNING: do not reference the mismatched files until the discrepancy is resolved, they may have been tampered with.\n\n"
+	}
+	return summary + builder.String()
+}
+
+// policyChecksumMatches returns the hex SHA-256 of content and whether it matches the expected checksum,
+// which may carry a "sha256:" prefix
+func policyChecksumMatches(content []byte, expected string) (string, bool) {
+	sum := sha256.Sum256(content)
+	actual := hex.EncodeToString(sum[:])
+	expected = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(expected)), "sha256:")
+	return actual, expected != "" && actual == expected
 }
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+)
+
+type policyFileTransport map[string]string
+
+func (t policyFileTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, ok := t[req.URL.Path]
+	if !ok {
+		return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader("")), Header: http.Header{}}, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK, Status: "200 OK", Body: io.NopCloser(strings.NewReader(body)), Header: http.Header{}}, nil
+}
+
+func sha256Hex(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestPolicyChecksumMatches(t *testing.T) {
+	content := []byte("main = rule { true }\n")
+	expected := sha256Hex(string(content))
+
+	if actual, ok := policyChecksumMatches(content, expected); !ok || actual != expected {
+		t.Errorf("expected checksum %s to match, got %s", expected, actual)
+	}
+	if _, ok := policyChecksumMatches(content, "sha256:"+strings.ToUpper(expected)); !ok {
+		t.Error("expected prefixed upper-case checksum to match")
+	}
+	if _, ok := policyChecksumMatches(content, "deadbeef"); ok {
+		t.Error("expected different checksum not to match")
+	}
+	if _, ok := policyChecksumMatches(content, ""); ok {
+		t.Error("expected empty checksum not to match")
+	}
+}
+
+func TestVerifyPolicyChecksums(t *testing.T) {
+	policyPath := "policies/hashicorp/example/1.0.0"
+	httpClient := &http.Client{Transport: policyFileTransport{
+		"/v2/" + policyPath + "/policy/good.sentinel":           "good policy",
+		"/v2/" + policyPath + "/policy/tampered.sentinel":       "tampered policy",
+		"/v2/" + policyPath + "/policy-module/helpers.sentinel": "helpers",
+	}}
+
+	var details client.TerraformPolicyDetails
+	included := `{"included": [
+		{"type": "policies", "attributes": {"name": "good", "shasum": "` + sha256Hex("good policy") + `"}},
+		{"type": "policies", "attributes": {"name": "tampered", "shasum": "` + sha256Hex("original policy") + `"}},
+		{"type": "policies", "attributes": {"name": "missing", "shasum": "abc"}},
+		{"type": "policy-modules", "attributes": {"name": "helpers", "shasum": "` + sha256Hex("helpers") + `"}},
+		{"type": "policy-library", "attributes": {"name": "library"}}
+	]}`
+	if err := json.Unmarshal([]byte(included), &details); err != nil {
+		t.Fatalf("failed to unmarshal policy details: %v", err)
+	}
+
+	out := verifyPolicyChecksums(context.Background(), httpClient, policyPath, details, log.New())
+	expected := []string{
+		"**Verified:** 2, **Mismatched:** 1, **Failed:** 1",
+		"WARNING:",
+		"- policy good: verified",
+		"- policy tampered: MISMATCH",
+		"- policy missing: NOT VERIFIED",
+		"- policy-module helpers: verified",
+	}
+	for _, want := range expected {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "library") {
+		t.Errorf("policy libraries have no checksum to verify, got:\n%s", out)
+	}
+}
+
+type policyStatusTransport int
+
+func (t policyStatusTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: int(t), Status: fmt.Sprintf("%d %s", t, http.StatusText(int(t))), Body: io.NopCloser(strings.NewReader("")), Header: http.Header{}}, nil
+}
+
+func TestFetchPolicyDetailsErrors(t *testing.T) {
+	tests := []struct {
+		status      int
+		unavailable bool
+		expected    string
+	}{
+		{status: http.StatusNotFound, expected: "policy not found: policies/acme/set/1.0.0"},
+		{status: http.StatusServiceUnavailable, unavailable: true, expected: "policy API unavailable: policies/acme/set/1.0.0 could not be fetched from the v2 policy API"},
+		{status: http.StatusInternalServerError, unavailable: true, expected: "policy API unavailable"},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			httpClient := &http.Client{Transport: policyStatusTransport(tt.status)}
+			_, err := fetchPolicyDetails(context.Background(), httpClient, "policies/acme/set/1.0.0", log.New())
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Fatalf("expected error containing %q, got %v", tt.expected, err)
+			}
+			if errors.Is(err, errPolicyAPIUnavailable) != tt.unavailable {
+				t.Errorf("expected unavailable %v, got %v", tt.unavailable, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// defaultPolicyEnforcementLevel is the enforcement level Sentinel applies to a policy block that does not set one
+const defaultPolicyEnforcementLevel = "advisory"
+
+// policySetTargetProviders are the providers a policy set is recognized to target from its name and title
+var policySetTargetProviders = []string{"aws", "azurerm", "google", "kubernetes", "oci", "alicloud", "vsphere", "tfe"}
+
+// policySetMetadata summarizes a policy set version without its readme
+type policySetMetadata struct {
+	TerraformPolicyID string `json:"terraform_policy_id"`
+	Namespace         string `json:"namespace"`
+	Name              string `json:"name"`
+	Title             string `json:"title,omitempty"`
+	Version           string `json:"version"`
+	Description       string `json:"description,omitempty"`
+	Provider          string `json:"provider,omitempty"`
+	// EnforcementDefault is the level the registry recommends for every policy of the set, empty when the policies
+	// recommend different levels or none
+	EnforcementDefault string    `json:"enforcement_default,omitempty"`
+	PolicyCount        int       `json:"policy_count"`
+	PolicyModuleCount  int       `json:"policy_module_count"`
+	Downloads          int       `json:"downloads"`
+	PublishedAt        time.Time `json:"published_at"`
+	Source             string    `json:"source,omitempty"`
+}
+
+// GetPolicySetMetadata creates a tool that returns a short summary of a policy set.
+func GetPolicySetMetadata(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_policy_set_metadata",
+			mcp.WithDescription(`Returns a short summary of a policy set from the Terraform registry as structured data: name, version, targeted provider, the enforcement level recommended for all of its policies when they share one, and the number of policies and policy modules, without the readme returned by 'get_policy_details'. Use it to decide whether a policy set is relevant before fetching its details. You must call 'search_policies' first to obtain the exact terraform_policy_id required to use this tool.`),
+			mcp.WithTitleAnnotation("Summarize a Terraform policy set"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_policy_id",
+				mcp.Required(),
+				mcp.Description("Matching terraform_policy_id retrieved from the 'search_policies' tool (e.g., 'policies/hashicorp/CIS-Policy-Set-for-AWS-Terraform/1.0.1')"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getPolicySetMetadataHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getPolicySetMetadataHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	terraformPolicyID, err := request.RequireString("terraform_policy_id")
+	if err != nil {
+		return ToolArgumentError(logger, "terraform_policy_id", "is required - use search_policies first to find valid policy IDs")
+	}
+	if terraformPolicyID == "" {
+		return ToolArgumentError(logger, "terraform_policy_id", "cannot be empty - use search_policies first to find valid policy IDs")
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	policyDetails, err := fetchPolicyDetails(ctx, httpClient, terraformPolicyID, logger)
+	if err != nil {
+		return ToolErrorf(logger, "%v", err)
+	}
+
+	metadata := summarizePolicySet(terraformPolicyID, policyDetails)
+	result, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return ToolError(logger, "failed to marshal policy set metadata", err)
+	}
+	return mcp.NewToolResultStructured(metadata, string(result)), nil
+}
+
+// summarizePolicySet builds the metadata of a policy set version, the namespace and name are taken from the
+// terraform_policy_id (policies/<namespace>/<name>/<version>) and the title from the included policy library
+func summarizePolicySet(terraformPolicyID string, policyDetails client.TerraformPolicyDetails) policySetMetadata {
+	attributes := policyDetails.Data.Attributes
+	metadata := policySetMetadata{
+		TerraformPolicyID:  terraformPolicyID,
+		Version:            attributes.Version,
+		Description:        attributes.Description,
+		EnforcementDefault: commonPolicyEnforcementLevel(policyDetails),
+		PolicyCount:        len(policyDetails.Data.Relationships.Policies.Data),
+		PolicyModuleCount:  len(policyDetails.Data.Relationships.PolicyModules.Data),
+		Downloads:          attributes.Downloads,
+		PublishedAt:        attributes.PublishedAt,
+		Source:             attributes.Source,
+	}
+	if parts := strings.Split(strings.Trim(terraformPolicyID, "/"), "/"); len(parts) == 4 {
+		metadata.Namespace, metadata.Name = parts[1], parts[2]
+		if metadata.Version == "" {
+			metadata.Version = parts[3]
+		}
+	}
+	for _, included := range policyDetails.Included {
+		if included.Type == "policy-library" {
+			metadata.Title = included.Attributes.Title
+			if metadata.Name == "" {
+				metadata.Name = included.Attributes.Name
+			}
+		}
+	}
+	for _, provider := range policySetTargetProviders {
+		if policyMatchesProvider(metadata.Name, metadata.Title, provider) {
+			metadata.Provider = provider
+			break
+		}
+	}
+	return metadata
+}
+
+// commonPolicyEnforcementLevel returns the enforcement level the registry recommends for all the included policies,
+// or an empty string when they recommend different levels or a policy recommends none
+func commonPolicyEnforcementLevel(policyDetails client.TerraformPolicyDetails) string {
+	common := ""
+	for _, included := range policyDetails.Included {
+		if included.Type != "policies" {
+			continue
+		}
+		level, recommended := normalizePolicyEnforcementLevel(included.Attributes.EnforcementLevel)
+		if !recommended || (common != "" && level != common) {
+			return ""
+		}
+		common = level
+	}
+	return common
+}
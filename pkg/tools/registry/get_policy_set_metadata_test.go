@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestSummarizePolicySet(t *testing.T) {
+	response := `{
+  "data": {
+    "type": "policy-library-versions",
+    "id": "42",
+    "attributes": {"description": "CIS benchmark policies", "downloads": 1234, "version": "1.0.1", "readme": "# A long readme"},
+    "relationships": {
+      "policies": {"data": [{"type": "policies", "id": "1"}, {"type": "policies", "id": "2"}, {"type": "policies", "id": "3"}]},
+      "policy-modules": {"data": [{"type": "policy-modules", "id": "4"}]}
+    }
+  },
+  "included": [
+    {"type": "policy-library", "attributes": {"name": "CIS-Policy-Set-for-AWS-Terraform", "title": "Pre-written Sentinel Policies for AWS CIS Foundations Benchmark"}}
+  ]
+}`
+	var policyDetails client.TerraformPolicyDetails
+	if err := json.Unmarshal([]byte(response), &policyDetails); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	metadata := summarizePolicySet("policies/hashicorp/CIS-Policy-Set-for-AWS-Terraform/1.0.1", policyDetails)
+	expected := policySetMetadata{
+		TerraformPolicyID: "policies/hashicorp/CIS-Policy-Set-for-AWS-Terraform/1.0.1",
+		Namespace:         "hashicorp",
+		Name:              "CIS-Policy-Set-for-AWS-Terraform",
+		Title:             "Pre-written Sentinel Policies for AWS CIS Foundations Benchmark",
+		Version:           "1.0.1",
+		Description:       "CIS benchmark policies",
+		Provider:          "aws",
+		PolicyCount:       3,
+		PolicyModuleCount: 1,
+		Downloads:         1234,
+	}
+	if metadata != expected {
+		t.Errorf("expected %+v, got %+v", expected, metadata)
+	}
+
+	output, err := json.Marshal(metadata)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(string(output), "readme") {
+		t.Errorf("expected the metadata without the readme, got %s", output)
+	}
+	if strings.Contains(string(output), "enforcement_default") {
+		t.Errorf("expected no enforcement default when the registry recommends none, got %s", output)
+	}
+}
+
+func TestCommonPolicyEnforcementLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		levels   []string
+		expected string
+	}{
+		{name: "shared level", levels: []string{"hard-mandatory", "Hard Mandatory"}, expected: "hard-mandatory"},
+		{name: "different levels", levels: []string{"hard-mandatory", "advisory"}},
+		{name: "a policy without a level", levels: []string{"soft-mandatory", ""}},
+		{name: "no policies"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var included []string
+			for _, level := range tc.levels {
+				included = append(included, fmt.Sprintf(`{"type": "policies", "attributes": {"enforcement-level": %q}}`, level))
+			}
+			var policyDetails client.TerraformPolicyDetails
+			if err := json.Unmarshal([]byte(`{"included": [`+strings.Join(included, ",")+`]}`), &policyDetails); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual := commonPolicyEnforcementLevel(policyDetails); actual != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
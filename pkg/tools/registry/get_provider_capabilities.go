@@ -5,7 +5,6 @@ package tools
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -49,7 +48,7 @@ Returns a summary with counts and examples for each capability type.`),
 				mcp.Description("The version of the provider to analyze (defaults to 'latest')")),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getProviderCapabilitiesHandler(ctx, request, logger)
+			return getProviderCapabilitiesHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -57,26 +56,30 @@ Returns a summary with counts and examples for each capability type.`),
 func getProviderCapabilitiesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	namespace, err := request.RequireString("namespace")
 	if err != nil {
-		return ToolError(logger, "missing required input: namespace", err)
+		return ToolArgumentError(logger, "namespace", "is required")
 	}
 	namespace = strings.ToLower(namespace)
 
 	name, err := request.RequireString("name")
 	if err != nil {
-		return ToolError(logger, "missing required input: name", err)
+		return ToolArgumentError(logger, "name", "is required")
 	}
 	name = strings.ToLower(name)
 
-	version := request.GetString("version", "latest")
-	if version == "latest" || !utils.IsValidProviderVersionFormat(version) {
+	version, err := utils.ResolveVersionInput(request.GetString("version", "latest"))
+	if err != nil {
+		// Anything that is not a version is looked up as the latest release
+		version = "latest"
+	}
+	if version == "latest" {
 		httpClient, err := client.GetHttpClientFromContext(ctx, logger)
 		if err != nil {
 			return ToolError(logger, "failed to get http client for public Terraform registry", err)
 		}
 
-		latestVersion, err := client.GetLatestProviderVersion(httpClient, namespace, name, logger)
+		latestVersion, err := client.GetLatestProviderVersion(ctx, httpClient, namespace, name, logger)
 		if err != nil {
-			return ToolErrorf(logger, "provider not found: %s/%s - verify the namespace and provider name are correct", namespace, name)
+			return ToolErrorf(logger, "failed to get provider %s/%s: %v%s", namespace, name, err, notFoundHint(err, "verify the namespace and provider name are correct"))
 		}
 		version = latestVersion
 	}
@@ -87,18 +90,19 @@ func getProviderCapabilitiesHandler(ctx context.Context, request mcp.CallToolReq
 	}
 
 	uri := fmt.Sprintf("providers/%s/%s/%s", namespace, name, version)
-	response, err := client.SendRegistryCall(httpClient, "GET", uri, logger)
+	response, err := client.SendRegistryCall(ctx, httpClient, "GET", uri, logger, client.RegistryAPIVersions().Providers)
 	if err != nil {
 		return ToolErrorf(logger, "failed to fetch provider docs for %s/%s:%s - verify the provider exists", namespace, name, version)
 	}
 
 	var providerDocs client.ProviderDocs
-	if err := json.Unmarshal(response, &providerDocs); err != nil {
+	if err := client.DecodeRegistryResponse(response, &providerDocs); err != nil {
 		return ToolErrorf(logger, "failed to parse provider docs for %s/%s:%s", namespace, name, version)
 	}
 
 	output := analyzeAndFormatCapabilities(providerDocs, namespace, name, version)
-	return mcp.NewToolResultText(output), nil
+	providerDetail := client.ProviderDetail{ProviderNamespace: namespace, ProviderName: name, ProviderVersion: version}
+	return utils.NewToolResultData(output, countProviderDocCategories(providerDocs, providerDetail)), nil
 }
 
 func analyzeAndFormatCapabilities(docs client.ProviderDocs, namespace, name, version string) string {
@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxChangelogBytes bounds the size of a changelog read from a provider's source repository
+const maxChangelogBytes = 10 << 20
+
+// GetProviderChangelog creates a tool that returns the changelog entries of a provider between two versions.
+func GetProviderChangelog(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_provider_changelog",
+			mcp.WithDescription(`Fetches the CHANGELOG.md of a Terraform provider from the source repository linked in the public registry and returns the entries of the releases after 'from_version' up to and including 'to_version', newest first. Use it when planning a provider upgrade.
+If the provider does not publish a changelog in its source repository, a message saying so is returned instead.`),
+			mcp.WithTitleAnnotation("Get the changelog of a Terraform provider between two versions"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("from_version",
+				mcp.Required(),
+				mcp.Description("The version currently in use in the format 'x.y.z', its own entry is not included"),
+			),
+			mcp.WithString("to_version",
+				mcp.Description("The target version in the format 'x.y.z', defaults to 'latest'"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getProviderChangelogHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getProviderChangelogHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	providerName, err := request.RequireString("provider_name")
+	if err != nil || strings.TrimSpace(providerName) == "" {
+		return ToolArgumentError(logger, "provider_name", "is required")
+	}
+	providerName = strings.ToLower(strings.TrimSpace(providerName))
+	providerNamespace := providerNamespaceArgument(request)
+
+	fromVersion, err := request.RequireString("from_version")
+	if err != nil || strings.TrimSpace(fromVersion) == "" {
+		return ToolArgumentError(logger, "from_version", "is required")
+	}
+	fromVersion, err = utils.NormalizeVersion(fromVersion)
+	if err != nil {
+		return ToolArgumentError(logger, "from_version", "must be a version in the format 'x.y.z'")
+	}
+	toVersion, err := utils.ResolveVersionInput(request.GetString("to_version", "latest"))
+	if err != nil {
+		return ToolArgumentError(logger, "to_version", "must be a version in the format 'x.y.z' or 'latest'")
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	response, err := client.SendRegistryCall(ctx, httpClient, http.MethodGet, path.Join("providers", providerNamespace, providerName), logger, client.RegistryAPIVersions().Providers)
+	if err != nil {
+		return ToolErrorf(logger, "failed to get provider %s/%s: %v%s", providerNamespace, providerName, err, notFoundHint(err, "verify the namespace and provider name are correct"))
+	}
+	var provider client.ProviderVersionLatest
+	if err := client.DecodeRegistryResponse(response, &provider); err != nil {
+		return ToolError(logger, "failed to parse provider details", err)
+	}
+	if toVersion == "latest" {
+		toVersion = provider.Version
+	}
+	if cmp, err := utils.CompareVersions(fromVersion, toVersion); err == nil && cmp > 0 {
+		return ToolArgumentError(logger, "from_version", fmt.Sprintf("must not be newer than to_version %s", toVersion))
+	}
+
+	changelog := providerChangelog{
+		Provider:    providerNamespace + "/" + providerName,
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Repository:  provider.Source,
+		Entries:     []utils.ChangelogEntry{},
+	}
+	changelogURL, ok := githubChangelogURL(provider.Source)
+	if !ok {
+		return utils.NewToolResultData(changelogNotPublished(providerNamespace, providerName, provider.Source), changelog), nil
+	}
+	content, found, err := fetchProviderChangelog(ctx, httpClient, changelogURL)
+	if err != nil {
+		return ToolError(logger, "failed to fetch the provider changelog", err)
+	}
+	entries := utils.ParseChangelog(content)
+	if !found || len(entries) == 0 {
+		return utils.NewToolResultData(changelogNotPublished(providerNamespace, providerName, provider.Source), changelog), nil
+	}
+
+	between, err := utils.ChangelogBetween(entries, fromVersion, toVersion)
+	if err != nil {
+		return ToolArgumentError(logger, "from_version", err.Error())
+	}
+	changelog.Published, changelog.Source = true, changelogURL
+	changelog.Entries = append(changelog.Entries, between...)
+	return utils.NewToolResultData(formatProviderChangelog(providerNamespace, providerName, fromVersion, toVersion, changelogURL, entries, between), changelog), nil
+}
+
+// providerChangelog is the typed result of get_provider_changelog
+type providerChangelog struct {
+	Provider    string `json:"provider"`
+	FromVersion string `json:"from_version"`
+	ToVersion   string `json:"to_version"`
+	Repository  string `json:"repository,omitempty"`
+	// Published is false when the source repository has no changelog with release sections
+	Published bool                   `json:"published"`
+	Source    string                 `json:"source,omitempty"`
+	Entries   []utils.ChangelogEntry `json:"entries"`
+}
+
+// githubChangelogURL returns the raw URL of the CHANGELOG.md on the default branch of a GitHub source repository
+func githubChangelogURL(source string) (string, bool) {
+	parsed, err := url.Parse(strings.TrimSpace(source))
+	if err != nil || !strings.EqualFold(parsed.Host, "github.com") {
+		return "", false
+	}
+	parts := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", false
+	}
+	return fmt.Sprintf("https://raw.githubusercontent.com/%s/%s/HEAD/CHANGELOG.md", parts[0], strings.TrimSuffix(parts[1], ".git")), true
+}
+
+// fetchProviderChangelog downloads a changelog, reporting whether the repository has one
+func fetchProviderChangelog(ctx context.Context, httpClient *http.Client, changelogURL string) (string, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, changelogURL, nil)
+	if err != nil {
+		return "", false, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return "", false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", false, fmt.Errorf("fetching %s: status: %s", changelogURL, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxChangelogBytes))
+	if err != nil {
+		return "", false, fmt.Errorf("reading %s: %w", changelogURL, err)
+	}
+	return string(body), true, nil
+}
+
+func changelogNotPublished(namespace, name, source string) string {
+	message := fmt.Sprintf("The changelog of %s/%s is not published: no CHANGELOG.md with release sections was found in its source repository", namespace, name)
+	if source != "" {
+		message += fmt.Sprintf(" (%s). Check the release notes of the repository instead", source)
+	}
+	return message + "."
+}
+
+// formatProviderChangelog renders the changelog entries between two versions, noting when the changelog does not go back far enough
+func formatProviderChangelog(namespace, name, fromVersion, toVersion, changelogURL string, entries []utils.ChangelogEntry, between []utils.ChangelogEntry) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# Changelog of %s/%s: %s -> %s\n\n", namespace, name, fromVersion, toVersion))
+	builder.WriteString(fmt.Sprintf("Source: %s\n\n", changelogURL))
+
+	oldest := ""
+	for _, entry := range entries {
+		if oldest == "" {
+			oldest = entry.Version
+		} else if cmp, err := utils.CompareVersions(entry.Version, oldest); err == nil && cmp < 0 {
+			oldest = entry.Version
+		}
+	}
+	if cmp, err := utils.CompareVersions(oldest, fromVersion); err == nil && cmp > 0 {
+		builder.WriteString(fmt.Sprintf("Note: this changelog starts at version %s, the entries of older versions may be in an archived changelog of the repository.\n\n", oldest))
+	}
+
+	if len(between) == 0 {
+		builder.WriteString(fmt.Sprintf("No changelog entries between %s and %s.\n", fromVersion, toVersion))
+		return builder.String()
+	}
+	for _, entry := range between {
+		builder.WriteString(fmt.Sprintf("## %s\n\n", entry.Heading))
+		if entry.Body != "" {
+			builder.WriteString(entry.Body)
+			builder.WriteString("\n\n")
+		}
+	}
+	return strings.TrimSuffix(builder.String(), "\n")
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+)
+
+func TestGithubChangelogURL(t *testing.T) {
+	tests := []struct {
+		source   string
+		expected string
+		ok       bool
+	}{
+		{source: "https://github.com/hashicorp/terraform-provider-aws", expected: "https://raw.githubusercontent.com/hashicorp/terraform-provider-aws/HEAD/CHANGELOG.md", ok: true},
+		{source: "https://github.com/integrations/terraform-provider-github.git", expected: "https://raw.githubusercontent.com/integrations/terraform-provider-github/HEAD/CHANGELOG.md", ok: true},
+		{source: "https://gitlab.com/gitlabhq/terraform-provider-gitlab", ok: false},
+		{source: "https://github.com/hashicorp", ok: false},
+		{source: "", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			actual, ok := githubChangelogURL(tt.source)
+			if ok != tt.ok || actual != tt.expected {
+				t.Errorf("githubChangelogURL(%q) = %q, %v, want %q, %v", tt.source, actual, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+}
+
+func TestFormatProviderChangelog(t *testing.T) {
+	entries := utils.ParseChangelog("## 5.2.0 (March 1, 2024)\n\n* Fix crash\n\n## 5.1.0 (February 1, 2024)\n\n* Added tags\n")
+	between, err := utils.ChangelogBetween(entries, "5.0.0", "5.2.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := formatProviderChangelog("hashicorp", "aws", "5.0.0", "5.2.0", "https://example.com/CHANGELOG.md", entries, between)
+	for _, want := range []string{"# Changelog of hashicorp/aws: 5.0.0 -> 5.2.0", "## 5.2.0 (March 1, 2024)\n\n* Fix crash", "## 5.1.0 (February 1, 2024)", "starts at version 5.1.0"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output, got:\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "5.2.0 (March") > strings.Index(out, "5.1.0 (February") {
+		t.Errorf("expected the newest entry first, got:\n%s", out)
+	}
+
+	out = formatProviderChangelog("hashicorp", "aws", "5.2.0", "5.2.0", "https://example.com/CHANGELOG.md", entries, nil)
+	if !strings.Contains(out, "No changelog entries between 5.2.0 and 5.2.0") || strings.Contains(out, "starts at version") {
+		t.Errorf("unexpected output for an empty range:\n%s", out)
+	}
+}
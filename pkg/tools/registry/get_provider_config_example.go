@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxProviderConfigExamples bounds the documentation examples returned, overview pages of large providers hold many
+const maxProviderConfigExamples = 10
+
+// providerConfigExample is the provider configuration published in the overview documentation of a provider version
+type providerConfigExample struct {
+	Required []utils.DocArgument `json:"required"`
+	// Other are the provider arguments that are optional or not qualified by the documentation
+	Other    []string          `json:"other"`
+	Examples []utils.CodeBlock `json:"examples"`
+}
+
+// providerConfigExampleResult is the typed result of get_provider_config_example
+type providerConfigExampleResult struct {
+	Provider string `json:"provider"`
+	Version  string `json:"version"`
+	providerConfigExample
+}
+
+// GetProviderConfigExample creates a tool that returns the provider block examples and provider arguments of a provider version.
+func GetProviderConfigExample(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_provider_config_example",
+			mcp.WithDescription(`Returns ready-to-use HCL to configure a Terraform provider: a starter terraform and provider block with the required provider-level arguments and the variable declarations they reference, followed by the provider block examples (regions, authentication, endpoints) of the provider's overview documentation for the given version.
+Use it to bootstrap a working provider configuration instead of reading the whole overview; use 'get_provider_config_template' for curated authentication scenarios.`),
+			mcp.WithTitleAnnotation("Get the provider block configuration examples of a Terraform provider"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The version of the Terraform provider in the format 'x.y.z', or 'latest' to get the latest version"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getProviderConfigExampleHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getProviderConfigExampleHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve provider: %v", err)
+	}
+
+	providerVersionID, err := client.GetProviderVersionID(ctx, httpClient, providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to find provider %s/%s version %s in the registry: %v", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, err)
+	}
+	content, err := client.GetProviderOverviewDocs(ctx, httpClient, providerVersionID, logger)
+	if err != nil {
+		return ToolError(logger, "failed to fetch provider overview documentation", err)
+	}
+
+	example := extractProviderConfigExample(content, providerDetail.ProviderName)
+	if len(example.Required) == 0 && len(example.Other) == 0 && len(example.Examples) == 0 {
+		return ToolNotFoundErrorf(logger, "the overview documentation of provider %s/%s version %s has no provider block example or argument reference - use get_provider_overview to read it", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion)
+	}
+	return utils.NewToolResultData(formatProviderConfigExample(providerDetail, example), providerConfigExampleResult{
+		Provider:              providerDetail.ProviderNamespace + "/" + providerDetail.ProviderName,
+		Version:               providerDetail.ProviderVersion,
+		providerConfigExample: example,
+	}), nil
+}
+
+// extractProviderConfigExample collects the provider arguments of the overview documentation and its HCL examples
+// that contain a provider block of the provider
+func extractProviderConfigExample(content string, providerName string) providerConfigExample {
+	var example providerConfigExample
+	for _, argument := range utils.ParseDocArguments(content) {
+		switch argument.Qualifier {
+		case "Required":
+			example.Required = append(example.Required, argument)
+		case "Read-Only":
+			// Attributes exported by the provider are not configured
+		default:
+			example.Other = append(example.Other, argument.Name)
+		}
+	}
+
+	providerBlock := providerBlockRegex(providerName)
+	for _, block := range utils.FilterCodeBlocks(utils.ExtractCodeBlocks(content), utils.CodeLanguageHCL) {
+		if len(example.Examples) == maxProviderConfigExamples {
+			break
+		}
+		if providerBlock.MatchString(block.Code) {
+			block.Code = strings.TrimSpace(block.Code)
+			example.Examples = append(example.Examples, block)
+		}
+	}
+	return example
+}
+
+// starterProviderBlock returns a provider block setting the required arguments from input variables, and the
+// required nested blocks as empty blocks
+func starterProviderBlock(providerName string, required []utils.DocArgument) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "provider %q {\n", providerName)
+	width := 0
+	for _, argument := range required {
+		if !strings.HasPrefix(argument.Type, "Block") {
+			width = max(width, len(argument.Name))
+		}
+	}
+	for _, argument := range required {
+		if strings.HasPrefix(argument.Type, "Block") {
+			fmt.Fprintf(&builder, "  %s {}\n", argument.Name)
+			continue
+		}
+		fmt.Fprintf(&builder, "  %-*s = var.%s\n", width, argument.Name, argument.Name)
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+// starterProviderVariables declares the input variables the starter provider block references, so the starter
+// configuration is valid on its own
+func starterProviderVariables(required []utils.DocArgument) string {
+	var inputs []client.ModuleInput
+	for _, argument := range required {
+		if !strings.HasPrefix(argument.Type, "Block") {
+			inputs = append(inputs, client.ModuleInput{
+				Name:        argument.Name,
+				Type:        docArgumentTypeExpression(argument.Type),
+				Description: argument.Description,
+				Required:    true,
+			})
+		}
+	}
+	return generateVariablesTF(inputs)
+}
+
+// docArgumentTypeExpression converts the type of a documented argument (e.g. "String" or "List of String") into a
+// type constraint expression, any when the documentation gives no usable type
+func docArgumentTypeExpression(docType string) string {
+	docType = strings.ToLower(strings.TrimSpace(docType))
+	for _, collection := range []string{"list", "set", "map"} {
+		if element, ok := strings.CutPrefix(docType, collection+" of "); ok {
+			return collection + "(" + docArgumentTypeExpression(element) + ")"
+		}
+	}
+	switch docType {
+	case "string", "number", "bool":
+		return docType
+	case "boolean":
+		return "bool"
+	}
+	return "any"
+}
+
+func formatProviderConfigExample(providerDetail client.ProviderDetail, example providerConfigExample) string {
+	providerID := providerDetail.ProviderNamespace + "/" + providerDetail.ProviderName
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "# %s provider configuration (version %s)\n\n", providerID, providerDetail.ProviderVersion)
+
+	builder.WriteString("## Starter configuration\n\n")
+	requiredProviders := formatRequiredProviders([]requiredProvider{{
+		LocalName: providerDetail.ProviderName,
+		Source:    providerID,
+		Version:   requiredProviderConstraint(requiredProviderRequest{Version: providerDetail.ProviderVersion}, "", "pessimistic"),
+	}})
+	fmt.Fprintf(&builder, "```hcl\n%s\n%s", requiredProviders, starterProviderBlock(providerDetail.ProviderName, example.Required))
+	if variables := starterProviderVariables(example.Required); variables != "" {
+		fmt.Fprintf(&builder, "\n%s", variables)
+	}
+	builder.WriteString("```\n")
+
+	if len(example.Required) > 0 {
+		builder.WriteString("\n## Required provider arguments\n\n")
+		for _, argument := range example.Required {
+			fmt.Fprintf(&builder, "- `%s`", argument.Name)
+			if argument.Type != "" {
+				fmt.Fprintf(&builder, " (%s)", argument.Type)
+			}
+			if argument.Description != "" {
+				fmt.Fprintf(&builder, ": %s", argument.Description)
+			}
+			builder.WriteString("\n")
+		}
+	} else {
+		builder.WriteString("\nThe documentation marks no provider argument as required, set the optional ones the configuration needs.\n")
+	}
+	if len(example.Other) > 0 {
+		fmt.Fprintf(&builder, "\n**Other provider arguments:** %s\n", strings.Join(example.Other, ", "))
+	}
+
+	if len(example.Examples) > 0 {
+		builder.WriteString("\n## Documentation examples\n")
+		for _, block := range example.Examples {
+			heading := block.Heading
+			if heading == "" {
+				heading = "Example"
+			}
+			fmt.Fprintf(&builder, "\n### %s\n\n```hcl\n%s\n```\n", heading, block.Code)
+		}
+	}
+	return builder.String()
+}
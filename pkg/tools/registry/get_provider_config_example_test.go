@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestExtractProviderConfigExample(t *testing.T) {
+	content := "# Azure Provider\n\n" +
+		"## Example Usage\n\n" +
+		"```terraform\nprovider \"azurerm\" {\n  features {}\n}\n\nresource \"azurerm_resource_group\" \"example\" {\n  name = \"example\"\n}\n```\n\n" +
+		"## Argument Reference\n\n" +
+		"* `features` - (Required) A `features` block as defined below.\n" +
+		"* `subscription_id` - (Required) The Subscription ID which should be used.\n" +
+		"* `environment` - (Optional) The Cloud Environment which should be used. Defaults to `public`.\n\n" +
+		"## Other Examples\n\n" +
+		"```terraform\nresource \"azurerm_virtual_network\" \"example\" {\n  name = \"example\"\n}\n```\n"
+
+	example := extractProviderConfigExample(content, "azurerm")
+	if len(example.Required) != 2 || example.Required[0].Name != "features" || example.Required[1].Name != "subscription_id" {
+		t.Fatalf("unexpected required arguments: %+v", example.Required)
+	}
+	if len(example.Other) != 1 || example.Other[0] != "environment" {
+		t.Errorf("unexpected other arguments: %v", example.Other)
+	}
+	if len(example.Examples) != 1 || example.Examples[0].Heading != "Example Usage" {
+		t.Fatalf("expected only the example with a provider block, got %+v", example.Examples)
+	}
+}
+
+func TestStarterProviderBlock(t *testing.T) {
+	example := extractProviderConfigExample("## Schema\n\n### Required\n\n"+
+		"- `features` (Block List, Min: 1) Features of the provider\n"+
+		"- `subscription_id` (String) The Subscription ID\n"+
+		"- `tenant_id` (String) The Tenant ID\n", "azurerm")
+
+	expected := "provider \"azurerm\" {\n" +
+		"  features {}\n" +
+		"  subscription_id = var.subscription_id\n" +
+		"  tenant_id       = var.tenant_id\n" +
+		"}\n"
+	if got := starterProviderBlock("azurerm", example.Required); got != expected {
+		t.Errorf("starterProviderBlock() =\n%s\nwant\n%s", got, expected)
+	}
+	if got := starterProviderBlock("aws", nil); got != "provider \"aws\" {\n}\n" {
+		t.Errorf("starterProviderBlock() without required arguments = %q", got)
+	}
+
+	// Every var. reference of the provider block is declared, blocks are not variables
+	expectedVariables := "variable \"subscription_id\" {\n" +
+		"  type        = string\n" +
+		"  description = \"The Subscription ID\"\n" +
+		"  # Required: no default, a value must be supplied by the caller\n" +
+		"}\n\n" +
+		"variable \"tenant_id\" {\n" +
+		"  type        = string\n" +
+		"  description = \"The Tenant ID\"\n" +
+		"  # Required: no default, a value must be supplied by the caller\n" +
+		"}\n"
+	if got := starterProviderVariables(example.Required); got != expectedVariables {
+		t.Errorf("starterProviderVariables() =\n%s\nwant\n%s", got, expectedVariables)
+	}
+	if got := starterProviderVariables(nil); got != "" {
+		t.Errorf("starterProviderVariables() without required arguments = %q", got)
+	}
+}
+
+func TestDocArgumentTypeExpression(t *testing.T) {
+	for docType, expected := range map[string]string{
+		"String":                "string",
+		"Boolean":               "bool",
+		"Number":                "number",
+		"List of String":        "list(string)",
+		"Map of List of Number": "map(list(number))",
+		"Set of Object":         "set(any)",
+		"":                      "any",
+		"Block List, Max: 1":    "any",
+	} {
+		if got := docArgumentTypeExpression(docType); got != expected {
+			t.Errorf("docArgumentTypeExpression(%q) = %q, want %q", docType, got, expected)
+		}
+	}
+}
+
+func TestFormatProviderConfigExample(t *testing.T) {
+	providerDetail := client.ProviderDetail{ProviderNamespace: "hashicorp", ProviderName: "aws", ProviderVersion: "5.31.0"}
+	example := extractProviderConfigExample("## Example Usage\n\n```terraform\nprovider \"aws\" {\n  region = \"us-east-1\"\n}\n```\n\n"+
+		"## Argument Reference\n\n* `region` - (Optional) The AWS region.\n", "aws")
+
+	output := formatProviderConfigExample(providerDetail, example)
+	for _, expected := range []string{
+		"# hashicorp/aws provider configuration (version 5.31.0)",
+		"      source  = \"hashicorp/aws\"\n      version = \"~> 5.31\"",
+		"provider \"aws\" {\n}\n```",
+		"The documentation marks no provider argument as required",
+		"**Other provider arguments:** region",
+		"### Example Usage\n\n```hcl\nprovider \"aws\" {\n  region = \"us-east-1\"\n}\n```",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("output does not contain %q:\n%s", expected, output)
+		}
+	}
+}
@@ -0,0 +1,368 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// providerConfigScenario is a named provider block template for a common configuration pattern
+type providerConfigScenario struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Template    string   `json:"template"`
+	Variables   []string `json:"variables"`
+	Source      string   `json:"source"` // "curated" or the heading of the documentation section it was extracted from
+}
+
+// providerConfigScenarios is the typed result of get_provider_config_template without a scenario
+type providerConfigScenarios struct {
+	Provider  string                   `json:"provider"`
+	Scenarios []providerConfigScenario `json:"scenarios"`
+}
+
+// curatedProviderConfigScenarios holds hand maintained templates for the most common authentication patterns
+var curatedProviderConfigScenarios = map[string][]providerConfigScenario{
+	"aws": {
+		{
+			Name:        "shared-credentials-profile",
+			Description: "Authenticate with a named profile from the shared AWS config and credentials files",
+			Template: `provider "aws" {
+  region                   = var.region
+  profile                  = var.profile
+  shared_config_files      = ["~/.aws/config"]
+  shared_credentials_files = ["~/.aws/credentials"]
+}`,
+			Variables: []string{"region", "profile"},
+		},
+		{
+			Name:        "assume-role",
+			Description: "Assume an IAM role, typically in another account, using the ambient credentials",
+			Template: `provider "aws" {
+  region = var.region
+
+  assume_role {
+    role_arn     = var.assume_role_arn
+    session_name = var.session_name
+    external_id  = var.external_id
+  }
+}`,
+			Variables: []string{"region", "assume_role_arn", "session_name", "external_id"},
+		},
+		{
+			Name:        "assume-role-web-identity",
+			Description: "Assume an IAM role with an OIDC web identity token, e.g. from a CI system or EKS service account",
+			Template: `provider "aws" {
+  region = var.region
+
+  assume_role_with_web_identity {
+    role_arn                = var.role_arn
+    session_name            = var.session_name
+    web_identity_token_file = var.web_identity_token_file
+  }
+}`,
+			Variables: []string{"region", "role_arn", "session_name", "web_identity_token_file"},
+		},
+		{
+			Name:        "multi-region",
+			Description: "Default provider plus an aliased provider for a second region, selected with provider = aws.secondary",
+			Template: `provider "aws" {
+  region = var.primary_region
+}
+
+provider "aws" {
+  alias  = "secondary"
+  region = var.secondary_region
+}`,
+			Variables: []string{"primary_region", "secondary_region"},
+		},
+	},
+	"azurerm": {
+		{
+			Name:        "azure-cli",
+			Description: "Use the credentials of the logged in Azure CLI user, suited to local development",
+			Template: `provider "azurerm" {
+  features {}
+  subscription_id = var.subscription_id
+}`,
+			Variables: []string{"subscription_id"},
+		},
+		{
+			Name:        "service-principal-secret",
+			Description: "Authenticate as a service principal with a client secret",
+			Template: `provider "azurerm" {
+  features {}
+  subscription_id = var.subscription_id
+  tenant_id       = var.tenant_id
+  client_id       = var.client_id
+  client_secret   = var.client_secret
+}`,
+			Variables: []string{"subscription_id", "tenant_id", "client_id", "client_secret"},
+		},
+		{
+			Name:        "managed-identity",
+			Description: "Authenticate with the managed identity of the Azure host, set client_id for a user-assigned identity",
+			Template: `provider "azurerm" {
+  features {}
+  use_msi         = true
+  subscription_id = var.subscription_id
+  client_id       = var.client_id
+}`,
+			Variables: []string{"subscription_id", "client_id"},
+		},
+		{
+			Name:        "oidc",
+			Description: "Authenticate as a service principal with OpenID Connect workload identity federation",
+			Template: `provider "azurerm" {
+  features {}
+  use_oidc        = true
+  subscription_id = var.subscription_id
+  tenant_id       = var.tenant_id
+  client_id       = var.client_id
+}`,
+			Variables: []string{"subscription_id", "tenant_id", "client_id"},
+		},
+	},
+	"google": {
+		{
+			Name:        "application-default-credentials",
+			Description: "Use Application Default Credentials from gcloud or the runtime environment",
+			Template: `provider "google" {
+  project = var.project_id
+  region  = var.region
+}`,
+			Variables: []string{"project_id", "region"},
+		},
+		{
+			Name:        "service-account-key-file",
+			Description: "Authenticate with a service account JSON key file",
+			Template: `provider "google" {
+  credentials = file(var.credentials_file)
+  project     = var.project_id
+  region      = var.region
+}`,
+			Variables: []string{"credentials_file", "project_id", "region"},
+		},
+		{
+			Name:        "impersonate-service-account",
+			Description: "Impersonate a service account using the caller's credentials",
+			Template: `provider "google" {
+  impersonate_service_account = var.service_account_email
+  project                     = var.project_id
+  region                      = var.region
+}`,
+			Variables: []string{"service_account_email", "project_id", "region"},
+		},
+	},
+	"kubernetes": {
+		{
+			Name:        "kubeconfig",
+			Description: "Use a context from a kubeconfig file",
+			Template: `provider "kubernetes" {
+  config_path    = var.kubeconfig_path
+  config_context = var.kubeconfig_context
+}`,
+			Variables: []string{"kubeconfig_path", "kubeconfig_context"},
+		},
+		{
+			Name:        "eks-exec",
+			Description: "Connect to an EKS cluster and fetch short lived tokens with the AWS CLI",
+			Template: `provider "kubernetes" {
+  host                   = var.cluster_endpoint
+  cluster_ca_certificate = base64decode(var.cluster_ca_certificate)
+
+  exec {
+    api_version = "client.authentication.k8s.io/v1beta1"
+    command     = "aws"
+    args        = ["eks", "get-token", "--cluster-name", var.cluster_name]
+  }
+}`,
+			Variables: []string{"cluster_endpoint", "cluster_ca_certificate", "cluster_name"},
+		},
+	},
+}
+
+var scenarioNameRegex = regexp.MustCompile(`[^a-z0-9]+`)
+
+// GetProviderConfigTemplate creates a tool that returns a provider block template for a named configuration scenario.
+func GetProviderConfigTemplate(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_provider_config_template",
+			mcp.WithDescription(`Returns a parameterized provider block template for a named configuration scenario, e.g. 'assume-role' for aws or 'oidc' for azurerm.
+Templates come from a curated set for major providers and from the examples in the provider's overview documentation. If the scenario is unknown, the available scenarios are returned.`),
+			mcp.WithTitleAnnotation("Get a provider configuration template for an authentication scenario"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The version of the Terraform provider used for documentation examples, in the format 'x.y.z' or 'latest'"),
+			),
+			mcp.WithString("scenario",
+				mcp.Description("The scenario name, e.g. 'assume-role'. Leave empty to list the available scenarios"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getProviderConfigTemplateHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getProviderConfigTemplateHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	scenario := normalizeScenarioName(request.GetString("scenario", ""))
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve provider: %v", err)
+	}
+
+	scenarios := curatedProviderConfigScenarios[providerDetail.ProviderName]
+	if match, ok := findProviderConfigScenario(scenarios, scenario); ok && scenario != "" {
+		return utils.NewToolResultData(formatProviderConfigScenario(providerDetail, match), match), nil
+	}
+
+	// Fall back to the examples published in the provider's overview documentation
+	providerVersionID, err := client.GetProviderVersionID(ctx, httpClient, providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, logger)
+	if err == nil {
+		content, err := client.GetProviderOverviewDocs(ctx, httpClient, providerVersionID, logger)
+		if err != nil {
+			logger.Warnf("Unable to fetch overview docs for %s/%s: %v", providerDetail.ProviderNamespace, providerDetail.ProviderName, err)
+		} else {
+			scenarios = append(scenarios, extractProviderConfigScenarios(content, providerDetail.ProviderName, scenarios)...)
+		}
+	}
+
+	if match, ok := findProviderConfigScenario(scenarios, scenario); ok && scenario != "" {
+		return utils.NewToolResultData(formatProviderConfigScenario(providerDetail, match), match), nil
+	}
+
+	if len(scenarios) == 0 {
+		return ToolNotFoundErrorf(logger, "no configuration scenarios available for provider %s/%s - use get_provider_overview to read the provider documentation", providerDetail.ProviderNamespace, providerDetail.ProviderName)
+	}
+
+	var builder strings.Builder
+	if scenario != "" {
+		builder.WriteString(fmt.Sprintf("Scenario '%s' is not available for %s/%s.\n\n", scenario, providerDetail.ProviderNamespace, providerDetail.ProviderName))
+	}
+	builder.WriteString(fmt.Sprintf("Available scenarios for %s/%s:\n\n", providerDetail.ProviderNamespace, providerDetail.ProviderName))
+	for _, s := range scenarios {
+		builder.WriteString(fmt.Sprintf("- %s: %s\n", s.Name, s.Description))
+	}
+
+	if scenario != "" {
+		return ToolError(logger, builder.String(), nil)
+	}
+	return utils.NewToolResultData(builder.String(), providerConfigScenarios{
+		Provider:  providerDetail.ProviderNamespace + "/" + providerDetail.ProviderName,
+		Scenarios: scenarios,
+	}), nil
+}
+
+// normalizeScenarioName turns user input like "Assume Role" or "assume_role" into "assume-role"
+func normalizeScenarioName(name string) string {
+	return strings.Trim(scenarioNameRegex.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+func findProviderConfigScenario(scenarios []providerConfigScenario, name string) (providerConfigScenario, bool) {
+	for _, s := range scenarios {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return providerConfigScenario{}, false
+}
+
+// extractProviderConfigScenarios turns the HCL examples of a provider's overview documentation that contain
+// a provider block into scenarios named after the section they appear in. Names already in use are skipped.
+func extractProviderConfigScenarios(content string, providerName string, existing []providerConfigScenario) []providerConfigScenario {
+	providerBlock := providerBlockRegex(providerName)
+	seen := make(map[string]bool)
+	for _, s := range existing {
+		seen[s.Name] = true
+	}
+
+	var scenarios []providerConfigScenario
+	for _, block := range utils.FilterCodeBlocks(utils.ExtractCodeBlocks(content), utils.CodeLanguageHCL) {
+		if !providerBlock.MatchString(block.Code) {
+			continue
+		}
+		name := normalizeScenarioName(block.Heading)
+		if name == "" {
+			name = "example"
+		}
+		base := name
+		for i := 2; seen[name]; i++ {
+			name = fmt.Sprintf("%s-%d", base, i)
+		}
+		seen[name] = true
+
+		scenarios = append(scenarios, providerConfigScenario{
+			Name:        name,
+			Description: fmt.Sprintf("Example from the provider documentation section '%s'", block.Heading),
+			Template:    strings.TrimSpace(block.Code),
+			Variables:   extractVariableReferences(block.Code),
+			Source:      block.Heading,
+		})
+	}
+	return scenarios
+}
+
+// providerBlockRegex matches the opening line of a provider block of the given provider
+func providerBlockRegex(providerName string) *regexp.Regexp {
+	return regexp.MustCompile(`(?m)^\s*provider\s+"` + regexp.QuoteMeta(providerName) + `"\s*\{`)
+}
+
+var variableReferenceRegex = regexp.MustCompile(`\bvar\.([a-zA-Z_][a-zA-Z0-9_-]*)`)
+
+// extractVariableReferences returns the distinct input variables referenced by an HCL snippet, sorted by name
+func extractVariableReferences(code string) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, match := range variableReferenceRegex.FindAllStringSubmatch(code, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			names = append(names, match[1])
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func formatProviderConfigScenario(providerDetail client.ProviderDetail, scenario providerConfigScenario) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# %s/%s provider configuration: %s\n\n", providerDetail.ProviderNamespace, providerDetail.ProviderName, scenario.Name))
+	builder.WriteString(fmt.Sprintf("%s\n\n", scenario.Description))
+	if scenario.Source != "" {
+		builder.WriteString(fmt.Sprintf("**Source:** provider documentation version %s\n\n", providerDetail.ProviderVersion))
+	}
+	builder.WriteString(fmt.Sprintf("```hcl\n%s\n```\n", scenario.Template))
+	if len(scenario.Variables) > 0 {
+		builder.WriteString("\n**Input variables to declare:**\n\n")
+		for _, variable := range scenario.Variables {
+			builder.WriteString(fmt.Sprintf("- `%s`\n", variable))
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeScenarioName(t *testing.T) {
+	tests := map[string]string{
+		"assume-role":   "assume-role",
+		"Assume Role":   "assume-role",
+		"assume_role":   "assume-role",
+		"  OIDC  ":      "oidc",
+		"Assume Role!!": "assume-role",
+		"":              "",
+	}
+	for input, expected := range tests {
+		if got := normalizeScenarioName(input); got != expected {
+			t.Errorf("normalizeScenarioName(%q) = %q, want %q", input, got, expected)
+		}
+	}
+}
+
+func TestCuratedProviderConfigScenariosAreUnique(t *testing.T) {
+	for provider, scenarios := range curatedProviderConfigScenarios {
+		seen := make(map[string]bool)
+		for _, s := range scenarios {
+			if s.Name != normalizeScenarioName(s.Name) {
+				t.Errorf("%s scenario %q is not a normalized name", provider, s.Name)
+			}
+			if seen[s.Name] {
+				t.Errorf("%s scenario %q is defined twice", provider, s.Name)
+			}
+			seen[s.Name] = true
+			if got := extractVariableReferences(s.Template); len(got) != len(s.Variables) {
+				t.Errorf("%s scenario %q declares variables %v but the template references %v", provider, s.Name, s.Variables, got)
+			}
+		}
+	}
+}
+
+func TestExtractProviderConfigScenarios(t *testing.T) {
+	content := "# AWS Provider\n\n" +
+		"## Example Usage\n\n" +
+		"```terraform\nprovider \"aws\" {\n  region = \"us-east-1\"\n}\n```\n\n" +
+		"## Assume Role\n\n" +
+		"```terraform\nprovider \"aws\" {\n  assume_role {\n    role_arn = var.role_arn\n  }\n}\n```\n\n" +
+		"## Environment Variables\n\n" +
+		"```console\n% export AWS_REGION=\"us-west-2\"\n```\n\n" +
+		"```terraform\nresource \"aws_vpc\" \"example\" {\n  cidr_block = \"10.0.0.0/16\"\n}\n```\n"
+
+	existing := curatedProviderConfigScenarios["aws"]
+	scenarios := extractProviderConfigScenarios(content, "aws", existing)
+	if len(scenarios) != 2 {
+		t.Fatalf("expected 2 scenarios from the docs, got %d: %+v", len(scenarios), scenarios)
+	}
+	if scenarios[0].Name != "example-usage" {
+		t.Errorf("expected first scenario to be named after its heading, got %q", scenarios[0].Name)
+	}
+	// The curated set already has "assume-role", so the extracted one gets a suffix
+	if scenarios[1].Name != "assume-role-2" {
+		t.Errorf("expected duplicate scenario name to be suffixed, got %q", scenarios[1].Name)
+	}
+	if !reflect.DeepEqual(scenarios[1].Variables, []string{"role_arn"}) {
+		t.Errorf("expected variables [role_arn], got %v", scenarios[1].Variables)
+	}
+}
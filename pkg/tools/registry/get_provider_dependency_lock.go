@@ -0,0 +1,394 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// maxLockPlatforms bounds the platforms of one call, each one downloads a provider package
+	maxLockPlatforms = 6
+	// maxProviderPackageBytes bounds the size of a downloaded provider package
+	maxProviderPackageBytes = 1 << 30
+	// maxShasumsBytes bounds the size of a SHA256SUMS document
+	maxShasumsBytes = 1 << 20
+	// providerPackageTimeout bounds the download of a provider package, far larger than the registry API responses.
+	// The timeout of the tool call still applies and is usually shorter, see MCP_TOOL_CALL_TIMEOUT_PER_TOOL.
+	providerPackageTimeout = 5 * time.Minute
+	// maxCachedLockHashes bounds the in-memory cache of 'h1:' hashes
+	maxCachedLockHashes = 512
+)
+
+// lockHashCache holds the 'h1:' hash of a provider package per provider, version and platform. The packages of a
+// published version do not change, so entries never expire and a platform is only downloaded once.
+var lockHashCache = struct {
+	sync.Mutex
+	entries map[string]string
+}{entries: make(map[string]string)}
+
+// defaultLockPlatforms are the platforms hashed when the call does not name any
+var defaultLockPlatforms = []string{"linux_amd64"}
+
+var lockPlatformRegex = regexp.MustCompile(`^[a-z0-9]+_[a-z0-9]+$`)
+
+// providerLockEntry is a provider entry of a .terraform.lock.hcl file
+type providerLockEntry struct {
+	Address string   `json:"address"`
+	Version string   `json:"version"`
+	Hashes  []string `json:"hashes"`
+	// Platforms are the requested platforms the h1 hashes were computed for
+	Platforms []string `json:"platforms"`
+	// Missing are the requested platforms the version publishes no package for
+	Missing []string `json:"missing,omitempty"`
+}
+
+// GetProviderDependencyLock creates a tool that generates the .terraform.lock.hcl entry of a provider version.
+func GetProviderDependencyLock(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_provider_dependency_lock",
+			mcp.WithDescription(`Generates the .terraform.lock.hcl entry of a provider version from the Terraform registry, with the 'h1:' hashes of the requested platforms and the 'zh:' hashes of every platform the version is published for, as 'terraform init' records them.
+The registry does not publish 'h1:' hashes, so the package of each requested platform is downloaded and hashed, which can take a while for large providers. Request only the platforms the configuration is run on. Hashes are cached, so a call that timed out can be retried with fewer platforms and later calls for the same version reuse them.`),
+			mcp.WithTitleAnnotation("Generate the dependency lock file entry of a Terraform provider"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The provider version in the format 'x.y.z', defaults to 'latest'"),
+			),
+			mcp.WithArray("platforms",
+				mcp.Description(fmt.Sprintf("The platforms to compute 'h1:' hashes for, as 'os_arch' (e.g. ['linux_amd64', 'darwin_arm64']), at most %d. Defaults to ['linux_amd64']", maxLockPlatforms)),
+				mcp.WithStringItems(),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getProviderDependencyLockHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getProviderDependencyLockHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	providerName, err := request.RequireString("provider_name")
+	if err != nil || strings.TrimSpace(providerName) == "" {
+		return ToolArgumentError(logger, "provider_name", "is required")
+	}
+	providerName = strings.ToLower(strings.TrimSpace(providerName))
+	providerNamespace := providerNamespaceArgument(request)
+
+	providerVersion, err := utils.ResolveVersionInput(request.GetString("provider_version", "latest"))
+	if err != nil {
+		return ToolArgumentError(logger, "provider_version", "must be a version in the format 'x.y.z' or 'latest'")
+	}
+	platforms, err := lockPlatforms(request.GetStringSlice("platforms", nil))
+	if err != nil {
+		return ToolArgumentError(logger, "platforms", err.Error())
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	if providerVersion == "latest" {
+		providerVersion, err = client.GetLatestProviderVersion(ctx, httpClient, providerNamespace, providerName, logger)
+		if err != nil {
+			return ToolErrorf(logger, "failed to get provider %s/%s: %v - verify the namespace and provider name are correct", providerNamespace, providerName, err)
+		}
+	}
+
+	packages, missing, err := fetchProviderPackages(ctx, httpClient, providerNamespace, providerName, providerVersion, platforms, logger)
+	if err != nil {
+		return ToolError(logger, fmt.Sprintf("failed to get the packages of %s/%s version %s", providerNamespace, providerName, providerVersion), err)
+	}
+	if len(packages) == 0 {
+		return ToolNotFoundErrorf(logger, "%s/%s version %s publishes no package for the requested platforms %s - check the provider name and version, or request other platforms", providerNamespace, providerName, providerVersion, strings.Join(platforms, ", "))
+	}
+
+	entry := providerLockEntry{
+		Address: fmt.Sprintf("registry.terraform.io/%s/%s", providerNamespace, providerName),
+		Version: providerVersion,
+		Missing: missing,
+	}
+	hashes := make(map[string]bool)
+	packageClient := client.NewDownloadHttpClient(ctx, providerPackageTimeout, logger)
+	results := client.ParallelRegistryCalls(packages, 0, func(pkg client.ProviderPackage) (string, error) {
+		return cachedProviderPackageHash(ctx, packageClient, providerNamespace, providerName, providerVersion, pkg)
+	})
+	if err := client.ParallelErrors(results); err != nil {
+		return ToolError(logger, "failed to hash the provider packages", err)
+	}
+	for i, result := range results {
+		hashes[result.Value] = true
+		entry.Platforms = append(entry.Platforms, packages[i].OS+"_"+packages[i].Arch)
+	}
+
+	zipHashes, err := fetchProviderZipHashes(ctx, httpClient, packages[0].ShasumsURL, providerName, providerVersion)
+	if err != nil {
+		// The requested platforms can still be locked with the checksums of their own packages
+		logger.Warnf("Failed to fetch the SHA256SUMS of %s/%s version %s, only the requested platforms are locked: %v", providerNamespace, providerName, providerVersion, err)
+		zipHashes = nil
+		for _, pkg := range packages {
+			zipHashes = append(zipHashes, "zh:"+strings.ToLower(pkg.Shasum))
+		}
+	}
+	for _, hash := range zipHashes {
+		hashes[hash] = true
+	}
+	for hash := range hashes {
+		entry.Hashes = append(entry.Hashes, hash)
+	}
+	sort.Strings(entry.Hashes)
+
+	return utils.NewToolResultData(formatProviderLockEntry(entry), entry), nil
+}
+
+// lockPlatforms validates the requested platforms, dropping duplicates
+func lockPlatforms(raw []string) ([]string, error) {
+	if len(raw) == 0 {
+		return defaultLockPlatforms, nil
+	}
+	seen := make(map[string]bool, len(raw))
+	var platforms []string
+	for _, value := range raw {
+		platform := strings.ToLower(strings.TrimSpace(value))
+		if !lockPlatformRegex.MatchString(platform) {
+			return nil, fmt.Errorf("'%s' is not a platform in the format 'os_arch', e.g. 'linux_amd64'", value)
+		}
+		if !seen[platform] {
+			seen[platform] = true
+			platforms = append(platforms, platform)
+		}
+	}
+	if len(platforms) > maxLockPlatforms {
+		return nil, fmt.Errorf("must contain at most %d platforms, got %d", maxLockPlatforms, len(platforms))
+	}
+	return platforms, nil
+}
+
+// fetchProviderPackages gets the package of every requested platform, returning the platforms the version publishes
+// no package for separately
+func fetchProviderPackages(ctx context.Context, httpClient *http.Client, namespace, name, version string, platforms []string, logger *log.Logger) ([]client.ProviderPackage, []string, error) {
+	results := client.ParallelRegistryCalls(platforms, 0, func(platform string) (*client.ProviderPackage, error) {
+		goos, arch, _ := strings.Cut(platform, "_")
+		response, err := client.SendRegistryCall(ctx, httpClient, http.MethodGet, path.Join("providers", namespace, name, version, "download", goos, arch), logger, client.RegistryAPIVersions().Providers)
+		if err != nil {
+			if client.RegistryErrorCode(err) == utils.ErrorCodeNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+		var pkg client.ProviderPackage
+		if err := client.DecodeRegistryResponse(response, &pkg); err != nil {
+			return nil, fmt.Errorf("parsing the %s package: %w", platform, err)
+		}
+		return &pkg, nil
+	})
+	if err := client.ParallelErrors(results); err != nil {
+		return nil, nil, err
+	}
+
+	var packages []client.ProviderPackage
+	var missing []string
+	for i, result := range results {
+		if result.Value == nil {
+			missing = append(missing, platforms[i])
+			continue
+		}
+		packages = append(packages, *result.Value)
+	}
+	return packages, missing, nil
+}
+
+// cachedProviderPackageHash returns the 'h1:' hash of a provider package, downloading it only when it is not cached
+func cachedProviderPackageHash(ctx context.Context, httpClient *http.Client, namespace, name, version string, pkg client.ProviderPackage) (string, error) {
+	key := strings.Join([]string{namespace, name, version, pkg.OS + "_" + pkg.Arch}, "/")
+	lockHashCache.Lock()
+	cached, ok := lockHashCache.entries[key]
+	lockHashCache.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	hash, err := providerPackageHash(ctx, httpClient, pkg)
+	if err != nil {
+		return "", err
+	}
+	lockHashCache.Lock()
+	if len(lockHashCache.entries) >= maxCachedLockHashes {
+		lockHashCache.entries = make(map[string]string)
+	}
+	lockHashCache.entries[key] = hash
+	lockHashCache.Unlock()
+	return hash, nil
+}
+
+// providerPackageHash downloads a provider package, verifies it against the checksum advertised by the registry and
+// returns its 'h1:' hash
+func providerPackageHash(ctx context.Context, httpClient *http.Client, pkg client.ProviderPackage) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pkg.DownloadURL, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("downloading %s: %w", pkg.Filename, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("downloading %s: status: %s", pkg.Filename, resp.Status)
+	}
+
+	file, err := os.CreateTemp("", "terraform-provider-*.zip")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	checksum := sha256.New()
+	written, err := io.Copy(io.MultiWriter(file, checksum), io.LimitReader(resp.Body, maxProviderPackageBytes+1))
+	if err != nil {
+		return "", fmt.Errorf("downloading %s: %w", pkg.Filename, err)
+	}
+	if written > maxProviderPackageBytes {
+		return "", fmt.Errorf("package %s is larger than %d bytes", pkg.Filename, maxProviderPackageBytes)
+	}
+	if actual := hex.EncodeToString(checksum.Sum(nil)); !strings.EqualFold(actual, pkg.Shasum) {
+		return "", fmt.Errorf("checksum mismatch for %s: registry advertises sha256:%s but the download hashes to sha256:%s", pkg.Filename, pkg.Shasum, actual)
+	}
+
+	reader, err := zip.NewReader(file, written)
+	if err != nil {
+		return "", fmt.Errorf("reading %s: %w", pkg.Filename, err)
+	}
+	return zipContentHash(reader)
+}
+
+// zipContentHash computes the 'h1:' hash Terraform records for a provider package: the SHA-256 of a summary listing
+// the SHA-256 and name of every file of the archive, sorted by name. Directory entries are not listed.
+func zipContentHash(reader *zip.Reader) (string, error) {
+	files := make([]*zip.File, 0, len(reader.File))
+	for _, file := range reader.File {
+		if !file.FileInfo().IsDir() {
+			files = append(files, file)
+		}
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+
+	summary := sha256.New()
+	for _, file := range files {
+		if strings.Contains(file.Name, "\n") {
+			return "", fmt.Errorf("file names with newlines are not supported: %q", file.Name)
+		}
+		content, err := file.Open()
+		if err != nil {
+			return "", err
+		}
+		fileHash := sha256.New()
+		_, err = io.Copy(fileHash, content)
+		content.Close()
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(summary, "%x  %s\n", fileHash.Sum(nil), file.Name)
+	}
+	return "h1:" + base64.StdEncoding.EncodeToString(summary.Sum(nil)), nil
+}
+
+// fetchProviderZipHashes returns the 'zh:' hashes of the packages of every platform listed in the SHA256SUMS
+// document of a provider version
+func fetchProviderZipHashes(ctx context.Context, httpClient *http.Client, shasumsURL, name, version string) ([]string, error) {
+	if shasumsURL == "" {
+		return nil, errors.New("the registry advertises no SHA256SUMS document")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, shasumsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: status: %s", shasumsURL, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxShasumsBytes))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", shasumsURL, err)
+	}
+
+	hashes := parseProviderShasums(string(body), name, version)
+	if len(hashes) == 0 {
+		return nil, fmt.Errorf("no packages of terraform-provider-%s %s listed in %s", name, version, shasumsURL)
+	}
+	return hashes, nil
+}
+
+// parseProviderShasums reads the 'zh:' hashes of the provider packages of a SHA256SUMS document, ignoring other files
+// such as the manifest
+func parseProviderShasums(shasums, name, version string) []string {
+	prefix := fmt.Sprintf("terraform-provider-%s_%s_", name, version)
+	var hashes []string
+	scanner := bufio.NewScanner(strings.NewReader(shasums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || len(fields[0]) != sha256.Size*2 {
+			continue
+		}
+		if strings.HasPrefix(fields[1], prefix) && strings.HasSuffix(fields[1], ".zip") {
+			hashes = append(hashes, "zh:"+strings.ToLower(fields[0]))
+		}
+	}
+	return hashes
+}
+
+// formatProviderLockEntry renders the lock file entry the way 'terraform init' writes it
+func formatProviderLockEntry(entry providerLockEntry) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# Dependency lock entry of %s %s\n\n", entry.Address, entry.Version))
+	builder.WriteString(fmt.Sprintf("'h1:' hashes computed for: %s\n", strings.Join(entry.Platforms, ", ")))
+	if len(entry.Missing) > 0 {
+		builder.WriteString(fmt.Sprintf("Not published for: %s - this version has no package for these platforms, so they cannot be locked\n", strings.Join(entry.Missing, ", ")))
+	}
+	builder.WriteString("\nAdd the entry to .terraform.lock.hcl, 'terraform init' records the version constraints of the configuration on the next run:\n\n")
+	builder.WriteString("```hcl\n")
+	builder.WriteString(fmt.Sprintf("provider %q {\n", entry.Address))
+	builder.WriteString(fmt.Sprintf("  version = %q\n", entry.Version))
+	builder.WriteString("  hashes = [\n")
+	for _, hash := range entry.Hashes {
+		builder.WriteString(fmt.Sprintf("    %q,\n", hash))
+	}
+	builder.WriteString("  ]\n}\n```\n")
+	return builder.String()
+}
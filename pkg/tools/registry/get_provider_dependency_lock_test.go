@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestZipContentHash(t *testing.T) {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	// Written out of order, the hash sorts the files by name and leaves out directory entries
+	for _, file := range []struct{ name, content string }{
+		{"terraform-provider-example_v1.0.0", "binary"},
+		{"docs/", ""},
+		{"README.md", "README"},
+	} {
+		w, err := writer.Create(file.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(file.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, err := zipContentHash(reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := "h1:tYVqrc4oMo4nbXCxnY2GmuZy4iucC1cjli5x+SVgaLg="; hash != expected {
+		t.Errorf("expected %s, got %s", expected, hash)
+	}
+}
+
+func TestCachedProviderPackageHash(t *testing.T) {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	w, err := writer.Create("terraform-provider-example_v1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte("binary")); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	checksum := sha256.Sum256(buf.Bytes())
+
+	var downloads atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downloads.Add(1)
+		w.Write(buf.Bytes())
+	}))
+	defer server.Close()
+
+	pkg := client.ProviderPackage{OS: "linux", Arch: "amd64", Filename: "example.zip", DownloadURL: server.URL, Shasum: hex.EncodeToString(checksum[:])}
+	first, err := cachedProviderPackageHash(context.Background(), server.Client(), "cache-test", "example", "1.0.0", pkg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := cachedProviderPackageHash(context.Background(), server.Client(), "cache-test", "example", "1.0.0", pkg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first != second || !strings.HasPrefix(first, "h1:") {
+		t.Errorf("expected the same h1 hash twice, got %s and %s", first, second)
+	}
+	if count := downloads.Load(); count != 1 {
+		t.Errorf("expected the package to be downloaded once, got %d downloads", count)
+	}
+}
+
+func TestParseProviderShasums(t *testing.T) {
+	shasums := strings.Join([]string{
+		"1111111111111111111111111111111111111111111111111111111111111111  terraform-provider-example_1.0.0_darwin_arm64.zip",
+		"2222222222222222222222222222222222222222222222222222222222222222  terraform-provider-example_1.0.0_manifest.json",
+		"3333333333333333333333333333333333333333333333333333333333333333  terraform-provider-example_1.0.0_linux_amd64.zip",
+		"4444444444444444444444444444444444444444444444444444444444444444  terraform-provider-other_1.0.0_linux_amd64.zip",
+		"not a checksum line",
+	}, "\n")
+
+	hashes := parseProviderShasums(shasums, "example", "1.0.0")
+	expected := []string{
+		"zh:1111111111111111111111111111111111111111111111111111111111111111",
+		"zh:3333333333333333333333333333333333333333333333333333333333333333",
+	}
+	if !reflect.DeepEqual(hashes, expected) {
+		t.Errorf("expected %v, got %v", expected, hashes)
+	}
+}
+
+func TestLockPlatforms(t *testing.T) {
+	platforms, err := lockPlatforms(nil)
+	if err != nil || !reflect.DeepEqual(platforms, defaultLockPlatforms) {
+		t.Errorf("expected the default platforms, got %v (%v)", platforms, err)
+	}
+
+	platforms, err = lockPlatforms([]string{"Linux_AMD64", "darwin_arm64", "linux_amd64"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{"linux_amd64", "darwin_arm64"}; !reflect.DeepEqual(platforms, expected) {
+		t.Errorf("expected %v, got %v", expected, platforms)
+	}
+
+	if _, err := lockPlatforms([]string{"linux/amd64"}); err == nil {
+		t.Error("expected an error for a platform not in the os_arch format")
+	}
+	if _, err := lockPlatforms([]string{"linux_amd64", "linux_arm64", "linux_386", "darwin_amd64", "darwin_arm64", "windows_amd64", "freebsd_amd64"}); err == nil {
+		t.Errorf("expected an error for more than %d platforms", maxLockPlatforms)
+	}
+}
+
+func TestFormatProviderLockEntry(t *testing.T) {
+	output := formatProviderLockEntry(providerLockEntry{
+		Address:   "registry.terraform.io/hashicorp/example",
+		Version:   "1.0.0",
+		Hashes:    []string{"h1:abc=", "zh:1111"},
+		Platforms: []string{"linux_amd64"},
+		Missing:   []string{"windows_arm64"},
+	})
+
+	for _, expected := range []string{
+		"provider \"registry.terraform.io/hashicorp/example\" {\n  version = \"1.0.0\"\n  hashes = [\n    \"h1:abc=\",\n    \"zh:1111\",\n  ]\n}",
+		"'h1:' hashes computed for: linux_amd64",
+		"Not published for: windows_arm64",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, output)
+		}
+	}
+}
@@ -5,22 +5,37 @@ package tools
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
 	"path"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	log "github.com/sirupsen/logrus"
 )
 
+const (
+	// defaultProviderDocPageSize is the page size in characters used when only 'page' is passed
+	defaultProviderDocPageSize = 20000
+	// minProviderDocPageSize keeps pages large enough to hold a meaningful section
+	minProviderDocPageSize = 1000
+)
+
 // GetProviderDocs creates a tool to get provider docs for a specific service from registry.
 func GetProviderDocs(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("get_provider_details",
 			mcp.WithDescription(`Fetches up-to-date documentation for a specific service from a Terraform provider. 
-You must call 'search_providers' tool first to obtain the exact tfprovider-compatible provider_doc_id required to use this tool.`),
+You must call 'search_providers' tool first to obtain the exact tfprovider-compatible provider_doc_id required to use this tool.
+To read the same document in another provider version, pass 'provider_name' and 'provider_version' ('latest' resolves to the newest published version); the resolved version is stated at the top of the output.
+Deprecation notices of the resource and its arguments are listed at the top and in the structured 'deprecations' field.
+The whole document is returned by default; pass 'examples_only' to get just its HCL code examples, e.g. when scaffolding configuration. For very large documents pass 'page' and/or 'page_size' to fetch it in chunks; each chunk ends with a marker telling whether more pages exist.
+For configuration that must work across provider versions, pass 'provider_name' and 'from_version' (and optionally 'to_version') to get the arguments of the resource merged across that version range instead, annotated with the versions they were added, deprecated or removed in. This fetches several versions, so keep the range narrow.`),
 			mcp.WithTitleAnnotation("Fetch detailed Terraform provider documentation using a document ID"),
 			mcp.WithOpenWorldHintAnnotation(true),
 			mcp.WithReadOnlyHintAnnotation(true),
@@ -28,9 +43,36 @@ You must call 'search_providers' tool first to obtain the exact tfprovider-compa
 			mcp.WithString("provider_doc_id",
 				mcp.Required(),
 				mcp.Description("Exact tfprovider-compatible provider_doc_id, (e.g., '8894603', '8906901') retrieved from 'search_providers'")),
+			mcp.WithNumber("page",
+				mcp.Description("Page of the document to return, starting at 1. Omit together with page_size to get the whole document"),
+				mcp.Min(1),
+			),
+			mcp.WithNumber("page_size",
+				mcp.Description(fmt.Sprintf("Maximum number of characters per page (min %d), defaults to %d when only page is set", minProviderDocPageSize, defaultProviderDocPageSize)),
+				mcp.Min(minProviderDocPageSize),
+			),
+			mcp.WithString("provider_name",
+				mcp.Description("The name of the Terraform provider the document belongs to, required with provider_version and from_version, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("Return the document as published in this provider version, in the format 'x.y.z' or 'latest', requires provider_name"),
+			),
+			mcp.WithBoolean("examples_only",
+				mcp.Description("Return only the fenced HCL code examples of the document under its title, without the prose"),
+				mcp.DefaultBool(false),
+			),
+			mcp.WithString("from_version",
+				mcp.Description("Merge the documentation of the versions from this one in the format 'x.y.z' up to to_version"),
+			),
+			mcp.WithString("to_version",
+				mcp.Description("The last version to merge in the format 'x.y.z', defaults to 'latest'"),
+			),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getProviderDocsHandler(ctx, req, logger)
+			return getProviderDocsHandler(ctx, req, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -38,13 +80,37 @@ You must call 'search_providers' tool first to obtain the exact tfprovider-compa
 func getProviderDocsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	providerDocID, err := request.RequireString("provider_doc_id")
 	if err != nil {
-		return ToolError(logger, "missing required input: provider_doc_id", err)
+		return ToolArgumentError(logger, "provider_doc_id", "is required")
 	}
 	if providerDocID == "" {
-		return ToolError(logger, "provider_doc_id cannot be empty", nil)
+		return ToolArgumentError(logger, "provider_doc_id", "cannot be empty")
 	}
 	if _, err := strconv.Atoi(providerDocID); err != nil {
-		return ToolError(logger, "provider_doc_id must be a valid number - use search_providers first to find valid IDs", err)
+		return ToolArgumentError(logger, "provider_doc_id", "must be a valid number - use search_providers first to find valid IDs")
+	}
+
+	page, err := utils.OptionalIntParam(request, "page")
+	if err != nil {
+		return ToolArgumentError(logger, "page", err.Error())
+	}
+	pageSize, err := utils.OptionalIntParam(request, "page_size")
+	if err != nil {
+		return ToolArgumentError(logger, "page_size", err.Error())
+	}
+	paginate := page != 0 || pageSize != 0
+	if paginate {
+		if page == 0 {
+			page = 1
+		}
+		if pageSize == 0 {
+			pageSize = defaultProviderDocPageSize
+		}
+		if page < 1 {
+			return ToolArgumentError(logger, "page", fmt.Sprintf("must be at least 1, got %d", page))
+		}
+		if pageSize < minProviderDocPageSize {
+			return ToolArgumentError(logger, "page_size", fmt.Sprintf("must be at least %d characters, got %d", minProviderDocPageSize, pageSize))
+		}
 	}
 
 	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
@@ -52,15 +118,219 @@ func getProviderDocsHandler(ctx context.Context, request mcp.CallToolRequest, lo
 		return ToolError(logger, "failed to get http client for public Terraform registry", err)
 	}
 
-	detailResp, err := client.SendRegistryCall(httpClient, "GET", path.Join("provider-docs", providerDocID), logger, "v2")
+	details, err := getProviderDocDetails(ctx, httpClient, providerDocID, logger)
+	if errors.Is(err, errProviderDocNotFound) {
+		return ToolNotFoundErrorf(logger, "provider doc not found: %s - use search_providers first to find valid provider_doc_id values", providerDocID)
+	}
+	if err != nil {
+		return ToolErrorf(logger, "%v", err)
+	}
+
+	examplesOnly := request.GetBool("examples_only", false)
+	if fromVersion := request.GetString("from_version", ""); fromVersion != "" {
+		if examplesOnly {
+			return ToolArgumentError(logger, "examples_only", "cannot be combined with from_version")
+		}
+		return mergedProviderDocsHandler(ctx, httpClient, request, details, fromVersion, logger)
+	}
+
+	header := ""
+	docVersion := ""
+	if providerVersion := strings.TrimSpace(request.GetString("provider_version", "")); providerVersion != "" {
+		providerDetail, ok := providerDetailFromRequest(request, details.Data.Attributes.Category)
+		if !ok {
+			return ToolArgumentError(logger, "provider_name", "is required when provider_version is set")
+		}
+		providerDetail, err = withComparedVersion(ctx, httpClient, providerDetail, providerVersion, logger)
+		if err != nil {
+			return ToolArgumentError(logger, "provider_version", err.Error())
+		}
+		details, err = providerDocInVersion(ctx, httpClient, providerDetail, details, logger)
+		if err != nil {
+			return ToolErrorf(logger, "%v", err)
+		}
+		header = providerDocVersionHeader(providerDetail.ProviderVersion, providerVersion)
+		docVersion = providerDetail.ProviderVersion
+	}
+
+	content := details.Data.Attributes.Content
+	deprecations := utils.ExtractDocDeprecations(content, details.Data.Attributes.Title)
+	header += providerDocDeprecationsHeader(deprecations)
+	if examplesOnly {
+		content = providerDocExamples(details.Data.Attributes.Title, content)
+	}
+	docPage := newProviderDocPage(details, docVersion, content)
+	docPage.Deprecations = deprecations
+	if !paginate {
+		return withProviderDocDeprecations(utils.NewToolResultData(header+content, docPage), deprecations), nil
+	}
+
+	pages := utils.SplitDocPages(content, pageSize)
+	if page > len(pages) {
+		return ToolArgumentError(logger, "page", fmt.Sprintf("%d is out of range: provider doc %s has %d page(s) with page_size %d", page, details.Data.ID, len(pages), pageSize))
+	}
+	docPage.Content, docPage.Page, docPage.Pages = pages[page-1], page, len(pages)
+	return withProviderDocDeprecations(utils.NewToolResultData(header+pages[page-1]+providerDocPageMarker(page, len(pages), pageSize), docPage), deprecations), nil
+}
+
+// providerDocPage is the typed result of the tools returning the content of a provider document
+type providerDocPage struct {
+	ProviderDocID string `json:"provider_doc_id"`
+	Title         string `json:"title"`
+	Category      string `json:"category"`
+	Slug          string `json:"slug"`
+	// Version is the provider version the document was read from, when the tool resolved one
+	Version      string                 `json:"version,omitempty"`
+	Content      string                 `json:"content"`
+	Page         int                    `json:"page,omitempty"`
+	Pages        int                    `json:"pages,omitempty"`
+	Deprecations []utils.DocDeprecation `json:"deprecations,omitempty"`
+	// Note explains why the content is returned instead of the structured data the tool extracts
+	Note string `json:"note,omitempty"`
+}
+
+func newProviderDocPage(details client.ProviderResourceDetails, version string, content string) providerDocPage {
+	return providerDocPage{
+		ProviderDocID: details.Data.ID,
+		Title:         details.Data.Attributes.Title,
+		Category:      details.Data.Attributes.Category,
+		Slug:          details.Data.Attributes.Slug,
+		Version:       version,
+		Content:       content,
+	}
+}
+
+// providerDocDeprecations is the structured content of a document with deprecation notices
+type providerDocDeprecations struct {
+	Deprecations []utils.DocDeprecation `json:"deprecations"`
+}
+
+// withProviderDocDeprecations attaches the deprecation notices of the document as structured content, so they
+// do not have to be found in the text
+func withProviderDocDeprecations(result *mcp.CallToolResult, deprecations []utils.DocDeprecation) *mcp.CallToolResult {
+	if len(deprecations) > 0 {
+		result.StructuredContent = providerDocDeprecations{Deprecations: deprecations}
+	}
+	return result
+}
+
+// providerDocDeprecationsHeader summarizes the deprecation notices at the top of the document, on every page
+func providerDocDeprecationsHeader(deprecations []utils.DocDeprecation) string {
+	if len(deprecations) == 0 {
+		return ""
+	}
+	var builder strings.Builder
+	builder.WriteString("Deprecations (avoid these in new configuration):\n")
+	for _, deprecation := range deprecations {
+		line := fmt.Sprintf("- %s `%s` is deprecated", deprecation.Kind, deprecation.Name)
+		if deprecation.Replacement != "" {
+			line += fmt.Sprintf(", use `%s` instead", deprecation.Replacement)
+		}
+		builder.WriteString(line + "\n")
+	}
+	return builder.String() + "\n"
+}
+
+// providerDetailFromRequest returns the provider a document belongs to, from the provider_name and
+// provider_namespace arguments, the namespace falling back to the default provider namespace
+func providerDetailFromRequest(request mcp.CallToolRequest, category string) (client.ProviderDetail, bool) {
+	providerDetail := client.ProviderDetail{
+		ProviderName:         strings.ToLower(strings.TrimSpace(request.GetString("provider_name", ""))),
+		ProviderNamespace:    providerNamespaceArgument(request),
+		ProviderDocumentType: category,
+	}
+	return providerDetail, providerDetail.ProviderName != ""
+}
+
+// providerDocInVersion returns the document with the same category and slug in the version of providerDetail
+func providerDocInVersion(ctx context.Context, httpClient *http.Client, providerDetail client.ProviderDetail, details client.ProviderResourceDetails, logger *log.Logger) (client.ProviderResourceDetails, error) {
+	providerDocs, err := getProviderDocsList(ctx, httpClient, providerDetail, logger)
 	if err != nil {
-		return ToolErrorf(logger, "provider doc not found: %s - use search_providers first to find valid provider_doc_id values", providerDocID)
+		return details, err
+	}
+	doc, ok := findProviderDocBySlug(providerDocs, providerDetail.ProviderName, providerDetail.ProviderDocumentType, details.Data.Attributes.Slug)
+	if !ok {
+		return details, fmt.Errorf("%s '%s' is not documented in %s/%s version %s", providerDetail.ProviderDocumentType, details.Data.Attributes.Slug, providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion)
 	}
+	if doc.ID == details.Data.ID {
+		return details, nil
+	}
+
+	return getProviderDocDetails(ctx, httpClient, doc.ID, logger)
+}
+
+// errProviderDocNotFound is returned by getProviderDocDetails when the registry answers 404 for the ID
+var errProviderDocNotFound = errors.New("provider doc not found")
 
+// getProviderDocDetails fetches a provider document by its provider_doc_id
+func getProviderDocDetails(ctx context.Context, httpClient *http.Client, providerDocID string, logger *log.Logger) (client.ProviderResourceDetails, error) {
 	var details client.ProviderResourceDetails
-	if err := json.Unmarshal(detailResp, &details); err != nil {
-		return ToolErrorf(logger, "failed to parse provider docs for %s", providerDocID)
+	detailResp, err := client.SendRegistryCall(ctx, httpClient, http.MethodGet, path.Join("provider-docs", providerDocID), logger, client.RegistryAPIVersions().ProviderDocs)
+	if err != nil {
+		if client.RegistryErrorCode(err) == utils.ErrorCodeNotFound {
+			return details, fmt.Errorf("%w: %s", errProviderDocNotFound, providerDocID)
+		}
+		return details, fmt.Errorf("getting provider doc %s: %w", providerDocID, err)
+	}
+	if err := client.DecodeRegistryResponse(detailResp, &details); err != nil {
+		return details, fmt.Errorf("failed to parse provider docs for %s", providerDocID)
+	}
+	return details, nil
+}
+
+// providerDocVersionHeader states the provider version a document was read from, and what it was resolved from
+func providerDocVersionHeader(version string, requested string) string {
+	if resolved, err := utils.ResolveVersionInput(requested); err == nil && resolved == version {
+		return fmt.Sprintf("Provider version: %s\n\n", version)
+	}
+	return fmt.Sprintf("Provider version: %s (resolved from '%s')\n\n", version, requested)
+}
+
+// mergedProviderDocsHandler returns the fields of the document merged across a version range
+func mergedProviderDocsHandler(ctx context.Context, httpClient *http.Client, request mcp.CallToolRequest, details client.ProviderResourceDetails, fromVersion string, logger *log.Logger) (*mcp.CallToolResult, error) {
+	category := details.Data.Attributes.Category
+	providerDetail, ok := providerDetailFromRequest(request, category)
+	if !ok {
+		return ToolArgumentError(logger, "provider_name", "is required when from_version is set")
+	}
+	if category != "resources" && category != "data-sources" {
+		return ToolArgumentError(logger, "from_version", fmt.Sprintf("merging across versions is only supported for resources and data sources, provider doc %s is in category '%s'", details.Data.ID, category))
+	}
+
+	toVersion := request.GetString("to_version", "latest")
+	if strings.TrimSpace(toVersion) == "" {
+		toVersion = "latest"
+	}
+
+	versions, totalVersions, err := getMergedProviderDocs(ctx, httpClient, providerDetail, details.Data.Attributes.Slug, fromVersion, toVersion, logger)
+	if err != nil {
+		return ToolError(logger, "failed to merge provider docs across versions", err)
+	}
+	return utils.NewToolResultData(formatMergedProviderDocs(details.Data.Attributes.Title, versions, totalVersions), newMergedProviderDoc(details, versions, totalVersions)), nil
+}
+
+// providerDocExamples keeps only the fenced HCL code blocks of a document, under its title
+func providerDocExamples(title string, content string) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# %s\n", title))
+	examples := 0
+	for _, block := range utils.ExtractCodeBlocks(content) {
+		if block.Language != utils.CodeLanguageHCL {
+			continue
+		}
+		examples++
+		builder.WriteString(fmt.Sprintf("\n```hcl\n%s\n```\n", strings.Trim(block.Code, "\n")))
+	}
+	if examples == 0 {
+		builder.WriteString("\nThis document has no HCL code examples.\n")
 	}
+	return builder.String()
+}
 
-	return mcp.NewToolResultText(details.Data.Attributes.Content), nil
+// providerDocPageMarker describes the position of a page in the document and how to fetch the next one
+func providerDocPageMarker(page, totalPages, pageSize int) string {
+	if page < totalPages {
+		return fmt.Sprintf("\n\n---\n[Page %d of %d. More pages available: call get_provider_details with page=%d and page_size=%d to continue]\n", page, totalPages, page+1, pageSize)
+	}
+	return fmt.Sprintf("\n\n---\n[Page %d of %d. End of document]\n", page, totalPages)
 }
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// maxProviderDocsBatchSize is the maximum number of documents fetched by a single batch call
+	maxProviderDocsBatchSize = 20
+	// providerDocsBatchWorkers bounds the number of documents fetched concurrently
+	providerDocsBatchWorkers = 5
+)
+
+// providerDocsBatch is the structured result of a batch call, the documents keyed by provider_doc_id
+type providerDocsBatch struct {
+	Docs   map[string]providerDocsBatchEntry `json:"docs"`
+	Failed int                               `json:"failed"`
+}
+
+// providerDocsBatchEntry is a fetched document, or the reason it could not be fetched
+type providerDocsBatchEntry struct {
+	Title    string `json:"title,omitempty"`
+	Category string `json:"category,omitempty"`
+	Slug     string `json:"slug,omitempty"`
+	Content  string `json:"content,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// GetProviderDocsBatch creates a tool that fetches several provider documents in one call.
+func GetProviderDocsBatch(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_provider_details_batch",
+			mcp.WithDescription(fmt.Sprintf(`Fetches the documentation of several provider documents in a single call, e.g. a resource and the resources it is usually combined with, returned as structured data keyed by provider_doc_id.
+You must call 'search_providers' first to obtain the exact tfprovider-compatible provider_doc_id values. Up to %d documents can be fetched per call; a document that cannot be fetched gets an 'error' instead of 'content' without failing the others.
+Use 'get_provider_details' for a single document or to page through a very large one.`, maxProviderDocsBatchSize)),
+			mcp.WithTitleAnnotation("Fetch several Terraform provider documents using their document IDs"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithArray("provider_doc_ids",
+				mcp.Required(),
+				mcp.Description("Exact tfprovider-compatible provider_doc_id values retrieved from 'search_providers', e.g. ['8894603', '8906901']"),
+				mcp.WithStringItems(),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getProviderDocsBatchHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getProviderDocsBatchHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	rawIDs, err := request.RequireStringSlice("provider_doc_ids")
+	if err != nil {
+		return ToolArgumentError(logger, "provider_doc_ids", "is required and must be a list of provider_doc_id strings")
+	}
+	providerDocIDs, err := uniqueProviderDocIDs(rawIDs)
+	if err != nil {
+		return ToolArgumentError(logger, "provider_doc_ids", err.Error())
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	batch := fetchProviderDocsBatch(ctx, httpClient, providerDocIDs, logger)
+	if batch.Failed == len(providerDocIDs) {
+		return ToolErrorf(logger, "none of the provider docs could be fetched: %s - use search_providers first to find valid provider_doc_id values", strings.Join(providerDocIDs, ", "))
+	}
+
+	result, err := json.MarshalIndent(batch, "", "  ")
+	if err != nil {
+		return ToolError(logger, "failed to marshal provider docs", err)
+	}
+	return mcp.NewToolResultStructured(batch, string(result)), nil
+}
+
+// uniqueProviderDocIDs validates the requested IDs and drops duplicates, keeping the first occurrence
+func uniqueProviderDocIDs(rawIDs []string) ([]string, error) {
+	seen := make(map[string]bool, len(rawIDs))
+	var ids []string
+	for _, raw := range rawIDs {
+		id := strings.TrimSpace(raw)
+		if _, err := strconv.Atoi(id); err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid provider_doc_id - use search_providers first to find valid IDs", raw)
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("cannot be empty")
+	}
+	if len(ids) > maxProviderDocsBatchSize {
+		return nil, fmt.Errorf("must contain at most %d IDs, got %d", maxProviderDocsBatchSize, len(ids))
+	}
+	return ids, nil
+}
+
+// fetchProviderDocsBatch fetches the documents with a bounded number of workers
+func fetchProviderDocsBatch(ctx context.Context, httpClient *http.Client, providerDocIDs []string, logger *log.Logger) providerDocsBatch {
+	batch := providerDocsBatch{Docs: make(map[string]providerDocsBatchEntry, len(providerDocIDs))}
+	results := client.ParallelRegistryCalls(providerDocIDs, providerDocsBatchWorkers, func(id string) (client.ProviderResourceDetails, error) {
+		return getProviderDocDetails(ctx, httpClient, id, logger)
+	})
+	for i, result := range results {
+		if result.Err != nil {
+			batch.Docs[providerDocIDs[i]] = providerDocsBatchEntry{Error: result.Err.Error()}
+			batch.Failed++
+			continue
+		}
+		attributes := result.Value.Data.Attributes
+		batch.Docs[providerDocIDs[i]] = providerDocsBatchEntry{
+			Title:    attributes.Title,
+			Category: attributes.Category,
+			Slug:     attributes.Slug,
+			Content:  attributes.Content,
+		}
+	}
+	return batch
+}
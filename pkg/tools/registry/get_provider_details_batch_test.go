@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func TestUniqueProviderDocIDs(t *testing.T) {
+	ids, err := uniqueProviderDocIDs([]string{"8894603", " 8906901 ", "8894603"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{"8894603", "8906901"}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+
+	tooMany := make([]string, maxProviderDocsBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprint(i + 1)
+	}
+	for name, rawIDs := range map[string][]string{
+		"empty":      {},
+		"not number": {"8894603", "aws_instance"},
+		"too many":   tooMany,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := uniqueProviderDocIDs(rawIDs); err == nil {
+				t.Errorf("expected an error for %v", rawIDs)
+			}
+		})
+	}
+}
+
+func TestFetchProviderDocsBatch(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			observed := maxInFlight.Load()
+			if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+
+		id := strings.TrimPrefix(r.URL.Path, "/v2/provider-docs/")
+		if id == "404" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, `{"data": {"id": %q, "attributes": {"title": "doc %s", "category": "resources", "slug": "s%s", "content": "content %s"}}}`, id, id, id, id)
+	}))
+	defer registry.Close()
+	t.Setenv("TERRAFORM_REGISTRY_URL", registry.URL)
+
+	ids := []string{"1", "2", "3", "4", "5", "6", "7", "404"}
+	batch := fetchProviderDocsBatch(context.Background(), registry.Client(), ids, log.New())
+
+	if len(batch.Docs) != len(ids) || batch.Failed != 1 {
+		t.Fatalf("expected %d docs with 1 failure, got %+v", len(ids), batch)
+	}
+	if doc := batch.Docs["3"]; doc.Title != "doc 3" || doc.Content != "content 3" || doc.Slug != "s3" || doc.Error != "" {
+		t.Errorf("unexpected doc 3: %+v", doc)
+	}
+	if doc := batch.Docs["404"]; doc.Error == "" || doc.Content != "" {
+		t.Errorf("expected an error for doc 404, got %+v", doc)
+	}
+	if maxInFlight.Load() > providerDocsBatchWorkers {
+		t.Errorf("expected at most %d concurrent requests, got %d", providerDocsBatchWorkers, maxInFlight.Load())
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+)
+
+func TestProviderDocVersionHeader(t *testing.T) {
+	tests := []struct {
+		name      string
+		version   string
+		requested string
+		want      string
+	}{
+		{name: "latest", version: "6.2.0", requested: "latest", want: "Provider version: 6.2.0 (resolved from 'latest')\n\n"},
+		{name: "latest mixed case", version: "6.2.0", requested: "Latest", want: "Provider version: 6.2.0 (resolved from 'Latest')\n\n"},
+		{name: "exact version", version: "5.80.0", requested: "5.80.0", want: "Provider version: 5.80.0\n\n"},
+		{name: "v prefixed version", version: "5.80.0", requested: "v5.80.0", want: "Provider version: 5.80.0\n\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := providerDocVersionHeader(tt.version, tt.requested); got != tt.want {
+				t.Errorf("providerDocVersionHeader(%q, %q) = %q, want %q", tt.version, tt.requested, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProviderDocExamples(t *testing.T) {
+	content := "---\nsubcategory: \"EC2\"\n---\n\n# Resource: aws_instance\n\nProvides an EC2 instance.\n\n## Example Usage\n\n```terraform\nresource \"aws_instance\" \"web\" {\n  ami = \"ami-123\"\n}\n```\n\n## Import\n\n```shell\n$ terraform import aws_instance.web i-123\n```\n\n```hcl\nimport {\n  to = aws_instance.web\n  id = \"i-123\"\n}\n```\n"
+	want := "# aws_instance\n\n```hcl\nresource \"aws_instance\" \"web\" {\n  ami = \"ami-123\"\n}\n```\n\n```hcl\nimport {\n  to = aws_instance.web\n  id = \"i-123\"\n}\n```\n"
+	if got := providerDocExamples("aws_instance", content); got != want {
+		t.Errorf("providerDocExamples() = %q, want %q", got, want)
+	}
+
+	if got := providerDocExamples("aws_instance", "No examples here.\n\n```shell\nls\n```\n"); got != "# aws_instance\n\nThis document has no HCL code examples.\n" {
+		t.Errorf("unexpected output without HCL examples: %q", got)
+	}
+}
+
+func TestProviderDocDeprecationsHeader(t *testing.T) {
+	if got := providerDocDeprecationsHeader(nil); got != "" {
+		t.Errorf("expected no header without deprecations, got %q", got)
+	}
+
+	deprecations := []utils.DocDeprecation{
+		{Kind: "resource", Name: "aws_s3_bucket_object", Replacement: "aws_s3_object"},
+		{Kind: "argument", Name: "legacy_mode"},
+	}
+	want := "Deprecations (avoid these in new configuration):\n- resource `aws_s3_bucket_object` is deprecated, use `aws_s3_object` instead\n- argument `legacy_mode` is deprecated\n\n"
+	if got := providerDocDeprecationsHeader(deprecations); got != want {
+		t.Errorf("providerDocDeprecationsHeader() = %q, want %q", got, want)
+	}
+
+	result := withProviderDocDeprecations(mcp.NewToolResultText("doc"), deprecations)
+	structured, ok := result.StructuredContent.(providerDocDeprecations)
+	if !ok || len(structured.Deprecations) != 2 {
+		t.Errorf("expected the deprecations as structured content, got %#v", result.StructuredContent)
+	}
+	if result := withProviderDocDeprecations(mcp.NewToolResultText("doc"), nil); result.StructuredContent != nil {
+		t.Errorf("expected no structured content without deprecations, got %#v", result.StructuredContent)
+	}
+}
+
+func TestGetProviderDocDetailsErrors(t *testing.T) {
+	tests := []struct {
+		status   int
+		notFound bool
+		expected utils.ErrorCode
+	}{
+		{status: http.StatusNotFound, notFound: true},
+		{status: http.StatusTooManyRequests, expected: utils.ErrorCodeRateLimited},
+		{status: http.StatusInternalServerError, expected: utils.ErrorCodeUpstreamError},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			httpClient := &http.Client{Transport: policyStatusTransport(tt.status)}
+			_, err := getProviderDocDetails(context.Background(), httpClient, "12345", log.New())
+			if errors.Is(err, errProviderDocNotFound) != tt.notFound {
+				t.Fatalf("expected not found %v, got %v", tt.notFound, err)
+			}
+			if !tt.notFound {
+				if code := errorCodeOf(err); code != tt.expected {
+					t.Errorf("expected %s, got %s for %v", tt.expected, code, err)
+				}
+			}
+		})
+	}
+}
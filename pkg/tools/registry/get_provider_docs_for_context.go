@@ -0,0 +1,321 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// minDocContextTokens keeps the budget large enough for the title and a few required arguments
+	minDocContextTokens = 200
+	// docContextOmittedReserve is held back from the budget for the list of omitted content
+	docContextOmittedReserve = 60
+	// maxOmittedNames caps the names listed per omitted section
+	maxOmittedNames = 8
+)
+
+// docContextItem is a piece of a doc page that is either included whole or omitted
+type docContextItem struct {
+	Label string // name used when the item is reported as omitted
+	Text  string
+}
+
+// docContextSection is a group of items, sections are filled in priority order
+type docContextSection struct {
+	Title string
+	Items []docContextItem
+}
+
+// GetProviderDocsForContext creates a tool that returns the most useful parts of a resource's documentation within a token budget.
+func GetProviderDocsForContext(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_provider_docs_for_context",
+			mcp.WithDescription(`Returns the documentation of a Terraform resource or data source trimmed to fit a token budget, most useful content first: required arguments, then optional arguments (those used in the examples first), then HCL examples, nested blocks and finally read-only attributes.
+Content is dropped whole rather than cut mid-sentence, and the result lists what was omitted so it can be fetched with 'get_provider_details' if needed.`),
+			mcp.WithTitleAnnotation("Get Terraform resource documentation that fits a token budget"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The version of the Terraform provider in the format 'x.y.z', or 'latest' to get the latest version"),
+			),
+			mcp.WithString("resource_name",
+				mcp.Required(),
+				mcp.Description("The resource or data source name, with or without the provider prefix, e.g. 'aws_instance' or 'instance'"),
+			),
+			mcp.WithString("provider_document_type",
+				mcp.Description("Whether the name refers to a resource or a data source"),
+				mcp.Enum("resources", "data-sources"),
+				mcp.DefaultString("resources"),
+			),
+			mcp.WithNumber("max_tokens",
+				mcp.Required(),
+				mcp.Description(fmt.Sprintf("The approximate number of tokens the result may take up, at least %d", minDocContextTokens)),
+				mcp.Min(minDocContextTokens),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getProviderDocsForContextHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getProviderDocsForContextHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	resourceName, err := request.RequireString("resource_name")
+	if err != nil || strings.TrimSpace(resourceName) == "" {
+		return ToolArgumentError(logger, "resource_name", "is required")
+	}
+	maxTokens, err := utils.OptionalIntParam(request, "max_tokens")
+	if err != nil {
+		return ToolArgumentError(logger, "max_tokens", err.Error())
+	}
+	if maxTokens < minDocContextTokens {
+		return ToolArgumentError(logger, "max_tokens", fmt.Sprintf("must be at least %d, got %d", minDocContextTokens, maxTokens))
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve provider: %v", err)
+	}
+	if providerDetail.ProviderDocumentType != "data-sources" {
+		providerDetail.ProviderDocumentType = "resources"
+	}
+
+	doc, content, err := getProviderDocContentBySlug(ctx, httpClient, providerDetail, resourceName, logger)
+	if err != nil {
+		return ToolError(logger, "failed to fetch resource documentation", err)
+	}
+
+	fitted := fitProviderDocToBudget(doc, utils.CleanProviderDoc(content), maxTokens)
+	docPage := providerDocPageOf(doc, fitted)
+	docPage.Version = providerDetail.ProviderVersion
+	return utils.NewToolResultData(fitted, docPage), nil
+}
+
+// fitProviderDocToBudget renders the sections of a doc page in priority order, dropping whole items that do not
+// fit in maxTokens, and lists what was dropped
+func fitProviderDocToBudget(doc client.ProviderDoc, content string, maxTokens int) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# %s\n\n", doc.Title))
+
+	sections := docContextSections(content)
+	if len(sections) == 0 {
+		// Nothing structured to prioritize, fall back to the start of the page
+		note := fmt.Sprintf("> **Note:** No arguments or examples could be extracted, the start of the page is shown. Use get_provider_details with provider_doc_id %s for the rest.\n\n", doc.ID)
+		builder.WriteString(note)
+		remaining := (maxTokens - utils.EstimateTokens(builder.String())) * 4
+		if remaining > 0 {
+			builder.WriteString(utils.SplitDocPages(content, remaining)[0])
+		}
+		return builder.String()
+	}
+
+	if description := docDescription(content); description != "" {
+		builder.WriteString(description + "\n\n")
+	}
+	header := builder.String()
+	budget := maxTokens - docContextOmittedReserve - utils.EstimateTokens(header)
+
+	included := make([]docContextSection, len(sections))
+	omitted := make(map[string][]string)
+	for i, section := range sections {
+		included[i].Title = section.Title
+		for _, item := range section.Items {
+			cost := utils.EstimateTokens(item.Text)
+			if len(included[i].Items) == 0 {
+				cost += utils.EstimateTokens(docContextHeading(section.Title))
+			}
+			if cost > budget {
+				omitted[section.Title] = append(omitted[section.Title], item.Label)
+				continue
+			}
+			included[i].Items = append(included[i].Items, item)
+			budget -= cost
+		}
+	}
+
+	// The reserve is an estimate, drop the lowest priority items until the omitted list fits as well
+	result := renderDocContext(header, doc.ID, maxTokens, included, omitted)
+	for utils.EstimateTokens(result) > maxTokens {
+		last := len(included) - 1
+		for last >= 0 && len(included[last].Items) == 0 {
+			last--
+		}
+		if last < 0 {
+			break
+		}
+		items := included[last].Items
+		omitted[included[last].Title] = append([]string{items[len(items)-1].Label}, omitted[included[last].Title]...)
+		included[last].Items = items[:len(items)-1]
+		result = renderDocContext(header, doc.ID, maxTokens, included, omitted)
+	}
+	return result
+}
+
+func docContextHeading(title string) string {
+	return fmt.Sprintf("## %s\n\n", title)
+}
+
+// renderDocContext writes the included sections followed by a summary of the omitted items
+func renderDocContext(header string, docID string, maxTokens int, included []docContextSection, omitted map[string][]string) string {
+	var builder strings.Builder
+	builder.WriteString(header)
+	for _, section := range included {
+		if len(section.Items) == 0 {
+			continue
+		}
+		builder.WriteString(docContextHeading(section.Title))
+		for _, item := range section.Items {
+			builder.WriteString(item.Text)
+		}
+		builder.WriteString("\n")
+	}
+
+	if len(omitted) == 0 {
+		return builder.String()
+	}
+	builder.WriteString(fmt.Sprintf("## Omitted to fit %d tokens\n\n", maxTokens))
+	for _, section := range included {
+		names := omitted[section.Title]
+		if len(names) == 0 {
+			continue
+		}
+		listed := names
+		if len(listed) > maxOmittedNames {
+			listed = listed[:maxOmittedNames]
+		}
+		line := fmt.Sprintf("- %s (%d): %s", section.Title, len(names), strings.Join(listed, ", "))
+		if len(names) > len(listed) {
+			line += fmt.Sprintf(" and %d more", len(names)-len(listed))
+		}
+		builder.WriteString(line + "\n")
+	}
+	builder.WriteString(fmt.Sprintf("\nUse get_provider_details with provider_doc_id %s to read the full documentation.\n", docID))
+	return builder.String()
+}
+
+// docContextSections splits a doc page into prioritized sections of items
+func docContextSections(content string) []docContextSection {
+	var required, optional, attributes []utils.DocArgument
+	for _, argument := range utils.ParseDocArguments(content) {
+		switch argument.Qualifier {
+		case "Required":
+			required = append(required, argument)
+		case "Read-Only":
+			attributes = append(attributes, argument)
+		default:
+			optional = append(optional, argument)
+		}
+	}
+	examples := utils.FilterCodeBlocks(utils.ExtractCodeBlocks(content), utils.CodeLanguageHCL)
+	optional = orderByExampleUsage(optional, examples)
+
+	var sections []docContextSection
+	addArguments := func(title string, arguments []utils.DocArgument) {
+		if len(arguments) == 0 {
+			return
+		}
+		section := docContextSection{Title: title}
+		for _, argument := range arguments {
+			section.Items = append(section.Items, docContextItem{Label: argument.Name, Text: "- " + formatDocField("`"+argument.Name+"`", argument) + "\n"})
+		}
+		sections = append(sections, section)
+	}
+
+	addArguments("Required arguments", required)
+	addArguments("Optional arguments", optional)
+	if len(examples) > 0 {
+		section := docContextSection{Title: "Examples"}
+		for i, example := range examples {
+			label := example.Heading
+			if label == "" {
+				label = fmt.Sprintf("example %d", i+1)
+			}
+			text := fmt.Sprintf("```hcl\n%s\n```\n", strings.TrimRight(example.Code, "\n"))
+			if example.Heading != "" {
+				text = fmt.Sprintf("### %s\n\n%s\n", example.Heading, text)
+			}
+			section.Items = append(section.Items, docContextItem{Label: label, Text: text})
+		}
+		sections = append(sections, section)
+	}
+	if blocks := utils.ParseDocNestedBlocks(content); len(blocks) > 0 {
+		section := docContextSection{Title: "Nested blocks"}
+		for _, block := range blocks {
+			var text strings.Builder
+			text.WriteString(fmt.Sprintf("### `%s`\n\n", block.Name))
+			for _, argument := range block.Arguments {
+				text.WriteString("- " + formatDocField("`"+argument.Name+"`", argument) + "\n")
+			}
+			text.WriteString("\n")
+			section.Items = append(section.Items, docContextItem{Label: block.Name, Text: text.String()})
+		}
+		sections = append(sections, section)
+	}
+	addArguments("Attributes", attributes)
+
+	return sections
+}
+
+// orderByExampleUsage moves the arguments set in the examples to the front, as those are the commonly used ones.
+// The document order is kept otherwise.
+func orderByExampleUsage(arguments []utils.DocArgument, examples []utils.CodeBlock) []utils.DocArgument {
+	var code strings.Builder
+	for _, example := range examples {
+		code.WriteString(example.Code + "\n")
+	}
+	var used, unused []utils.DocArgument
+	for _, argument := range arguments {
+		usage := regexp.MustCompile(`(?m)^\s*` + regexp.QuoteMeta(argument.Name) + `\s*(=|\{)`)
+		if usage.MatchString(code.String()) {
+			used = append(used, argument)
+		} else {
+			unused = append(unused, argument)
+		}
+	}
+	return append(used, unused...)
+}
+
+// docDescription returns the first paragraph of a doc page before any heading or code block
+func docDescription(content string) string {
+	var lines []string
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "#") {
+			if len(lines) > 0 {
+				break
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || (trimmed == "" && len(lines) > 0) {
+			break
+		}
+		if trimmed != "" {
+			lines = append(lines, trimmed)
+		}
+	}
+	return strings.Join(lines, " ")
+}
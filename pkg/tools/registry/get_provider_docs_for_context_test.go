@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+)
+
+func TestFitProviderDocToBudget(t *testing.T) {
+	var optional strings.Builder
+	for i := 0; i < 20; i++ {
+		optional.WriteString(fmt.Sprintf("* `setting_%d` - (Optional) A rarely used setting that takes up some room in the documentation.\n", i))
+	}
+	content := `Provides an EC2 instance resource.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "aws_instance" "web" {
+  ami           = "ami-123"
+  instance_type = "t3.micro"
+}
+` + "```" + `
+
+## Argument Reference
+
+* ` + "`ami`" + ` - (Required) AMI to use for the instance.
+` + optional.String() + `* ` + "`instance_type`" + ` - (Optional) Instance type to use for the instance.
+
+## Attribute Reference
+
+* ` + "`arn`" + ` - ARN of the instance.
+`
+	doc := client.ProviderDoc{ID: "123", Title: "aws_instance"}
+
+	full := fitProviderDocToBudget(doc, content, 5000)
+	for _, want := range []string{"# aws_instance", "Provides an EC2 instance resource.", "## Required arguments", "## Examples", "`arn`"} {
+		if !strings.Contains(full, want) {
+			t.Errorf("expected %q in the full result, got:\n%s", want, full)
+		}
+	}
+	if strings.Contains(full, "Omitted") {
+		t.Errorf("expected nothing omitted with a large budget, got:\n%s", full)
+	}
+
+	trimmed := fitProviderDocToBudget(doc, content, 300)
+	if tokens := utils.EstimateTokens(trimmed); tokens > 300 {
+		t.Errorf("expected at most 300 tokens, got %d:\n%s", tokens, trimmed)
+	}
+	if !strings.Contains(trimmed, "- `ami` (Required): AMI to use for the instance.") {
+		t.Errorf("expected the required argument to be kept, got:\n%s", trimmed)
+	}
+	// instance_type is used in the example so it is ranked before the other optional arguments
+	if !strings.Contains(trimmed, "`instance_type`") {
+		t.Errorf("expected the optional argument used in the example to be kept, got:\n%s", trimmed)
+	}
+	if !strings.Contains(trimmed, "## Omitted to fit 300 tokens") || !strings.Contains(trimmed, "- Optional arguments (") {
+		t.Errorf("expected the omitted content to be reported, got:\n%s", trimmed)
+	}
+	if !strings.Contains(trimmed, "provider_doc_id 123") {
+		t.Errorf("expected a pointer to the full documentation, got:\n%s", trimmed)
+	}
+}
+
+func TestFitProviderDocToBudgetUnstructured(t *testing.T) {
+	content := strings.Repeat("Some prose without any argument list.\n\n", 200)
+	result := fitProviderDocToBudget(client.ProviderDoc{ID: "7", Title: "guide"}, content, 200)
+
+	if !strings.Contains(result, "No arguments or examples could be extracted") {
+		t.Errorf("expected the fallback note, got:\n%s", result)
+	}
+	if tokens := utils.EstimateTokens(result); tokens > 200 {
+		t.Errorf("expected at most 200 tokens, got %d", tokens)
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	// functionSignatureRegex matches a tfplugindocs signature such as "arn_parse(arn string) object"
+	functionSignatureRegex = regexp.MustCompile(`^\s*([A-Za-z_][A-Za-z0-9_]*)\s*\((.*)\)\s*(.*?)\s*$`)
+	// functionArgumentRegex matches an entry of the numbered "Arguments" list, e.g. "1. `arn` (String) ARN to parse."
+	functionArgumentRegex = regexp.MustCompile("^\\s*\\d+\\.\\s+`([^`]+)`\\s*(?:\\(([^)]*)\\))?\\s*(.*)$")
+)
+
+// providerFunction is the signature and documentation of a provider-defined function
+type providerFunction struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description,omitempty"`
+	Signature   string              `json:"signature,omitempty"`
+	Parameters  []functionParameter `json:"parameters,omitempty"`
+	ReturnType  string              `json:"return_type,omitempty"`
+}
+
+type functionParameter struct {
+	Name        string `json:"name"`
+	Type        string `json:"type,omitempty"`
+	Variadic    bool   `json:"variadic,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// providerFunctions is the typed result of get_provider_functions
+type providerFunctions struct {
+	Provider  string             `json:"provider"`
+	Version   string             `json:"version"`
+	Functions []providerFunction `json:"functions"`
+}
+
+// GetProviderFunctions creates a tool that documents the provider-defined functions of a provider version.
+func GetProviderFunctions(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_provider_functions",
+			mcp.WithDescription(`Lists the provider-defined functions of a Terraform provider version with their signatures (parameter names and types, variadic parameters, return type) and descriptions.
+Provider functions are called in configurations as provider::<provider_name>::<function_name>(...) and require Terraform 1.8 or later.`),
+			mcp.WithTitleAnnotation("Get the provider-defined functions of a Terraform provider"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The version of the Terraform provider in the format 'x.y.z', or 'latest' to get the latest version"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getProviderFunctionsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getProviderFunctionsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve provider: %v", err)
+	}
+
+	providerVersionID, err := client.GetProviderVersionID(ctx, httpClient, providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to find provider %s/%s version %s in the registry: %v", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, err)
+	}
+
+	docs, err := client.SendPaginatedRegistryCall(ctx, httpClient, fmt.Sprintf("provider-docs?filter[provider-version]=%s&filter[category]=functions&filter[language]=hcl", providerVersionID), logger)
+	if err != nil {
+		return ToolError(logger, "failed to list provider functions", err)
+	}
+	if len(docs) == 0 {
+		return ToolNotFoundErrorf(logger, "provider %s/%s version %s does not define any functions", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion)
+	}
+
+	contents := client.ParallelRegistryCalls(docs, 0, func(doc client.ProviderDocData) (string, error) {
+		return client.GetProviderResourceDocs(ctx, httpClient, doc.ID, logger)
+	})
+	functions := make([]providerFunction, 0, len(docs))
+	for i, doc := range docs {
+		if contents[i].Err != nil {
+			// List the function without its signature rather than leave it out
+			logger.Warnf("Unable to fetch the documentation of function %s: %v", doc.Attributes.Title, contents[i].Err)
+			functions = append(functions, providerFunction{Name: doc.Attributes.Title})
+			continue
+		}
+		functions = append(functions, parseProviderFunctionDoc(doc.Attributes.Title, utils.CleanProviderDoc(contents[i].Value)))
+	}
+	sort.Slice(functions, func(i, j int) bool { return functions[i].Name < functions[j].Name })
+
+	return utils.NewToolResultData(formatProviderFunctions(providerDetail, functions), providerFunctions{
+		Provider:  providerDetail.ProviderNamespace + "/" + providerDetail.ProviderName,
+		Version:   providerDetail.ProviderVersion,
+		Functions: functions,
+	}), nil
+}
+
+// parseProviderFunctionDoc extracts the signature and parameter documentation of a function doc page
+func parseProviderFunctionDoc(title string, content string) providerFunction {
+	function := providerFunction{Name: title, Description: docDescription(content)}
+
+	for _, block := range utils.ExtractCodeBlocks(content) {
+		if !strings.EqualFold(strings.TrimSpace(block.Heading), "Signature") {
+			continue
+		}
+		signature := strings.TrimSpace(block.Code)
+		match := functionSignatureRegex.FindStringSubmatch(signature)
+		if match == nil {
+			continue
+		}
+		function.Signature = signature
+		function.Name = match[1]
+		function.Parameters = parseFunctionParameters(match[2])
+		function.ReturnType = match[3]
+		break
+	}
+
+	// Descriptions come from the numbered "Arguments" list, in signature order
+	position := 0
+	inArguments := false
+	for _, line := range strings.Split(content, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			inArguments = strings.Contains(strings.ToLower(line), "argument")
+			continue
+		}
+		if !inArguments {
+			continue
+		}
+		match := functionArgumentRegex.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		name := strings.TrimPrefix(match[1], "...")
+		index := -1
+		for i, parameter := range function.Parameters {
+			if parameter.Name == name {
+				index = i
+				break
+			}
+		}
+		if index == -1 {
+			// The signature is missing or names the parameter differently, rely on the list
+			if function.Signature != "" && position < len(function.Parameters) {
+				index = position
+			} else {
+				function.Parameters = append(function.Parameters, functionParameter{Name: name, Type: strings.ToLower(match[2])})
+				index = len(function.Parameters) - 1
+			}
+		}
+		function.Parameters[index].Description = strings.TrimSpace(match[3])
+		position++
+	}
+	return function
+}
+
+// parseFunctionParameters parses the parameter list of a signature, e.g. "separator string, items ...string"
+func parseFunctionParameters(list string) []functionParameter {
+	var parameters []functionParameter
+	for _, part := range splitTopLevel(list) {
+		fields := strings.Fields(part)
+		if len(fields) == 0 {
+			continue
+		}
+		parameter := functionParameter{Name: fields[0]}
+		if len(fields) > 1 {
+			parameter.Type = strings.Join(fields[1:], " ")
+		}
+		if strings.HasPrefix(parameter.Type, "...") {
+			parameter.Variadic = true
+			parameter.Type = strings.TrimPrefix(parameter.Type, "...")
+		}
+		parameters = append(parameters, parameter)
+	}
+	return parameters
+}
+
+// splitTopLevel splits on the commas that are not nested in brackets, so "list(string)" or "object({...})"
+// types stay whole
+func splitTopLevel(list string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range list {
+		switch r {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(list[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if rest := strings.TrimSpace(list[start:]); rest != "" {
+		parts = append(parts, rest)
+	}
+	return parts
+}
+
+func formatProviderFunctions(providerDetail client.ProviderDetail, functions []providerFunction) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# Functions of %s/%s %s (%d)\n\n", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, len(functions)))
+	builder.WriteString(fmt.Sprintf("Call them as `provider::%s::<function_name>(...)`, which requires Terraform 1.8 or later and the provider in `required_providers`.\n\n", providerDetail.ProviderName))
+
+	for _, function := range functions {
+		builder.WriteString(fmt.Sprintf("## %s\n\n", function.Name))
+		if function.Description != "" {
+			builder.WriteString(function.Description + "\n\n")
+		}
+		if function.Signature != "" {
+			builder.WriteString(fmt.Sprintf("Signature: `%s`\n\n", function.Signature))
+		} else {
+			builder.WriteString("Signature: not documented\n\n")
+		}
+		if len(function.Parameters) > 0 {
+			builder.WriteString("Parameters:\n")
+			for _, parameter := range function.Parameters {
+				line := fmt.Sprintf("- `%s`", parameter.Name)
+				var attributes []string
+				if parameter.Type != "" {
+					attributes = append(attributes, parameter.Type)
+				}
+				if parameter.Variadic {
+					attributes = append(attributes, "variadic")
+				}
+				if len(attributes) > 0 {
+					line += " (" + strings.Join(attributes, ", ") + ")"
+				}
+				if parameter.Description != "" {
+					line += ": " + parameter.Description
+				}
+				builder.WriteString(line + "\n")
+			}
+			builder.WriteString("\n")
+		}
+		if function.ReturnType != "" {
+			builder.WriteString(fmt.Sprintf("Returns: %s\n\n", function.ReturnType))
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestParseProviderFunctionDoc(t *testing.T) {
+	content := "# Function: join_names\n\n" +
+		"Joins names with a separator.\n\n" +
+		"## Example Usage\n\n```terraform\noutput \"x\" {\n  value = provider::acme::join_names(\"-\", \"a\", \"b\")\n}\n```\n\n" +
+		"## Signature\n\n```text\njoin_names(separator string, names ...string) string\n```\n\n" +
+		"## Arguments\n\n" +
+		"1. `separator` (String) Separator placed between the names.\n" +
+		"1. `names` (Variadic, String) Names to join.\n"
+
+	function := parseProviderFunctionDoc("join_names", content)
+
+	if function.Name != "join_names" || function.ReturnType != "string" || function.Description != "Joins names with a separator." {
+		t.Fatalf("unexpected function %+v", function)
+	}
+	if len(function.Parameters) != 2 {
+		t.Fatalf("expected 2 parameters, got %+v", function.Parameters)
+	}
+	separator, names := function.Parameters[0], function.Parameters[1]
+	if separator.Name != "separator" || separator.Type != "string" || separator.Variadic || separator.Description != "Separator placed between the names." {
+		t.Errorf("unexpected first parameter %+v", separator)
+	}
+	if names.Name != "names" || names.Type != "string" || !names.Variadic || names.Description != "Names to join." {
+		t.Errorf("unexpected variadic parameter %+v", names)
+	}
+
+	output := formatProviderFunctions(client.ProviderDetail{ProviderNamespace: "acme", ProviderName: "acme", ProviderVersion: "1.0.0"}, []providerFunction{function})
+	for _, want := range []string{
+		"provider::acme::<function_name>",
+		"Signature: `join_names(separator string, names ...string) string`",
+		"- `names` (string, variadic): Names to join.",
+		"Returns: string",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestParseFunctionParametersNestedTypes(t *testing.T) {
+	parameters := parseFunctionParameters("input object({a = string, b = list(number)}), keys list(string)")
+	if len(parameters) != 2 || parameters[0].Type != "object({a = string, b = list(number)})" || parameters[1].Name != "keys" {
+		t.Errorf("unexpected parameters %+v", parameters)
+	}
+	if parameters := parseFunctionParameters(""); len(parameters) != 0 {
+		t.Errorf("expected no parameters, got %+v", parameters)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetProviderGuide creates a tool that returns a provider guide by its slug, or lists the guides of a provider.
+func GetProviderGuide(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_provider_guide",
+			mcp.WithDescription(`Fetches a guide of a Terraform provider version, such as a version upgrade or authentication guide, by its slug and returns its markdown directly, without looking up a provider_doc_id first.
+When 'guide_slug' is omitted, lists the slugs and titles of all guides of the provider version instead.`),
+			mcp.WithTitleAnnotation("Fetch a Terraform provider guide by its slug"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The version of the Terraform provider in the format 'x.y.z', or 'latest' to get the latest version"),
+			),
+			mcp.WithString("guide_slug",
+				mcp.Description("The slug of the guide, e.g. 'version-5-upgrade'. Omit it to list the available guides"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getProviderGuideHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getProviderGuideHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	guideSlug := normalizeGuideSlug(request.GetString("guide_slug", ""))
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve provider: %v", err)
+	}
+
+	providerVersionID, err := client.GetProviderVersionID(ctx, httpClient, providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to find provider %s/%s version %s in the registry: %v", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, err)
+	}
+
+	guides, err := client.SendPaginatedRegistryCall(ctx, httpClient, fmt.Sprintf("provider-docs?filter[provider-version]=%s&filter[category]=guides&filter[language]=hcl", providerVersionID), logger)
+	if err != nil {
+		return ToolError(logger, "failed to list the provider guides", err)
+	}
+	if len(guides) == 0 {
+		return ToolNotFoundErrorf(logger, "provider %s/%s version %s has no guides", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion)
+	}
+	sort.Slice(guides, func(i, j int) bool { return guides[i].Attributes.Slug < guides[j].Attributes.Slug })
+
+	if guideSlug == "" {
+		return utils.NewToolResultData(formatProviderGuideList(providerDetail, guides), newProviderGuideList(providerDetail, guides)), nil
+	}
+
+	guide, ok := findProviderGuide(guides, guideSlug)
+	if !ok {
+		return ToolNotFoundErrorf(logger, "guide '%s' not found in provider %s/%s version %s - call get_provider_guide without guide_slug to list the available guides", guideSlug, providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion)
+	}
+	content, err := client.GetProviderResourceDocs(ctx, httpClient, guide.ID, logger)
+	if err != nil {
+		return ToolError(logger, fmt.Sprintf("failed to fetch guide %s", guide.Attributes.Slug), err)
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# %s\n\n", guide.Attributes.Title))
+	builder.WriteString(fmt.Sprintf("Guide `%s` of %s/%s %s (provider_doc_id: %s)\n\n", guide.Attributes.Slug, providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, guide.ID))
+	cleaned := utils.CleanProviderDoc(content)
+	builder.WriteString(cleaned)
+	return utils.NewToolResultData(builder.String(), providerDocPage{
+		ProviderDocID: guide.ID,
+		Title:         guide.Attributes.Title,
+		Category:      guide.Attributes.Category,
+		Slug:          guide.Attributes.Slug,
+		Version:       providerDetail.ProviderVersion,
+		Content:       cleaned,
+	}), nil
+}
+
+// normalizeGuideSlug accepts a slug as written in a registry URL or doc path, e.g. "guides/version-5-upgrade" or
+// "version-5-upgrade.html.markdown"
+func normalizeGuideSlug(raw string) string {
+	slug := strings.ToLower(strings.TrimSpace(raw))
+	slug = slug[strings.LastIndex(slug, "/")+1:]
+	for _, suffix := range []string{".html.markdown", ".html.md", ".md"} {
+		slug = strings.TrimSuffix(slug, suffix)
+	}
+	return slug
+}
+
+// findProviderGuide looks up a guide by slug, falling back to a guide whose title matches the slug with dashes as
+// spaces (e.g. "version 5 upgrade")
+func findProviderGuide(guides []client.ProviderDocData, slug string) (client.ProviderDocData, bool) {
+	for _, guide := range guides {
+		if strings.ToLower(guide.Attributes.Slug) == slug {
+			return guide, true
+		}
+	}
+	title := strings.ReplaceAll(slug, "-", " ")
+	for _, guide := range guides {
+		if strings.ToLower(guide.Attributes.Title) == title {
+			return guide, true
+		}
+	}
+	return client.ProviderDocData{}, false
+}
+
+// providerGuideList is the typed result of get_provider_guide without a guide_slug
+type providerGuideList struct {
+	Provider string          `json:"provider"`
+	Version  string          `json:"version"`
+	Guides   []providerGuide `json:"guides"`
+}
+
+type providerGuide struct {
+	Slug          string `json:"slug"`
+	Title         string `json:"title"`
+	ProviderDocID string `json:"provider_doc_id"`
+}
+
+func newProviderGuideList(providerDetail client.ProviderDetail, guides []client.ProviderDocData) providerGuideList {
+	list := providerGuideList{
+		Provider: providerDetail.ProviderNamespace + "/" + providerDetail.ProviderName,
+		Version:  providerDetail.ProviderVersion,
+		Guides:   make([]providerGuide, 0, len(guides)),
+	}
+	for _, guide := range guides {
+		list.Guides = append(list.Guides, providerGuide{Slug: guide.Attributes.Slug, Title: guide.Attributes.Title, ProviderDocID: guide.ID})
+	}
+	return list
+}
+
+func formatProviderGuideList(providerDetail client.ProviderDetail, guides []client.ProviderDocData) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# Guides of %s/%s %s (%d)\n\n", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, len(guides)))
+	for _, guide := range guides {
+		builder.WriteString(fmt.Sprintf("- %s: %s\n", guide.Attributes.Slug, guide.Attributes.Title))
+	}
+	builder.WriteString("\nCall get_provider_guide with one of these slugs as guide_slug to read the guide.\n")
+	return builder.String()
+}
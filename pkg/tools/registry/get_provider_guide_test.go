@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func testProviderGuide(id, slug, title string) client.ProviderDocData {
+	var guide client.ProviderDocData
+	guide.ID = id
+	guide.Attributes.Slug = slug
+	guide.Attributes.Title = title
+	return guide
+}
+
+func TestNormalizeGuideSlug(t *testing.T) {
+	for input, expected := range map[string]string{
+		"version-5-upgrade":                     "version-5-upgrade",
+		" Version-5-Upgrade ":                   "version-5-upgrade",
+		"guides/version-5-upgrade":              "version-5-upgrade",
+		"version-5-upgrade.html.markdown":       "version-5-upgrade",
+		"website/docs/guides/custom-service.md": "custom-service",
+		"":                                      "",
+	} {
+		if actual := normalizeGuideSlug(input); actual != expected {
+			t.Errorf("normalizeGuideSlug(%q): expected %q, got %q", input, expected, actual)
+		}
+	}
+}
+
+func TestFindProviderGuide(t *testing.T) {
+	guides := []client.ProviderDocData{
+		testProviderGuide("1", "version-5-upgrade", "Terraform AWS Provider Version 5 Upgrade Guide"),
+		testProviderGuide("2", "resource-tagging", "Resource Tagging"),
+	}
+
+	if guide, ok := findProviderGuide(guides, "version-5-upgrade"); !ok || guide.ID != "1" {
+		t.Errorf("expected the guide with the slug, got %+v (%v)", guide, ok)
+	}
+	if guide, ok := findProviderGuide(guides, "resource-tagging"); !ok || guide.ID != "2" {
+		t.Errorf("expected the guide with the slug, got %+v (%v)", guide, ok)
+	}
+	if _, ok := findProviderGuide(guides, "version-4-upgrade"); ok {
+		t.Error("expected no guide for an unknown slug")
+	}
+}
+
+func TestFormatProviderGuideList(t *testing.T) {
+	providerDetail := client.ProviderDetail{ProviderNamespace: "hashicorp", ProviderName: "aws", ProviderVersion: "5.0.0"}
+	output := formatProviderGuideList(providerDetail, []client.ProviderDocData{
+		testProviderGuide("1", "resource-tagging", "Resource Tagging"),
+		testProviderGuide("2", "version-5-upgrade", "Version 5 Upgrade Guide"),
+	})
+	for _, expected := range []string{
+		"# Guides of hashicorp/aws 5.0.0 (2)",
+		"- resource-tagging: Resource Tagging",
+		"- version-5-upgrade: Version 5 Upgrade Guide",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, output)
+		}
+	}
+}
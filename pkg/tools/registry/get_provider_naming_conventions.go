@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// namingPatternLimit is the maximum number of service prefixes and suffixes in the summary
+	namingPatternLimit = 10
+	// namingPatternMinCount is how often a prefix or suffix must occur to count as a convention
+	namingPatternMinCount = 2
+)
+
+// GetProviderNamingConventions creates a tool that summarizes the resource naming conventions of a provider.
+func GetProviderNamingConventions(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_provider_naming_conventions",
+			mcp.WithDescription(`Summarizes how the resources and data sources of a Terraform provider are named, derived from its resource inventory: the type prefix, the most common service prefixes (e.g. 'aws_s3_') and the most common suffixes (e.g. '_policy', '_attachment').
+Use it to construct plausible resource names for an unfamiliar provider, then confirm them with 'list_provider_resources' or 'search_providers'.`),
+			mcp.WithTitleAnnotation("Summarize the resource naming conventions of a Terraform provider"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The version of the Terraform provider in the format 'x.y.z', or 'latest' to get the latest version"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getProviderNamingConventionsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getProviderNamingConventionsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve provider: %v", err)
+	}
+
+	providerDocs, err := getProviderDocsList(ctx, httpClient, providerDetail, logger)
+	if err != nil {
+		return ToolError(logger, "failed to list provider documentation", err)
+	}
+
+	output, conventions := formatProviderNamingConventions(providerDocs, providerDetail)
+	if conventions.Resources+conventions.DataSources == 0 {
+		return ToolNotFoundErrorf(logger, "no resources or data sources found in provider %s/%s version %s", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion)
+	}
+	return utils.NewToolResultData(output, conventions), nil
+}
+
+// providerNamingConventions is the typed result of get_provider_naming_conventions
+type providerNamingConventions struct {
+	Provider        string          `json:"provider"`
+	Version         string          `json:"version"`
+	Prefix          string          `json:"prefix"`
+	Resources       int             `json:"resources"`
+	DataSources     int             `json:"data_sources"`
+	ServicePrefixes []namingPattern `json:"service_prefixes,omitempty"`
+	Suffixes        []namingPattern `json:"suffixes,omitempty"`
+	// SharedNames is the number of data sources sharing the name of a resource
+	SharedNames int `json:"shared_names"`
+}
+
+// namingPattern is a name segment and the number of resource or data source names it occurs in
+type namingPattern struct {
+	Segment string `json:"segment"`
+	Count   int    `json:"count"`
+}
+
+// topNamingPatterns returns the segments occurring at least namingPatternMinCount times, most frequent first
+func topNamingPatterns(counts map[string]int) []namingPattern {
+	var patterns []namingPattern
+	for segment, count := range counts {
+		if count >= namingPatternMinCount {
+			patterns = append(patterns, namingPattern{Segment: segment, Count: count})
+		}
+	}
+	sort.Slice(patterns, func(i, j int) bool {
+		if patterns[i].Count != patterns[j].Count {
+			return patterns[i].Count > patterns[j].Count
+		}
+		return patterns[i].Segment < patterns[j].Segment
+	})
+	if len(patterns) > namingPatternLimit {
+		patterns = patterns[:namingPatternLimit]
+	}
+	return patterns
+}
+
+// formatProviderNamingConventions renders the naming conventions of the resources and data sources of a provider,
+// returning the output and the conventions it was derived from
+func formatProviderNamingConventions(docs client.ProviderDocs, providerDetail client.ProviderDetail) (string, providerNamingConventions) {
+	prefix := providerDetail.ProviderName + "_"
+	resources := make(map[string]bool)
+	dataSources := make(map[string]bool)
+	servicePrefixes := make(map[string]int)
+	suffixes := make(map[string]int)
+
+	for _, doc := range docs.Docs {
+		if doc.Language != "hcl" || (doc.Category != "resources" && doc.Category != "data-sources") {
+			continue
+		}
+		name := providerResourceName(providerDetail.ProviderName, doc)
+		if doc.Category == "resources" {
+			if resources[name] {
+				continue
+			}
+			resources[name] = true
+		} else {
+			if dataSources[name] {
+				continue
+			}
+			dataSources[name] = true
+		}
+
+		// Only resources contribute the patterns, data sources mostly mirror them
+		if doc.Category != "resources" {
+			continue
+		}
+		segments := strings.Split(strings.TrimPrefix(name, prefix), "_")
+		if len(segments) < 2 {
+			continue
+		}
+		servicePrefixes[segments[0]]++
+		suffixes[segments[len(segments)-1]]++
+	}
+
+	conventions := providerNamingConventions{
+		Provider:        providerDetail.ProviderNamespace + "/" + providerDetail.ProviderName,
+		Version:         providerDetail.ProviderVersion,
+		Prefix:          prefix,
+		Resources:       len(resources),
+		DataSources:     len(dataSources),
+		ServicePrefixes: topNamingPatterns(servicePrefixes),
+		Suffixes:        topNamingPatterns(suffixes),
+	}
+	for name := range dataSources {
+		if resources[name] {
+			conventions.SharedNames++
+		}
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# Naming conventions of %s %s\n\n", conventions.Provider, conventions.Version))
+	builder.WriteString(fmt.Sprintf("Derived from %d resources and %d data sources.\n\n", conventions.Resources, conventions.DataSources))
+	builder.WriteString(fmt.Sprintf("- All resource and data source types start with `%s`\n", prefix))
+
+	if len(conventions.ServicePrefixes) > 0 {
+		builder.WriteString("- Most common service prefixes: ")
+		for i, pattern := range conventions.ServicePrefixes {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(fmt.Sprintf("`%s%s_` (%d)", prefix, pattern.Segment, pattern.Count))
+		}
+		builder.WriteString("\n")
+	}
+
+	if len(conventions.Suffixes) > 0 {
+		builder.WriteString("- Most common suffixes: ")
+		for i, pattern := range conventions.Suffixes {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(fmt.Sprintf("`_%s` (%d)", pattern.Segment, pattern.Count))
+		}
+		builder.WriteString("\n")
+	}
+
+	if conventions.DataSources > 0 {
+		builder.WriteString(fmt.Sprintf("- %d of %d data sources share the name of a resource\n", conventions.SharedNames, conventions.DataSources))
+	}
+
+	return builder.String(), conventions
+}
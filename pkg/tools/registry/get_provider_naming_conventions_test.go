@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestFormatProviderNamingConventions(t *testing.T) {
+	docs := client.ProviderDocs{
+		Docs: []client.ProviderDoc{
+			{ID: "1", Slug: "s3_bucket", Category: "resources", Language: "hcl"},
+			{ID: "2", Slug: "s3_bucket_policy", Category: "resources", Language: "hcl"},
+			{ID: "3", Slug: "iam_role_policy", Category: "resources", Language: "hcl"},
+			{ID: "4", Slug: "iam_role", Category: "resources", Language: "hcl"},
+			{ID: "5", Slug: "instance", Category: "resources", Language: "hcl"},
+			{ID: "6", Slug: "s3_bucket", Category: "data-sources", Language: "hcl"},
+			{ID: "7", Slug: "ami", Category: "data-sources", Language: "hcl"},
+			{ID: "8", Slug: "arn", Category: "functions", Language: "hcl"},
+			{ID: "9", Slug: "s3_object", Category: "resources", Language: "python"},
+		},
+	}
+	detail := client.ProviderDetail{ProviderNamespace: "hashicorp", ProviderName: "aws", ProviderVersion: "5.0.0"}
+
+	out, conventions := formatProviderNamingConventions(docs, detail)
+	if count := conventions.Resources + conventions.DataSources; count != 7 {
+		t.Fatalf("expected 7 names, got %d:\n%s", count, out)
+	}
+	expected := []string{
+		"Derived from 5 resources and 2 data sources.",
+		"start with `aws_`",
+		"Most common service prefixes: `aws_iam_` (2), `aws_s3_` (2)",
+		"Most common suffixes: `_policy` (2)",
+		"1 of 2 data sources share the name of a resource",
+	}
+	for _, want := range expected {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "`_bucket`") {
+		t.Errorf("suffixes occurring once should not be listed, got:\n%s", out)
+	}
+
+	_, conventions = formatProviderNamingConventions(client.ProviderDocs{}, detail)
+	if count := conventions.Resources + conventions.DataSources; count != 0 {
+		t.Errorf("expected no names for empty docs, got %d", count)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetProviderOverview creates a tool to fetch the index page of a provider's documentation.
+func GetProviderOverview(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_provider_overview",
+			mcp.WithDescription(`Fetches the overview (index) documentation page of a Terraform provider, which describes how to configure the provider block, authentication and general usage.
+Use this before looking up individual resources when you need to know how to set up the provider.`),
+			mcp.WithTitleAnnotation("Get the overview documentation of a Terraform provider"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The version of the Terraform provider in the format 'x.y.z', or 'latest' to get the latest version"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getProviderOverviewHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getProviderOverviewHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve provider: %v", err)
+	}
+
+	providerVersionID, err := client.GetProviderVersionID(ctx, httpClient, providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to find provider %s/%s version %s in the registry: %v", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, err)
+	}
+
+	content, err := client.GetProviderOverviewDocs(ctx, httpClient, providerVersionID, logger)
+	if err != nil {
+		return ToolError(logger, "failed to fetch provider overview documentation", err)
+	}
+
+	content = utils.CleanProviderDoc(content)
+	if content == "" {
+		return ToolNotFoundErrorf(logger, "no overview documentation published for provider %s/%s version %s", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion)
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("**Provider:** %s/%s\n\n", providerDetail.ProviderNamespace, providerDetail.ProviderName))
+	builder.WriteString(fmt.Sprintf("**Version:** %s\n\n", providerDetail.ProviderVersion))
+	builder.WriteString(content)
+	builder.WriteString("\n")
+
+	return utils.NewToolResultData(builder.String(), providerOverview{
+		Provider: providerDetail.ProviderNamespace + "/" + providerDetail.ProviderName,
+		Version:  providerDetail.ProviderVersion,
+		Content:  content,
+	}), nil
+}
+
+// providerOverview is the typed result of get_provider_overview
+type providerOverview struct {
+	Provider string `json:"provider"`
+	Version  string `json:"version"`
+	Content  string `json:"content"`
+}
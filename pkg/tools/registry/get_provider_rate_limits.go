@@ -0,0 +1,248 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// maxRateLimitGuides caps the number of guides fetched in addition to the overview page
+	maxRateLimitGuides = 3
+	// maxRateLimitPassages caps the number of documentation passages returned
+	maxRateLimitPassages = 20
+)
+
+var (
+	// rateLimitKeywordRegex matches prose about API rate limits, quotas and retrying throttled requests
+	rateLimitKeywordRegex = regexp.MustCompile(`(?i)rate[ -]?limit|throttl|quota|too many requests|\b429\b|\bretr(y|ies|ied)\b|back-?off`)
+	// rateLimitArgumentRegex matches provider argument names that tune retries, throttling or concurrency
+	rateLimitArgumentRegex = regexp.MustCompile(`(?i)retr|rate_limit|throttl|backoff|quota|concurren|parallelism`)
+	// rateLimitGuideRegex matches the title or slug of guides likely to discuss rate limits
+	rateLimitGuideRegex = regexp.MustCompile(`(?i)rate|limit|throttl|quota|retr|best[-_ ]practice`)
+	// argumentItemRegex matches a list item documenting an argument, e.g. "* `max_retries` - (Optional) ..."
+	argumentItemRegex = regexp.MustCompile("^[*-]\\s+`[A-Za-z0-9_]+`")
+)
+
+// rateLimitPassage is a paragraph or list item of a doc page that mentions rate limits
+type rateLimitPassage struct {
+	Source  string `json:"source"`            // title of the doc page
+	Heading string `json:"heading,omitempty"` // closest heading above the passage, empty before the first heading
+	Text    string `json:"text"`
+}
+
+// providerRateLimits is the typed result of get_provider_rate_limits
+type providerRateLimits struct {
+	Provider  string              `json:"provider"`
+	Version   string              `json:"version"`
+	Arguments []utils.DocArgument `json:"arguments"`
+	Passages  []rateLimitPassage  `json:"passages"`
+	// Sources are the documentation pages that were searched
+	Sources []string `json:"sources"`
+}
+
+// GetProviderRateLimits creates a tool that extracts the documented rate-limit guidance of a provider.
+func GetProviderRateLimits(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_provider_rate_limits",
+			mcp.WithDescription(`Extracts the API rate-limit, throttling, quota and retry guidance documented by a Terraform provider, together with the provider arguments that tune it (e.g. 'max_retries').
+Searches the provider overview page and the provider guides about rate limits or best practices. Use this when configuring large applies against an API that throttles requests.`),
+			mcp.WithTitleAnnotation("Get the documented rate limits and retry settings of a Terraform provider"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The version of the Terraform provider in the format 'x.y.z', or 'latest' to get the latest version"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getProviderRateLimitsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getProviderRateLimitsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve provider: %v", err)
+	}
+
+	providerVersionID, err := client.GetProviderVersionID(ctx, httpClient, providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to find provider %s/%s version %s in the registry: %v", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, err)
+	}
+
+	overview, err := client.GetProviderOverviewDocs(ctx, httpClient, providerVersionID, logger)
+	if err != nil {
+		return ToolError(logger, "failed to fetch provider overview documentation", err)
+	}
+	overview = utils.CleanProviderDoc(overview)
+
+	arguments := rateLimitArguments(utils.ParseDocArguments(overview))
+	passages := extractRateLimitPassages("Overview", overview, true)
+	sources := []string{"the provider overview"}
+
+	// Guides are optional, a failure to list them should not hide the overview guidance
+	guides, err := client.SendPaginatedRegistryCall(ctx, httpClient, fmt.Sprintf("provider-docs?filter[provider-version]=%s&filter[category]=guides&filter[language]=hcl", providerVersionID), logger)
+	if err != nil {
+		logger.Warnf("Unable to list the guides of provider %s/%s: %v", providerDetail.ProviderNamespace, providerDetail.ProviderName, err)
+	}
+	selected := selectRateLimitGuides(guides)
+	contents := client.ParallelRegistryCalls(selected, 0, func(guide client.ProviderDocData) (string, error) {
+		return client.GetProviderResourceDocs(ctx, httpClient, guide.ID, logger)
+	})
+	for i, guide := range selected {
+		if contents[i].Err != nil {
+			logger.Warnf("Unable to fetch guide %s: %v", guide.Attributes.Title, contents[i].Err)
+			continue
+		}
+		sources = append(sources, fmt.Sprintf("the %q guide", guide.Attributes.Title))
+		passages = append(passages, extractRateLimitPassages(guide.Attributes.Title, utils.CleanProviderDoc(contents[i].Value), false)...)
+	}
+
+	return utils.NewToolResultData(formatProviderRateLimits(providerDetail, arguments, passages, sources), providerRateLimits{
+		Provider:  providerDetail.ProviderNamespace + "/" + providerDetail.ProviderName,
+		Version:   providerDetail.ProviderVersion,
+		Arguments: arguments,
+		Passages:  passages,
+		Sources:   sources,
+	}), nil
+}
+
+// selectRateLimitGuides returns the guides whose title or slug suggests they discuss rate limits
+func selectRateLimitGuides(guides []client.ProviderDocData) []client.ProviderDocData {
+	var selected []client.ProviderDocData
+	for _, guide := range guides {
+		if len(selected) == maxRateLimitGuides {
+			break
+		}
+		if rateLimitGuideRegex.MatchString(guide.Attributes.Title) || rateLimitGuideRegex.MatchString(guide.Attributes.Slug) {
+			selected = append(selected, guide)
+		}
+	}
+	return selected
+}
+
+// rateLimitArguments returns the provider arguments that tune retries or throttling
+func rateLimitArguments(arguments []utils.DocArgument) []utils.DocArgument {
+	var matched []utils.DocArgument
+	for _, argument := range arguments {
+		if rateLimitArgumentRegex.MatchString(argument.Name) || rateLimitKeywordRegex.MatchString(argument.Description) {
+			matched = append(matched, argument)
+		}
+	}
+	return matched
+}
+
+// extractRateLimitPassages returns the paragraphs and list items of a doc page that mention rate limits.
+// Code blocks are skipped, as are argument list items when skipArguments is set since those are reported as arguments.
+func extractRateLimitPassages(source string, content string, skipArguments bool) []rateLimitPassage {
+	var passages []rateLimitPassage
+	heading := ""
+	var current []string
+	flush := func() {
+		text := strings.Join(current, " ")
+		current = nil
+		if text == "" || !rateLimitKeywordRegex.MatchString(text) {
+			return
+		}
+		if skipArguments && argumentItemRegex.MatchString(text) {
+			return
+		}
+		passages = append(passages, rateLimitPassage{Source: source, Heading: heading, Text: text})
+	}
+
+	inCodeFence := false
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			flush()
+			inCodeFence = !inCodeFence
+			continue
+		}
+		if inCodeFence {
+			continue
+		}
+		switch {
+		case trimmed == "":
+			flush()
+		case strings.HasPrefix(trimmed, "#"):
+			flush()
+			heading = strings.TrimSpace(strings.TrimLeft(trimmed, "#"))
+		case strings.HasPrefix(trimmed, "* ") || strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "-> ") || strings.HasPrefix(trimmed, "~> "):
+			// Every list item and callout is a passage of its own, indented lines continue it
+			if !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") {
+				flush()
+			}
+			current = append(current, trimmed)
+		default:
+			current = append(current, trimmed)
+		}
+	}
+	flush()
+
+	return passages
+}
+
+func formatProviderRateLimits(providerDetail client.ProviderDetail, arguments []utils.DocArgument, passages []rateLimitPassage, sources []string) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("## Rate limits and retries for %s/%s version %s\n\n", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion))
+
+	if len(arguments) == 0 && len(passages) == 0 {
+		builder.WriteString(fmt.Sprintf("No rate-limit, throttling, quota or retry guidance is documented in %s. ", strings.Join(sources, ", ")))
+		builder.WriteString("Check the API documentation of the underlying service for its limits.\n")
+		return builder.String()
+	}
+
+	builder.WriteString(fmt.Sprintf("Searched %s.\n\n", strings.Join(sources, ", ")))
+
+	if len(arguments) > 0 {
+		builder.WriteString("### Provider arguments\n\n")
+		builder.WriteString("| Argument | Qualifier | Description |\n")
+		builder.WriteString("|----------|-----------|-------------|\n")
+		for _, argument := range arguments {
+			description := strings.ReplaceAll(argument.Description, "|", "\\|")
+			builder.WriteString(fmt.Sprintf("| `%s` | %s | %s |\n", argument.Name, argument.Qualifier, description))
+		}
+		builder.WriteString("\n")
+	}
+
+	if len(passages) > 0 {
+		builder.WriteString("### Documented guidance\n\n")
+		for i, passage := range passages {
+			if i == maxRateLimitPassages {
+				builder.WriteString(fmt.Sprintf("... and %d more passages\n", len(passages)-maxRateLimitPassages))
+				break
+			}
+			location := passage.Source
+			if passage.Heading != "" {
+				location += " > " + passage.Heading
+			}
+			builder.WriteString(fmt.Sprintf("- **%s:** %s\n", location, passage.Text))
+		}
+	}
+
+	return builder.String()
+}
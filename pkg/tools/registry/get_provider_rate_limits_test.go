@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+)
+
+const rateLimitOverviewDoc = "# AWS Provider\n\n" +
+	"Use the Amazon Web Services (AWS) provider to interact with the many resources supported by AWS.\n\n" +
+	"```terraform\nprovider \"aws\" {\n  max_retries = 25 # retries on throttling\n}\n```\n\n" +
+	"## Argument Reference\n\n" +
+	"* `region` - (Optional) AWS region where the provider will operate.\n" +
+	"* `max_retries` - (Optional) Maximum number of times an API call is retried when AWS throttles requests. Defaults to 25.\n" +
+	"* `retry_mode` - (Optional) Specifies how retries are attempted.\n" +
+	"* `profile` - (Optional) AWS profile name as set in the shared configuration file.\n\n" +
+	"## Throttling\n\n" +
+	"AWS applies API rate limits per account and region.\n" +
+	"Large applies may receive 429 Too Many Requests errors.\n\n" +
+	"~> **Note:** Reduce `-parallelism` if throttling persists.\n"
+
+func TestGetProviderRateLimitsExtraction(t *testing.T) {
+	arguments := rateLimitArguments(utils.ParseDocArguments(rateLimitOverviewDoc))
+	var names []string
+	for _, argument := range arguments {
+		names = append(names, argument.Name)
+	}
+	if strings.Join(names, ",") != "max_retries,retry_mode" {
+		t.Fatalf("expected max_retries and retry_mode, got %v", names)
+	}
+
+	passages := extractRateLimitPassages("Overview", rateLimitOverviewDoc, true)
+	if len(passages) != 2 {
+		t.Fatalf("expected 2 passages, got %d: %+v", len(passages), passages)
+	}
+	if passages[0].Heading != "Throttling" || !strings.Contains(passages[0].Text, "API rate limits per account and region. Large applies") {
+		t.Errorf("expected the throttling paragraph joined into one passage, got %+v", passages[0])
+	}
+	if !strings.HasPrefix(passages[1].Text, "~> **Note:**") {
+		t.Errorf("expected the callout as second passage, got %+v", passages[1])
+	}
+
+	// Argument list items are kept when they are not reported as arguments
+	passages = extractRateLimitPassages("Guide", rateLimitOverviewDoc, false)
+	if len(passages) != 4 || !strings.HasPrefix(passages[0].Text, "* `max_retries`") {
+		t.Errorf("expected the max_retries item to be kept, got %+v", passages)
+	}
+}
+
+func TestSelectRateLimitGuides(t *testing.T) {
+	guide := func(title, slug string) client.ProviderDocData {
+		var doc client.ProviderDocData
+		doc.Attributes.Title = title
+		doc.Attributes.Slug = slug
+		return doc
+	}
+	selected := selectRateLimitGuides([]client.ProviderDocData{
+		guide("Version 5 Upgrade Guide", "version-5-upgrade"),
+		guide("Custom Service Endpoints", "custom-service-endpoints"),
+		guide("Resource Tagging", "resource-tagging"),
+		guide("Retry Behavior", "retries"),
+		guide("Best Practices", "best-practices"),
+	})
+	if len(selected) != 2 || selected[0].Attributes.Slug != "retries" || selected[1].Attributes.Slug != "best-practices" {
+		t.Errorf("unexpected guides selected: %+v", selected)
+	}
+}
+
+func TestFormatProviderRateLimits(t *testing.T) {
+	detail := client.ProviderDetail{ProviderNamespace: "hashicorp", ProviderName: "aws", ProviderVersion: "5.0.0"}
+
+	out := formatProviderRateLimits(detail, nil, nil, []string{"the provider overview"})
+	if !strings.Contains(out, "No rate-limit, throttling, quota or retry guidance is documented in the provider overview") {
+		t.Errorf("expected a no guidance message, got:\n%s", out)
+	}
+
+	arguments := []utils.DocArgument{{Name: "max_retries", Qualifier: "Optional", Description: "Retries | throttled calls"}}
+	passages := []rateLimitPassage{{Source: "Overview", Heading: "Throttling", Text: "AWS applies API rate limits."}}
+	out = formatProviderRateLimits(detail, arguments, passages, []string{"the provider overview", `the "Retry Behavior" guide`})
+	expected := []string{
+		"## Rate limits and retries for hashicorp/aws version 5.0.0",
+		`Searched the provider overview, the "Retry Behavior" guide.`,
+		"| `max_retries` | Optional | Retries \\| throttled calls |",
+		"- **Overview > Throttling:** AWS applies API rate limits.",
+	}
+	for _, want := range expected {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output, got:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// protocolTerraformVersions maps the major version of a plugin protocol to the first Terraform version that speaks it
+var protocolTerraformVersions = map[int]string{
+	4: "0.10.0",
+	5: "0.12.0",
+	6: "0.15.4",
+}
+
+// sourceAddressTerraformVersion is the first Terraform version that installs providers from namespaces other than
+// hashicorp, through the source addresses of required_providers
+const sourceAddressTerraformVersion = "0.13.0"
+
+// terraformRequirement is the Terraform version range a provider version can be used with
+type terraformRequirement struct {
+	Protocols []string `json:"protocols"`
+	// Minimum is empty when the registry gives nothing to derive it from
+	Minimum string `json:"minimum,omitempty"`
+	// Maximum is an exclusive bound, set when the provider only speaks protocols newer Terraform versions dropped
+	Maximum string   `json:"maximum,omitempty"`
+	Reasons []string `json:"reasons"`
+}
+
+// providerTerraformRequirementResult is the typed result of get_provider_terraform_requirement
+type providerTerraformRequirementResult struct {
+	Provider string `json:"provider"`
+	Version  string `json:"version"`
+	terraformRequirement
+}
+
+// GetProviderTerraformRequirement creates a tool to get the Terraform versions a provider version can be used with.
+func GetProviderTerraformRequirement(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_provider_terraform_requirement",
+			mcp.WithDescription(`Returns the minimum Terraform CLI version a provider version requires, as a version constraint for the required_version setting.
+The registry publishes no explicit Terraform constraint for providers, so it is derived from the plugin protocols the provider version supports and from its namespace. Use it before recommending a provider version for a configuration pinned to an older Terraform version.`),
+			mcp.WithTitleAnnotation("Get the Terraform version required by a provider version"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The provider version in the format 'x.y.z', defaults to 'latest'"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getProviderTerraformRequirementHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getProviderTerraformRequirementHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	providerName, err := request.RequireString("provider_name")
+	if err != nil || strings.TrimSpace(providerName) == "" {
+		return ToolArgumentError(logger, "provider_name", "is required")
+	}
+	providerName = strings.ToLower(strings.TrimSpace(providerName))
+	providerNamespace := providerNamespaceArgument(request)
+
+	providerVersion, err := utils.ResolveVersionInput(request.GetString("provider_version", "latest"))
+	if err != nil {
+		return ToolArgumentError(logger, "provider_version", "must be a version in the format 'x.y.z' or 'latest'")
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	if providerVersion == "latest" {
+		providerVersion, err = client.GetLatestProviderVersion(ctx, httpClient, providerNamespace, providerName, logger)
+		if err != nil {
+			return ToolErrorf(logger, "failed to get provider %s/%s: %v - verify the namespace and provider name are correct", providerNamespace, providerName, err)
+		}
+	}
+
+	response, err := client.SendRegistryCall(ctx, httpClient, "GET", path.Join("providers", providerNamespace, providerName, "versions"), logger, client.RegistryAPIVersions().Providers)
+	if err != nil {
+		return ToolErrorf(logger, "failed to get the versions of %s/%s: %v - verify the namespace and provider name are correct", providerNamespace, providerName, err)
+	}
+	var versions client.ProviderVersionProtocols
+	if err := client.DecodeRegistryResponse(response, &versions); err != nil {
+		return ToolError(logger, "failed to parse the provider versions", err)
+	}
+
+	target, err := utils.NormalizeVersion(providerVersion)
+	if err != nil {
+		return ToolArgumentError(logger, "provider_version", "must be a version in the format 'x.y.z' or 'latest'")
+	}
+	for _, published := range versions.Versions {
+		if normalized, err := utils.NormalizeVersion(published.Version); err == nil && normalized == target {
+			requirement := providerTerraformRequirement(providerNamespace, published.Protocols)
+			return utils.NewToolResultData(formatTerraformRequirement(providerNamespace, providerName, published.Version, requirement), providerTerraformRequirementResult{
+				Provider:             providerNamespace + "/" + providerName,
+				Version:              published.Version,
+				terraformRequirement: requirement,
+			}), nil
+		}
+	}
+	return ToolNotFoundErrorf(logger, "version %s of %s/%s not found - use get_latest_provider_version or resolve_version_constraint to find a published version", providerVersion, providerNamespace, providerName)
+}
+
+// providerTerraformRequirement derives the Terraform versions a provider version can be used with from its plugin
+// protocols and namespace
+func providerTerraformRequirement(namespace string, protocols []string) terraformRequirement {
+	requirement := terraformRequirement{Protocols: protocols}
+	lowest, highest := 0, 0
+	for _, protocol := range protocols {
+		major, err := strconv.Atoi(strings.SplitN(strings.TrimSpace(protocol), ".", 2)[0])
+		if err != nil || protocolTerraformVersions[major] == "" {
+			continue
+		}
+		if lowest == 0 || major < lowest {
+			lowest = major
+		}
+		highest = max(highest, major)
+	}
+	if lowest == 0 {
+		return requirement
+	}
+
+	requirement.Minimum = protocolTerraformVersions[lowest]
+	requirement.Reasons = append(requirement.Reasons, fmt.Sprintf("plugin protocol %d is supported from Terraform %s", lowest, requirement.Minimum))
+	if highest < 5 {
+		// Terraform 0.12 dropped protocol 4
+		requirement.Maximum = protocolTerraformVersions[5]
+		requirement.Reasons = append(requirement.Reasons, fmt.Sprintf("plugin protocol %d is not supported from Terraform %s", highest, requirement.Maximum))
+	}
+	if namespace != hashicorpNamespace {
+		if compare, err := utils.CompareVersions(requirement.Minimum, sourceAddressTerraformVersion); err == nil && compare < 0 {
+			requirement.Minimum = sourceAddressTerraformVersion
+		}
+		requirement.Reasons = append(requirement.Reasons, fmt.Sprintf("providers outside of the hashicorp namespace are installed through required_providers source addresses, supported from Terraform %s", sourceAddressTerraformVersion))
+	}
+	return requirement
+}
+
+func formatTerraformRequirement(namespace, name, version string, requirement terraformRequirement) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Provider: %s/%s %s\n", namespace, name, version)
+	protocols := "unspecified"
+	if len(requirement.Protocols) > 0 {
+		protocols = strings.Join(requirement.Protocols, ", ")
+	}
+	fmt.Fprintf(&builder, "Plugin protocols: %s\n", protocols)
+
+	if requirement.Minimum == "" {
+		builder.WriteString("Minimum Terraform version: unspecified - the registry publishes no Terraform version constraint or known plugin protocol for this version, check the provider documentation\n")
+		return builder.String()
+	}
+	constraint := ">= " + requirement.Minimum
+	if requirement.Maximum != "" {
+		constraint += ", < " + requirement.Maximum
+	}
+	fmt.Fprintf(&builder, "Minimum Terraform version: %s\n", requirement.Minimum)
+	fmt.Fprintf(&builder, "required_version constraint: \"%s\"\n", constraint)
+	builder.WriteString("\nThe registry publishes no explicit Terraform constraint for providers, this one is derived because:\n")
+	for _, reason := range requirement.Reasons {
+		fmt.Fprintf(&builder, "- %s\n", reason)
+	}
+	return builder.String()
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestProviderTerraformRequirement(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		protocols []string
+		minimum   string
+		maximum   string
+	}{
+		{name: "protocol 5", namespace: "hashicorp", protocols: []string{"5.0"}, minimum: "0.12.0"},
+		{name: "protocols 5 and 6", namespace: "hashicorp", protocols: []string{"6.0", "5.0"}, minimum: "0.12.0"},
+		{name: "protocol 6 only", namespace: "hashicorp", protocols: []string{"6.0"}, minimum: "0.15.4"},
+		{name: "protocol 4 only", namespace: "hashicorp", protocols: []string{"4.0"}, minimum: "0.10.0", maximum: "0.12.0"},
+		{name: "partner namespace", namespace: "datadog", protocols: []string{"5.0"}, minimum: "0.13.0"},
+		{name: "partner namespace with protocol 6", namespace: "datadog", protocols: []string{"6.0"}, minimum: "0.15.4"},
+		{name: "no protocols", namespace: "hashicorp"},
+		{name: "unknown protocol", namespace: "hashicorp", protocols: []string{"9.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requirement := providerTerraformRequirement(tt.namespace, tt.protocols)
+			if requirement.Minimum != tt.minimum {
+				t.Errorf("expected minimum %q, got %q", tt.minimum, requirement.Minimum)
+			}
+			if requirement.Maximum != tt.maximum {
+				t.Errorf("expected maximum %q, got %q", tt.maximum, requirement.Maximum)
+			}
+		})
+	}
+}
+
+func TestFormatTerraformRequirement(t *testing.T) {
+	output := formatTerraformRequirement("hashicorp", "legacy", "1.0.0", providerTerraformRequirement("hashicorp", []string{"4.0"}))
+	for _, expected := range []string{
+		"Provider: hashicorp/legacy 1.0.0",
+		"Plugin protocols: 4.0",
+		"Minimum Terraform version: 0.10.0",
+		`required_version constraint: ">= 0.10.0, < 0.12.0"`,
+		"plugin protocol 4 is not supported from Terraform 0.12.0",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, output)
+		}
+	}
+
+	output = formatTerraformRequirement("hashicorp", "example", "1.0.0", providerTerraformRequirement("hashicorp", nil))
+	if !strings.Contains(output, "Minimum Terraform version: unspecified") {
+		t.Errorf("expected an unspecified minimum, got:\n%s", output)
+	}
+}
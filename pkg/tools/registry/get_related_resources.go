@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetRelatedResources creates a tool that lists the resources and data sources cross-referenced by a resource's documentation.
+func GetRelatedResources(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_related_resources",
+			mcp.WithDescription(`Lists the resources and data sources of the same provider that are linked from the documentation of a Terraform resource or data source, e.g. 'aws_iam_role' linked from 'aws_instance'.
+These are the relationships the provider documentation itself highlights. Each entry includes its registry link and, when available, the provider_doc_id to use with 'get_provider_details'.`),
+			mcp.WithTitleAnnotation("Get the resources cross-referenced in the documentation of a Terraform resource"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The version of the Terraform provider in the format 'x.y.z', or 'latest' to get the latest version"),
+			),
+			mcp.WithString("resource_name",
+				mcp.Required(),
+				mcp.Description("The resource or data source name, with or without the provider prefix, e.g. 'aws_instance' or 'instance'"),
+			),
+			mcp.WithString("provider_document_type",
+				mcp.Description("Whether the name refers to a resource or a data source"),
+				mcp.Enum("resources", "data-sources"),
+				mcp.DefaultString("resources"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getRelatedResourcesHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getRelatedResourcesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	resourceName, err := request.RequireString("resource_name")
+	if err != nil || strings.TrimSpace(resourceName) == "" {
+		return ToolArgumentError(logger, "resource_name", "is required")
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve provider: %v", err)
+	}
+	if providerDetail.ProviderDocumentType != "data-sources" {
+		providerDetail.ProviderDocumentType = "resources"
+	}
+
+	providerDocs, err := getProviderDocsList(ctx, httpClient, providerDetail, logger)
+	if err != nil {
+		return ToolError(logger, "failed to list provider documentation", err)
+	}
+	doc, ok := findProviderDocBySlug(providerDocs, providerDetail.ProviderName, providerDetail.ProviderDocumentType, resourceName)
+	if !ok {
+		return ToolNotFoundErrorf(logger, "%s '%s' not found in provider %s/%s version %s - use list_provider_resources to find the correct name",
+			providerDetail.ProviderDocumentType, resourceName, providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion)
+	}
+	content, err := client.GetProviderResourceDocs(ctx, httpClient, doc.ID, logger)
+	if err != nil {
+		return ToolError(logger, "failed to fetch resource documentation", err)
+	}
+
+	references := utils.ExtractDocCrossReferences(content, providerDetail.ProviderName)
+	return utils.NewToolResultData(formatRelatedResources(doc, references, providerDocs, providerDetail)), nil
+}
+
+func formatRelatedResources(doc client.ProviderDoc, references []utils.DocReference, providerDocs client.ProviderDocs, providerDetail client.ProviderDetail) (string, relatedResources) {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# Resources referenced by %s\n\n", doc.Title))
+
+	related := relatedResources{ProviderDocID: doc.ID, Title: doc.Title, Related: []relatedResource{}}
+	for _, reference := range references {
+		// Skip links of the page to itself
+		if reference.Category == doc.Category && reference.Slug == doc.Slug {
+			continue
+		}
+
+		resource := relatedResource{
+			Name:     providerDetail.ProviderName + "_" + reference.Slug,
+			Category: reference.Category,
+			URL: fmt.Sprintf("https://registry.terraform.io/providers/%s/%s/%s/docs/%s/%s",
+				providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, reference.Category, reference.Slug),
+		}
+		builder.WriteString(fmt.Sprintf("- %s (%s): %s", resource.Name, resource.Category, resource.URL))
+		if relatedDoc, ok := findProviderDocBySlug(providerDocs, providerDetail.ProviderName, reference.Category, reference.Slug); ok {
+			resource.ProviderDocID = relatedDoc.ID
+			builder.WriteString(fmt.Sprintf(" (provider_doc_id: %s)", relatedDoc.ID))
+		} else {
+			builder.WriteString(" (not documented in this provider version)")
+		}
+		builder.WriteString("\n")
+		related.Related = append(related.Related, resource)
+	}
+
+	if len(related.Related) == 0 {
+		builder.WriteString("The documentation does not link to any other resources or data sources of this provider.\n")
+	}
+	return builder.String(), related
+}
+
+// relatedResources is the typed result of get_related_resources
+type relatedResources struct {
+	ProviderDocID string            `json:"provider_doc_id"`
+	Title         string            `json:"title"`
+	Related       []relatedResource `json:"related"`
+}
+
+type relatedResource struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	URL      string `json:"url"`
+	// ProviderDocID is empty when the resource is not documented in the provider version
+	ProviderDocID string `json:"provider_doc_id,omitempty"`
+}
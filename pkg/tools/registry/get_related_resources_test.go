@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+)
+
+func TestFormatRelatedResources(t *testing.T) {
+	doc := client.ProviderDoc{ID: "1", Title: "aws_instance", Slug: "instance", Category: "resources", Language: "hcl"}
+	providerDocs := client.ProviderDocs{
+		Docs: []client.ProviderDoc{
+			doc,
+			{ID: "2", Slug: "iam_role", Category: "resources", Language: "hcl"},
+			{ID: "3", Slug: "ami", Category: "data-sources", Language: "hcl"},
+		},
+	}
+	detail := client.ProviderDetail{ProviderNamespace: "hashicorp", ProviderName: "aws", ProviderVersion: "5.0.0"}
+	references := []utils.DocReference{
+		{Category: "resources", Slug: "instance"},
+		{Category: "resources", Slug: "iam_role"},
+		{Category: "data-sources", Slug: "ami"},
+		{Category: "resources", Slug: "removed_resource"},
+	}
+
+	out, _ := formatRelatedResources(doc, references, providerDocs, detail)
+	for _, expected := range []string{
+		"- aws_iam_role (resources): https://registry.terraform.io/providers/hashicorp/aws/5.0.0/docs/resources/iam_role (provider_doc_id: 2)\n",
+		"- aws_ami (data-sources): https://registry.terraform.io/providers/hashicorp/aws/5.0.0/docs/data-sources/ami (provider_doc_id: 3)\n",
+		"- aws_removed_resource (resources): https://registry.terraform.io/providers/hashicorp/aws/5.0.0/docs/resources/removed_resource (not documented in this provider version)\n",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, out)
+		}
+	}
+	if strings.Contains(out, "aws_instance (resources)") {
+		t.Errorf("self references should be skipped, got:\n%s", out)
+	}
+
+	out, _ = formatRelatedResources(doc, nil, providerDocs, detail)
+	if !strings.Contains(out, "does not link to any other resources") {
+		t.Errorf("expected a no-references message, got:\n%s", out)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// argumentDefaultRegex matches the default value documented in an argument description, e.g. "Defaults to `true`"
+var argumentDefaultRegex = regexp.MustCompile("(?i)\\bdefaults? (?:to|is|value is)\\s*:?\\s*(?:`([^`]*)`|\"([^\"]*)\"|([^\\s,;]*[^\\s,;.]))")
+
+// resourceArguments is the structured summary of the arguments of a resource or data source
+type resourceArguments struct {
+	Resource      string                 `json:"resource"`
+	Provider      string                 `json:"provider"`
+	ProviderDocID string                 `json:"provider_doc_id"`
+	Required      []resourceArgumentInfo `json:"required"`
+	Optional      []resourceArgumentInfo `json:"optional"`
+	// Unqualified are the arguments the documentation does not mark as required or optional
+	Unqualified []resourceArgumentInfo `json:"unqualified,omitempty"`
+}
+
+type resourceArgumentInfo struct {
+	Name        string `json:"name"`
+	Type        string `json:"type,omitempty"`
+	Default     string `json:"default,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// GetResourceArguments creates a tool that returns the required and optional arguments of a resource or data source.
+func GetResourceArguments(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_resource_arguments",
+			mcp.WithDescription(`Summarizes the top-level arguments of a Terraform resource or data source as structured data, split into the required and the optional arguments with their types and documented defaults, parsed from the argument reference of its documentation.
+Use it to write a resource block: set every required argument, then the optional ones the configuration needs. Read-only attributes are left out; use 'get_resource_nested_block' for the arguments of a nested block.`),
+			mcp.WithTitleAnnotation("Get the required and optional arguments of a Terraform resource"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The version of the Terraform provider in the format 'x.y.z', or 'latest' to get the latest version"),
+			),
+			mcp.WithString("resource_name",
+				mcp.Required(),
+				mcp.Description("The resource or data source name, with or without the provider prefix, e.g. 'aws_instance' or 'instance'"),
+			),
+			mcp.WithString("provider_document_type",
+				mcp.Description("Whether the name refers to a resource or a data source"),
+				mcp.Enum("resources", "data-sources"),
+				mcp.DefaultString("resources"),
+			),
+			withContentFallback(),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getResourceArgumentsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getResourceArgumentsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	resourceName, err := request.RequireString("resource_name")
+	if err != nil || strings.TrimSpace(resourceName) == "" {
+		return ToolArgumentError(logger, "resource_name", "is required")
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve provider: %v", err)
+	}
+	if providerDetail.ProviderDocumentType != "data-sources" {
+		providerDetail.ProviderDocumentType = "resources"
+	}
+
+	doc, content, err := getProviderDocContentBySlug(ctx, httpClient, providerDetail, resourceName, logger)
+	if err != nil {
+		return ToolError(logger, "failed to fetch resource documentation", err)
+	}
+
+	arguments := summarizeResourceArguments(utils.ParseDocArguments(content))
+	if len(arguments.Required)+len(arguments.Optional)+len(arguments.Unqualified) == 0 {
+		if request.GetBool("content_fallback", true) {
+			return utils.NewToolResultData(formatUnstructuredDoc(doc, content, "no argument reference could be extracted from this documentation page")), nil
+		}
+		return ToolNotFoundErrorf(logger, "no arguments are documented for %s - use get_provider_details with provider_doc_id %s to read the full documentation", doc.Title, doc.ID)
+	}
+	arguments.Resource = doc.Title
+	arguments.Provider = providerDetail.ProviderNamespace + "/" + providerDetail.ProviderName + " " + providerDetail.ProviderVersion
+	arguments.ProviderDocID = doc.ID
+
+	result, err := json.MarshalIndent(arguments, "", "  ")
+	if err != nil {
+		return ToolError(logger, "failed to marshal resource arguments", err)
+	}
+	return mcp.NewToolResultStructured(arguments, string(result)), nil
+}
+
+// summarizeResourceArguments splits the documented arguments by qualifier, in documentation order, leaving out the
+// read-only attributes
+func summarizeResourceArguments(documented []utils.DocArgument) resourceArguments {
+	arguments := resourceArguments{Required: []resourceArgumentInfo{}, Optional: []resourceArgumentInfo{}}
+	for _, argument := range documented {
+		info := resourceArgumentInfo{
+			Name:        argument.Name,
+			Type:        argument.Type,
+			Default:     documentedArgumentDefault(argument.Description),
+			Description: argument.Description,
+		}
+		switch argument.Qualifier {
+		case "Required":
+			info.Default = ""
+			arguments.Required = append(arguments.Required, info)
+		case "Optional":
+			arguments.Optional = append(arguments.Optional, info)
+		case "Read-Only":
+			// Attributes are exported by the resource, not set in its configuration
+		default:
+			arguments.Unqualified = append(arguments.Unqualified, info)
+		}
+	}
+	return arguments
+}
+
+// documentedArgumentDefault returns the default value stated in an argument description, if any
+func documentedArgumentDefault(description string) string {
+	match := argumentDefaultRegex.FindStringSubmatch(description)
+	if match == nil {
+		return ""
+	}
+	for _, value := range match[1:] {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
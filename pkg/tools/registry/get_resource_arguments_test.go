@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+)
+
+func TestSummarizeResourceArguments(t *testing.T) {
+	content := "# Resource: aws_s3_bucket\n\n" +
+		"## Argument Reference\n\n" +
+		"* `bucket` - (Required) Name of the bucket.\n" +
+		"* `force_destroy` - (Optional) Whether all objects should be deleted when the bucket is destroyed. Defaults to `false`.\n" +
+		"* `object_lock_enabled` - (Optional, Forces new resource) Whether the bucket has Object Lock enabled. Default is false.\n" +
+		"* `tags` - Map of tags to assign to the bucket.\n\n" +
+		"## Attribute Reference\n\n" +
+		"* `arn` - ARN of the bucket.\n"
+
+	arguments := summarizeResourceArguments(utils.ParseDocArguments(content))
+	if len(arguments.Required) != 1 || arguments.Required[0].Name != "bucket" {
+		t.Fatalf("expected bucket to be the only required argument, got %+v", arguments.Required)
+	}
+	if len(arguments.Optional) != 2 {
+		t.Fatalf("expected 2 optional arguments, got %+v", arguments.Optional)
+	}
+	if arguments.Optional[0].Name != "force_destroy" || arguments.Optional[0].Default != "false" {
+		t.Errorf("expected force_destroy to default to false, got %+v", arguments.Optional[0])
+	}
+	if arguments.Optional[1].Default != "false" {
+		t.Errorf("expected object_lock_enabled to default to false, got %+v", arguments.Optional[1])
+	}
+	if len(arguments.Unqualified) != 1 || arguments.Unqualified[0].Name != "tags" {
+		t.Errorf("expected tags to be unqualified, got %+v", arguments.Unqualified)
+	}
+}
+
+func TestDocumentedArgumentDefault(t *testing.T) {
+	for description, expected := range map[string]string{
+		"Whether to enable it. Defaults to `true`.":     "true",
+		"The storage class. Defaults to \"STANDARD\".":  "STANDARD",
+		"Number of retries, defaults to 3.":             "3",
+		"Number of retries, defaults to 0":              "0",
+		"Idle timeout in seconds. Default value is 60.": "60",
+		"Name of the bucket.":                           "",
+	} {
+		if actual := documentedArgumentDefault(description); actual != expected {
+			t.Errorf("documentedArgumentDefault(%q): expected %q, got %q", description, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetResourceExamples creates a tool that extracts the code examples from a resource or data source documentation page.
+func GetResourceExamples(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_resource_examples",
+			mcp.WithDescription(`Extracts the code examples from the documentation of a Terraform resource or data source.
+Each example is labelled with its language (hcl, shell, json or text) so it can be syntax highlighted. Use 'language' to only return e.g. the HCL configuration examples.`),
+			mcp.WithTitleAnnotation("Get the code examples of a Terraform resource"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The version of the Terraform provider in the format 'x.y.z', or 'latest' to get the latest version"),
+			),
+			mcp.WithString("resource_name",
+				mcp.Required(),
+				mcp.Description("The resource or data source name, with or without the provider prefix, e.g. 'aws_instance' or 'instance'"),
+			),
+			mcp.WithString("provider_document_type",
+				mcp.Description("Whether the name refers to a resource or a data source"),
+				mcp.Enum("resources", "data-sources"),
+				mcp.DefaultString("resources"),
+			),
+			mcp.WithString("language",
+				mcp.Description("Only return examples in this language, 'all' returns every example"),
+				mcp.Enum("all", utils.CodeLanguageHCL, utils.CodeLanguageShell, utils.CodeLanguageJSON),
+				mcp.DefaultString("all"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getResourceExamplesHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getResourceExamplesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	resourceName, err := request.RequireString("resource_name")
+	if err != nil || strings.TrimSpace(resourceName) == "" {
+		return ToolArgumentError(logger, "resource_name", "is required")
+	}
+
+	language := strings.ToLower(request.GetString("language", "all"))
+	switch language {
+	case "all", utils.CodeLanguageHCL, utils.CodeLanguageShell, utils.CodeLanguageJSON:
+	default:
+		return ToolArgumentError(logger, "language", fmt.Sprintf("must be one of all, hcl, shell, json, got '%s'", language))
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve provider: %v", err)
+	}
+	if providerDetail.ProviderDocumentType != "data-sources" {
+		providerDetail.ProviderDocumentType = "resources"
+	}
+
+	doc, content, err := getProviderDocContentBySlug(ctx, httpClient, providerDetail, resourceName, logger)
+	if err != nil {
+		return ToolError(logger, "failed to fetch resource documentation", err)
+	}
+
+	examples := utils.ExtractCodeBlocks(content)
+	if language != "all" {
+		examples = utils.FilterCodeBlocks(examples, language)
+	}
+	if len(examples) == 0 {
+		return ToolNotFoundErrorf(logger, "no %s examples found in the documentation of %s", strings.TrimPrefix(language+" ", "all "), doc.Title)
+	}
+
+	return utils.NewToolResultData(formatCodeExamples(doc.Title, examples), resourceExamples{
+		ProviderDocID: doc.ID,
+		Title:         doc.Title,
+		Examples:      examples,
+	}), nil
+}
+
+// resourceExamples is the typed result of get_resource_examples
+type resourceExamples struct {
+	ProviderDocID string            `json:"provider_doc_id"`
+	Title         string            `json:"title"`
+	Examples      []utils.CodeBlock `json:"examples"`
+}
+
+func formatCodeExamples(title string, examples []utils.CodeBlock) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# Examples for %s\n\n", title))
+	for i, example := range examples {
+		builder.WriteString(fmt.Sprintf("## Example %d (%s)\n\n", i+1, example.Language))
+		builder.WriteString(fmt.Sprintf("```%s\n%s\n```\n\n", example.Language, example.Code))
+	}
+	return builder.String()
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetResourceImportDocs creates a tool that returns the import instructions of a resource.
+func GetResourceImportDocs(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_resource_import_docs",
+			mcp.WithDescription(`Fetches only the import instructions from the documentation of a Terraform resource: the 'import' block and 'terraform import' syntax and the format of the import ID.
+Use it to adopt existing infrastructure into Terraform. If the documentation has no import section, the result states that the resource does not document import support.`),
+			mcp.WithTitleAnnotation("Get the import instructions of a Terraform resource"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The version of the Terraform provider in the format 'x.y.z', or 'latest' to get the latest version"),
+			),
+			mcp.WithString("resource_name",
+				mcp.Required(),
+				mcp.Description("The resource name, with or without the provider prefix, e.g. 'aws_instance' or 'instance'"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getResourceImportDocsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getResourceImportDocsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	resourceName, err := request.RequireString("resource_name")
+	if err != nil || strings.TrimSpace(resourceName) == "" {
+		return ToolArgumentError(logger, "resource_name", "is required")
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve provider: %v", err)
+	}
+	// Data sources only read infrastructure, so only resources can be imported
+	providerDetail.ProviderDocumentType = "resources"
+
+	doc, content, err := getProviderDocContentBySlug(ctx, httpClient, providerDetail, resourceName, logger)
+	if err != nil {
+		return ToolError(logger, "failed to fetch resource documentation", err)
+	}
+
+	return utils.NewToolResultData(formatResourceImportDocs(doc, providerDetail, content)), nil
+}
+
+// formatResourceImportDocs returns the "Import" section of a resource doc page, or a statement that the page does
+// not document import when it has none
+func formatResourceImportDocs(doc client.ProviderDoc, providerDetail client.ProviderDetail, content string) (string, resourceImportDocs) {
+	importDocs := resourceImportDocs{
+		Provider:      providerDetail.ProviderNamespace + "/" + providerDetail.ProviderName,
+		Version:       providerDetail.ProviderVersion,
+		ProviderDocID: doc.ID,
+		Title:         doc.Title,
+	}
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# Import of %s\n\n", doc.Title))
+	builder.WriteString(fmt.Sprintf("**Provider:** %s/%s %s\n\n", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion))
+	builder.WriteString(fmt.Sprintf("**providerDocID:** %s\n\n", doc.ID))
+
+	section, found := utils.ExtractDocSection(utils.CleanProviderDoc(content), "Import")
+	if !found || section == "" {
+		builder.WriteString(fmt.Sprintf("**Import supported:** no\n\nThe documentation of %s has no import section, so the resource does not document import support and most likely cannot be imported. Create it with Terraform instead, or check the provider changelog for newer versions adding import.\n", doc.Title))
+		return builder.String(), importDocs
+	}
+	importDocs.Supported, importDocs.Section = true, section
+	builder.WriteString("**Import supported:** yes\n\n")
+	builder.WriteString(section)
+	builder.WriteString("\n")
+	return builder.String(), importDocs
+}
+
+// resourceImportDocs is the typed result of get_resource_import_docs
+type resourceImportDocs struct {
+	Provider      string `json:"provider"`
+	Version       string `json:"version"`
+	ProviderDocID string `json:"provider_doc_id"`
+	Title         string `json:"title"`
+	Supported     bool   `json:"supported"`
+	// Section is the import section of the documentation, empty when import is not documented
+	Section string `json:"section,omitempty"`
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestFormatResourceImportDocs(t *testing.T) {
+	doc := client.ProviderDoc{ID: "8894603", Title: "aws_instance"}
+	providerDetail := client.ProviderDetail{ProviderNamespace: "hashicorp", ProviderName: "aws", ProviderVersion: "5.0.0"}
+
+	content := "---\nsubcategory: \"EC2\"\n---\n\n# Resource: aws_instance\n\n" +
+		"## Argument Reference\n\n* `ami` - (Required) AMI to use.\n\n" +
+		"## Import\n\n" +
+		"Using `terraform import`, import instances using the `id`. For example:\n\n" +
+		"```console\n% terraform import aws_instance.web i-12345678\n```\n"
+
+	output, importDocs := formatResourceImportDocs(doc, providerDetail, content)
+	if !importDocs.Supported || !strings.Contains(importDocs.Section, "terraform import aws_instance.web") {
+		t.Errorf("expected the import section in the typed result, got %+v", importDocs)
+	}
+	for _, expected := range []string{
+		"# Import of aws_instance",
+		"**Provider:** hashicorp/aws 5.0.0",
+		"**Import supported:** yes",
+		"% terraform import aws_instance.web i-12345678",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, output)
+		}
+	}
+	if strings.Contains(output, "Argument Reference") {
+		t.Errorf("expected only the import section, got:\n%s", output)
+	}
+
+	output, importDocs = formatResourceImportDocs(doc, providerDetail, "# Resource: aws_instance\n\n## Argument Reference\n\n* `ami` - (Required) AMI to use.\n")
+	if importDocs.Supported || !strings.Contains(output, "**Import supported:** no") || !strings.Contains(output, "does not document import support") {
+		t.Errorf("expected the missing import support to be stated, got:\n%s", output)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetResourceNestedBlock creates a tool that returns the sub-arguments of a nested block of a resource or data source.
+func GetResourceNestedBlock(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_resource_nested_block",
+			mcp.WithDescription(`Fetches the documentation of a single nested block of a Terraform resource or data source, e.g. 'root_block_device' of 'aws_instance'.
+Returns only the arguments of that block with their types and descriptions. If the block is not found, the available block names are returned.`),
+			mcp.WithTitleAnnotation("Get the arguments of a nested block of a Terraform resource"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The version of the Terraform provider in the format 'x.y.z', or 'latest' to get the latest version"),
+			),
+			mcp.WithString("resource_name",
+				mcp.Required(),
+				mcp.Description("The resource or data source name, with or without the provider prefix, e.g. 'aws_instance' or 'instance'"),
+			),
+			mcp.WithString("block_name",
+				mcp.Required(),
+				mcp.Description("The name of the nested block, e.g. 'root_block_device'"),
+			),
+			mcp.WithString("provider_document_type",
+				mcp.Description("Whether the name refers to a resource or a data source"),
+				mcp.Enum("resources", "data-sources"),
+				mcp.DefaultString("resources"),
+			),
+			withContentFallback(),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getResourceNestedBlockHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func getResourceNestedBlockHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	resourceName, err := request.RequireString("resource_name")
+	if err != nil || strings.TrimSpace(resourceName) == "" {
+		return ToolArgumentError(logger, "resource_name", "is required")
+	}
+	blockName, err := request.RequireString("block_name")
+	if err != nil || strings.TrimSpace(blockName) == "" {
+		return ToolArgumentError(logger, "block_name", "is required")
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve provider: %v", err)
+	}
+	if providerDetail.ProviderDocumentType != "data-sources" {
+		providerDetail.ProviderDocumentType = "resources"
+	}
+
+	doc, content, err := getProviderDocContentBySlug(ctx, httpClient, providerDetail, resourceName, logger)
+	if err != nil {
+		return ToolError(logger, "failed to fetch resource documentation", err)
+	}
+
+	blocks := utils.ParseDocNestedBlocks(content)
+	block, found := utils.FindDocBlock(blocks, blockName)
+	if !found {
+		if len(blocks) == 0 {
+			if request.GetBool("content_fallback", true) {
+				return utils.NewToolResultData(formatUnstructuredDoc(doc, content, "no nested blocks could be extracted from this documentation page")), nil
+			}
+			return ToolNotFoundErrorf(logger, "no nested blocks are documented for %s - use get_provider_details with provider_doc_id %s to read the full documentation", doc.Title, doc.ID)
+		}
+		names := make([]string, 0, len(blocks))
+		for _, b := range blocks {
+			names = append(names, b.Name)
+		}
+		return ToolNotFoundErrorf(logger, "nested block '%s' not found in %s. Available blocks: %s", blockName, doc.Title, strings.Join(names, ", "))
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# `%s` block of %s\n\n", block.Name, doc.Title))
+	builder.WriteString(fmt.Sprintf("**Provider:** %s/%s %s\n\n", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion))
+	builder.WriteString(fmt.Sprintf("**providerDocID:** %s\n\n", doc.ID))
+	builder.WriteString("| Argument | Type | Required | Description |\n")
+	builder.WriteString("|---|---|---|---|\n")
+	for _, argument := range block.Arguments {
+		builder.WriteString(fmt.Sprintf("| %s | %s | %s | %s |\n",
+			argument.Name,
+			valueOrDash(argument.Type),
+			valueOrDash(argument.Qualifier),
+			strings.ReplaceAll(argument.Description, "|", "\\|"),
+		))
+	}
+
+	return utils.NewToolResultData(builder.String(), resourceNestedBlock{
+		Provider:      providerDetail.ProviderNamespace + "/" + providerDetail.ProviderName,
+		Version:       providerDetail.ProviderVersion,
+		ProviderDocID: doc.ID,
+		Title:         doc.Title,
+		Block:         block,
+	}), nil
+}
+
+// resourceNestedBlock is the typed result of get_resource_nested_block
+type resourceNestedBlock struct {
+	Provider      string         `json:"provider"`
+	Version       string         `json:"version"`
+	ProviderDocID string         `json:"provider_doc_id"`
+	Title         string         `json:"title"`
+	Block         utils.DocBlock `json:"block"`
+}
+
+// getProviderDocContentBySlug finds a resource or data source document by name for the resolved provider version
+// and returns its metadata and markdown content.
+func getProviderDocContentBySlug(ctx context.Context, httpClient *http.Client, providerDetail client.ProviderDetail, name string, logger *log.Logger) (client.ProviderDoc, string, error) {
+	providerDocs, err := getProviderDocsList(ctx, httpClient, providerDetail, logger)
+	if err != nil {
+		return client.ProviderDoc{}, "", err
+	}
+
+	if doc, ok := findProviderDocBySlug(providerDocs, providerDetail.ProviderName, providerDetail.ProviderDocumentType, name); ok {
+		content, err := client.GetProviderResourceDocs(ctx, httpClient, doc.ID, logger)
+		if err != nil {
+			return client.ProviderDoc{}, "", err
+		}
+		return doc, content, nil
+	}
+
+	return client.ProviderDoc{}, "", fmt.Errorf("%s '%s' %w for provider %s/%s version %s - use search_providers to find the correct name",
+		providerDetail.ProviderDocumentType, name, client.ErrRegistryNotFound, providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion)
+}
+
+// findProviderDocBySlug looks up the HCL doc of a resource or data source by name, with or without the provider prefix
+func findProviderDocBySlug(providerDocs client.ProviderDocs, providerName string, category string, name string) (client.ProviderDoc, bool) {
+	slug := strings.ToLower(strings.TrimSpace(name))
+	slug = strings.TrimPrefix(slug, providerName+"_")
+	for _, doc := range providerDocs.Docs {
+		if doc.Language == "hcl" && doc.Category == category && doc.Slug == slug {
+			return doc, true
+		}
+	}
+	return client.ProviderDoc{}, false
+}
+
+// withContentFallback adds the content_fallback parameter to tools that extract structured data from a doc page
+func withContentFallback() mcp.ToolOption {
+	return mcp.WithBoolean("content_fallback",
+		mcp.Description("When the documentation has no structured argument reference, return the page content with a note instead of an error"),
+		mcp.DefaultBool(true),
+	)
+}
+
+// formatUnstructuredDoc returns the cleaned content of a doc page whose arguments could not be extracted, with a
+// note explaining why, so tools stay useful for docs of varying completeness
+func formatUnstructuredDoc(doc client.ProviderDoc, content string, reason string) (string, providerDocPage) {
+	// Split like get_provider_details does, so its page=2 starts where this page ends and the page counts agree
+	pages := utils.SplitDocPages(content, defaultProviderDocPageSize)
+	firstPage := utils.CleanProviderDoc(pages[0])
+	docPage := providerDocPageOf(doc, firstPage)
+	docPage.Page, docPage.Pages = 1, len(pages)
+	docPage.Note = "structured arguments could not be extracted: " + reason
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("> **Note:** Structured arguments could not be extracted from the documentation of %s: %s. The page content is returned below instead.\n", doc.Title, reason))
+	if len(pages) > 1 {
+		builder.WriteString(fmt.Sprintf("> Only the first of %d pages is shown - use get_provider_details with provider_doc_id %s and page=2 to continue.\n", len(pages), doc.ID))
+	}
+	builder.WriteString("\n")
+	builder.WriteString(firstPage)
+	return builder.String(), docPage
+}
+
+// providerDocPageOf returns the typed result for content of a document found in the documentation index
+func providerDocPageOf(doc client.ProviderDoc, content string) providerDocPage {
+	return providerDocPage{
+		ProviderDocID: doc.ID,
+		Title:         doc.Title,
+		Category:      doc.Category,
+		Slug:          doc.Slug,
+		Content:       content,
+	}
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
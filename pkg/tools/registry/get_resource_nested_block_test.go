@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// A minimal resource doc without an argument reference section
+const docWithoutArgumentReference = "---\n" +
+	"page_title: \"aws_example\"\n" +
+	"---\n\n" +
+	"# Resource: aws_example\n\n" +
+	"<!-- generated -->\n" +
+	"Manages an example. This resource has no configurable arguments.\n\n" +
+	"## Example Usage\n\n" +
+	"```terraform\nresource \"aws_example\" \"this\" {}\n```\n"
+
+func TestFormatUnstructuredDoc(t *testing.T) {
+	doc := client.ProviderDoc{ID: "42", Title: "aws_example"}
+
+	out, _ := formatUnstructuredDoc(doc, docWithoutArgumentReference, "no nested blocks could be extracted from this documentation page")
+	if !strings.HasPrefix(out, "> **Note:** Structured arguments could not be extracted from the documentation of aws_example: no nested blocks could be extracted") {
+		t.Errorf("expected the output to start with a note, got:\n%s", out)
+	}
+	if !strings.Contains(out, "Manages an example.") || !strings.Contains(out, "resource \"aws_example\" \"this\" {}") {
+		t.Errorf("expected the page content to be returned, got:\n%s", out)
+	}
+	if strings.Contains(out, "page_title") || strings.Contains(out, "<!--") {
+		t.Errorf("expected the content to be cleaned, got:\n%s", out)
+	}
+	if strings.Contains(out, "page=2") {
+		t.Errorf("a short page should not mention further pages, got:\n%s", out)
+	}
+
+	long := docWithoutArgumentReference + strings.Repeat("Some more text.\n", 3*defaultProviderDocPageSize/16)
+	out, docPage := formatUnstructuredDoc(doc, long, "reason")
+	if !strings.Contains(out, "provider_doc_id 42 and page=2") {
+		t.Errorf("expected a long page to point to the next page, got a %d character output", len(out))
+	}
+	if len(out) > defaultProviderDocPageSize+500 {
+		t.Errorf("expected only the first page to be returned, got %d characters", len(out))
+	}
+
+	// get_provider_details splits the same content with the same page size, so the reported total is its last page
+	pages := utils.SplitDocPages(long, defaultProviderDocPageSize)
+	if docPage.Page != 1 || docPage.Pages != len(pages) {
+		t.Fatalf("expected page 1 of %d, got page %d of %d", len(pages), docPage.Page, docPage.Pages)
+	}
+	if docPage.Content != utils.CleanProviderDoc(pages[0]) {
+		t.Errorf("expected the first page to be the first page of get_provider_details")
+	}
+	lastPage := pages[docPage.Pages-1]
+	if !strings.HasSuffix(long, lastPage) || strings.Join(pages, "") != long {
+		t.Errorf("expected the pages up to the last one to cover the whole document")
+	}
+}
+
+func TestGetProviderDocContentBySlugNotFound(t *testing.T) {
+	httpClient := &http.Client{Transport: policyFileTransport{
+		"/v1/providers/hashicorp/aws/5.0.0": `{"docs":[{"id":"1","slug":"instance","category":"resources","language":"hcl"}]}`,
+	}}
+	providerDetail := client.ProviderDetail{ProviderNamespace: "hashicorp", ProviderName: "aws", ProviderVersion: "5.0.0", ProviderDocumentType: "resources"}
+
+	_, _, err := getProviderDocContentBySlug(context.Background(), httpClient, providerDetail, "aws_unknown", log.New())
+	if err == nil || !strings.Contains(err.Error(), "resources 'aws_unknown' not found") {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+	if code := errorCodeOf(err); code != utils.ErrorCodeNotFound {
+		t.Errorf("expected %s, got %s", utils.ErrorCodeNotFound, code)
+	}
+}
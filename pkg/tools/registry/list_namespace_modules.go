@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// registryNameRegex matches a registry namespace or provider name
+var registryNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+
+// ListNamespaceModules creates a tool that lists the modules published by a namespace.
+func ListNamespaceModules(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_namespace_modules",
+			mcp.WithDescription(`Lists the modules published by a namespace (organization) of the Terraform registry, with their latest version and description, e.g. to discover the module catalog of a team.
+Results are paginated like 'search_modules': pass the next_offset of a page as current_offset to get the next one. The module_id values can be passed to 'get_module_details'.`),
+			mcp.WithTitleAnnotation("List the Terraform modules published by a namespace"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("namespace",
+				mcp.Required(),
+				mcp.Description("The namespace publishing the modules, e.g. 'terraform-aws-modules', 'Azure'"),
+			),
+			mcp.WithString("provider",
+				mcp.Description("Only list the modules for this provider, e.g. 'aws', 'azurerm'"),
+			),
+			mcp.WithNumber("current_offset",
+				mcp.Description("Current offset for pagination"),
+				mcp.Min(0),
+				mcp.DefaultNumber(0),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listNamespaceModulesHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func listNamespaceModulesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	namespace, err := request.RequireString("namespace")
+	if err != nil || strings.TrimSpace(namespace) == "" {
+		return ToolArgumentError(logger, "namespace", "is required")
+	}
+	namespace = strings.TrimSpace(namespace)
+	if !registryNameRegex.MatchString(namespace) {
+		return ToolArgumentError(logger, "namespace", fmt.Sprintf("'%s' is not a valid registry namespace", namespace))
+	}
+	provider := strings.ToLower(strings.TrimSpace(request.GetString("provider", "")))
+	if provider != "" && !registryNameRegex.MatchString(provider) {
+		return ToolArgumentError(logger, "provider", fmt.Sprintf("'%s' is not a valid provider name", provider))
+	}
+	currentOffset := request.GetInt("current_offset", 0)
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	query := url.Values{}
+	query.Set("offset", fmt.Sprint(currentOffset))
+	if provider != "" {
+		query.Set("provider", provider)
+	}
+	response, err := client.SendRegistryCall(ctx, httpClient, http.MethodGet, path.Join("modules", namespace)+"?"+query.Encode(), logger, client.RegistryAPIVersions().Modules)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list modules for namespace: %s: %v%s", namespace, err, notFoundHint(err, "verify the namespace is correct"))
+	}
+
+	var terraformModules client.TerraformModules
+	if err := client.DecodeRegistryResponse(response, &terraformModules); err != nil {
+		return ToolErrorf(logger, "failed to parse the modules of namespace: %s", namespace)
+	}
+	if len(terraformModules.Data) == 0 {
+		if provider != "" {
+			return ToolNotFoundErrorf(logger, "no modules found for namespace %s and provider %s", namespace, provider)
+		}
+		return ToolNotFoundErrorf(logger, "no modules found for namespace: %s - verify the namespace is correct", namespace)
+	}
+	return utils.NewToolResultData(formatNamespaceModules(namespace, provider, terraformModules), terraformModules), nil
+}
+
+// formatNamespaceModules lists a page of the modules of a namespace, in registry order
+func formatNamespaceModules(namespace string, provider string, terraformModules client.TerraformModules) string {
+	var builder strings.Builder
+	if provider != "" {
+		builder.WriteString(fmt.Sprintf("Terraform Modules published by %s for provider %s\n\n", namespace, provider))
+	} else {
+		builder.WriteString(fmt.Sprintf("Terraform Modules published by %s\n\n", namespace))
+	}
+	builder.WriteString("Each result includes:\n")
+	builder.WriteString("- module_id: The module ID (format: namespace/name/provider-name/module-version)\n")
+	builder.WriteString("- Name: The name of the module\n")
+	builder.WriteString("- Provider: The provider of the module\n")
+	builder.WriteString("- Version: The latest version of the module\n")
+	builder.WriteString("- Description: A short description of the module\n")
+	builder.WriteString("\n\n---\n\n")
+	for _, module := range terraformModules.Data {
+		builder.WriteString(fmt.Sprintf("- module_id: %s\n", module.ID))
+		builder.WriteString(fmt.Sprintf("- Name: %s\n", module.Name))
+		builder.WriteString(fmt.Sprintf("- Provider: %s\n", module.Provider))
+		builder.WriteString(fmt.Sprintf("- Version: %s\n", module.Version))
+		builder.WriteString(fmt.Sprintf("- Description: %s\n", module.Description))
+		builder.WriteString("---\n\n")
+	}
+	builder.WriteString(formatModuleSearchPagination(terraformModules))
+	return builder.String()
+}
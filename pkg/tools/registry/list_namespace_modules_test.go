@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestFormatNamespaceModules(t *testing.T) {
+	response := `{
+  "meta": {"limit": 2, "current_offset": 0, "next_offset": 2, "next_url": "/v1/modules/acme?offset=2&provider=aws"},
+  "modules": [
+    {"id": "acme/vpc/aws/1.2.0", "name": "vpc", "provider": "aws", "version": "1.2.0", "description": "VPC with sane defaults"},
+    {"id": "acme/eks/aws/0.3.1", "name": "eks", "provider": "aws", "version": "0.3.1", "description": "EKS cluster"}
+  ]
+}`
+	var modules client.TerraformModules
+	if err := json.Unmarshal([]byte(response), &modules); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := formatNamespaceModules("acme", "aws", modules)
+	for _, expected := range []string{
+		"Terraform Modules published by acme for provider aws",
+		"- module_id: acme/vpc/aws/1.2.0\n- Name: vpc\n- Provider: aws\n- Version: 1.2.0\n- Description: VPC with sane defaults\n",
+		"- module_id: acme/eks/aws/0.3.1",
+		"- truncated: true",
+		"- next_offset: 2",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, output)
+		}
+	}
+	if strings.Index(output, "acme/vpc/aws") > strings.Index(output, "acme/eks/aws") {
+		t.Errorf("expected the modules in registry order")
+	}
+
+	if output := formatNamespaceModules("acme", "", modules); !strings.HasPrefix(output, "Terraform Modules published by acme\n") {
+		t.Errorf("unexpected header without provider: %q", output[:40])
+	}
+}
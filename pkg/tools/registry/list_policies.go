@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// policySet is the structured list of the policies and policy modules of a policy set version
+type policySet struct {
+	TerraformPolicyID string        `json:"terraform_policy_id"`
+	Policies          []policyEntry `json:"policies"`
+	PolicyModules     []policyEntry `json:"policy_modules"`
+}
+
+type policyEntry struct {
+	Name   string `json:"name"`
+	Sha256 string `json:"sha256"`
+}
+
+// ListPolicies creates a tool that returns only the names and checksums of the policies of a policy set.
+func ListPolicies(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_policies",
+			mcp.WithDescription(`Lists the policies and policy modules of a policy set from the Terraform registry as structured data (name and sha256 checksum), without the README and usage instructions returned by 'get_policy_details'. You must call 'search_policies' first to obtain the exact terraform_policy_id required to use this tool.`),
+			mcp.WithTitleAnnotation("List the policies of a Terraform policy set"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_policy_id",
+				mcp.Required(),
+				mcp.Description("Matching terraform_policy_id retrieved from the 'search_policies' tool (e.g., 'policies/hashicorp/CIS-Policy-Set-for-AWS-Terraform/1.0.1')"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listPoliciesHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func listPoliciesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	terraformPolicyID, err := request.RequireString("terraform_policy_id")
+	if err != nil {
+		return ToolArgumentError(logger, "terraform_policy_id", "is required - use search_policies first to find valid policy IDs")
+	}
+	if terraformPolicyID == "" {
+		return ToolArgumentError(logger, "terraform_policy_id", "cannot be empty - use search_policies first to find valid policy IDs")
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	policyDetails, err := fetchPolicyDetails(ctx, httpClient, terraformPolicyID, logger)
+	if err != nil {
+		return ToolErrorf(logger, "%v", err)
+	}
+
+	policies := listPolicySet(terraformPolicyID, policyDetails)
+	result, err := json.MarshalIndent(policies, "", "  ")
+	if err != nil {
+		return ToolError(logger, "failed to marshal policy list", err)
+	}
+	return mcp.NewToolResultStructured(policies, string(result)), nil
+}
+
+// listPolicySet collects the policies and policy modules included in the policy set response, in registry order
+func listPolicySet(terraformPolicyID string, policyDetails client.TerraformPolicyDetails) policySet {
+	set := policySet{
+		TerraformPolicyID: terraformPolicyID,
+		Policies:          []policyEntry{},
+		PolicyModules:     []policyEntry{},
+	}
+	for _, included := range policyDetails.Included {
+		entry := policyEntry{Name: included.Attributes.Name, Sha256: included.Attributes.Shasum}
+		switch included.Type {
+		case "policies":
+			set.Policies = append(set.Policies, entry)
+		case "policy-modules":
+			set.PolicyModules = append(set.PolicyModules, entry)
+		}
+	}
+	return set
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestListPolicySet(t *testing.T) {
+	var details client.TerraformPolicyDetails
+	included := `{"included": [
+		{"type": "policy-modules", "attributes": {"name": "helpers", "shasum": "111"}},
+		{"type": "policies", "attributes": {"name": "s3-encryption", "shasum": "222"}},
+		{"type": "policy-library", "attributes": {"name": "library"}},
+		{"type": "policies", "attributes": {"name": "ec2-imdsv2", "shasum": "333"}}
+	]}`
+	if err := json.Unmarshal([]byte(included), &details); err != nil {
+		t.Fatalf("failed to unmarshal policy details: %v", err)
+	}
+
+	policyID := "policies/hashicorp/example/1.0.0"
+	expected := policySet{
+		TerraformPolicyID: policyID,
+		Policies: []policyEntry{
+			{Name: "s3-encryption", Sha256: "222"},
+			{Name: "ec2-imdsv2", Sha256: "333"},
+		},
+		PolicyModules: []policyEntry{{Name: "helpers", Sha256: "111"}},
+	}
+	if actual := listPolicySet(policyID, details); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+
+	// Empty lists marshal as [] rather than null
+	out, err := json.Marshal(listPolicySet(policyID, client.TerraformPolicyDetails{}))
+	if err != nil {
+		t.Fatalf("failed to marshal policy set: %v", err)
+	}
+	if want := `{"terraform_policy_id":"policies/hashicorp/example/1.0.0","policies":[],"policy_modules":[]}`; string(out) != want {
+		t.Errorf("expected %s, got %s", want, out)
+	}
+}
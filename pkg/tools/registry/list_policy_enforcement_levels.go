@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// policyEnforcementLevels are the enforcement levels a Sentinel policy block accepts
+var policyEnforcementLevels = map[string]bool{
+	"advisory":       true,
+	"soft-mandatory": true,
+	"hard-mandatory": true,
+}
+
+// policySetEnforcementLevels is the structured list of the enforcement levels of the policies of a policy set version
+type policySetEnforcementLevels struct {
+	TerraformPolicyID string                   `json:"terraform_policy_id"`
+	Policies          []policyEnforcementLevel `json:"policies"`
+}
+
+type policyEnforcementLevel struct {
+	Name             string `json:"name"`
+	EnforcementLevel string `json:"enforcement_level"`
+	// Recommended is false when the registry recommends no level and the default advisory level is used
+	Recommended bool `json:"recommended"`
+}
+
+// ListPolicyEnforcementLevels creates a tool that returns the recommended enforcement level of every policy of a policy set.
+func ListPolicyEnforcementLevels(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_policy_enforcement_levels",
+			mcp.WithDescription(`Lists the enforcement level (advisory, soft-mandatory or hard-mandatory) the Terraform registry recommends for each policy of a policy set as structured data. Policies without a recommended level are listed as advisory with 'recommended' false. Use the levels for the enforcement_level of the policy blocks of a policies.hcl file. You must call 'search_policies' first to obtain the exact terraform_policy_id required to use this tool.`),
+			mcp.WithTitleAnnotation("List the enforcement levels of the policies of a Terraform policy set"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_policy_id",
+				mcp.Required(),
+				mcp.Description("Matching terraform_policy_id retrieved from the 'search_policies' tool (e.g., 'policies/hashicorp/CIS-Policy-Set-for-AWS-Terraform/1.0.1')"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listPolicyEnforcementLevelsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func listPolicyEnforcementLevelsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	terraformPolicyID, err := request.RequireString("terraform_policy_id")
+	if err != nil {
+		return ToolArgumentError(logger, "terraform_policy_id", "is required - use search_policies first to find valid policy IDs")
+	}
+	if terraformPolicyID == "" {
+		return ToolArgumentError(logger, "terraform_policy_id", "cannot be empty - use search_policies first to find valid policy IDs")
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	policyDetails, err := fetchPolicyDetails(ctx, httpClient, terraformPolicyID, logger)
+	if err != nil {
+		return ToolErrorf(logger, "%v", err)
+	}
+
+	levels := listPolicyEnforcementLevels(terraformPolicyID, policyDetails)
+	result, err := json.MarshalIndent(levels, "", "  ")
+	if err != nil {
+		return ToolError(logger, "failed to marshal policy enforcement levels", err)
+	}
+	return mcp.NewToolResultStructured(levels, string(result)), nil
+}
+
+// listPolicyEnforcementLevels collects the enforcement level of the policies included in the policy set response, in
+// registry order
+func listPolicyEnforcementLevels(terraformPolicyID string, policyDetails client.TerraformPolicyDetails) policySetEnforcementLevels {
+	levels := policySetEnforcementLevels{TerraformPolicyID: terraformPolicyID, Policies: []policyEnforcementLevel{}}
+	for _, included := range policyDetails.Included {
+		if included.Type != "policies" {
+			continue
+		}
+		level, recommended := normalizePolicyEnforcementLevel(included.Attributes.EnforcementLevel)
+		levels.Policies = append(levels.Policies, policyEnforcementLevel{Name: included.Attributes.Name, EnforcementLevel: level, Recommended: recommended})
+	}
+	return levels
+}
+
+// normalizePolicyEnforcementLevel returns the enforcement level in the form a policy block accepts (e.g.
+// "Hard Mandatory" as "hard-mandatory"), and false with the default advisory level when the level is empty or unknown
+func normalizePolicyEnforcementLevel(raw string) (string, bool) {
+	level := strings.NewReplacer("_", "-", " ", "-").Replace(strings.ToLower(strings.TrimSpace(raw)))
+	if !policyEnforcementLevels[level] {
+		return defaultPolicyEnforcementLevel, false
+	}
+	return level, true
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestListPolicyEnforcementLevels(t *testing.T) {
+	var details client.TerraformPolicyDetails
+	included := `{"included": [
+		{"type": "policy-modules", "attributes": {"name": "helpers", "enforcement-level": "hard-mandatory"}},
+		{"type": "policies", "attributes": {"name": "s3-encryption", "enforcement-level": "hard-mandatory"}},
+		{"type": "policies", "attributes": {"name": "ec2-imdsv2", "enforcement-level": "Soft Mandatory"}},
+		{"type": "policies", "attributes": {"name": "tags"}},
+		{"type": "policies", "attributes": {"name": "unknown", "enforcement-level": "strict"}}
+	]}`
+	if err := json.Unmarshal([]byte(included), &details); err != nil {
+		t.Fatalf("failed to unmarshal policy details: %v", err)
+	}
+
+	policyID := "policies/hashicorp/example/1.0.0"
+	expected := policySetEnforcementLevels{
+		TerraformPolicyID: policyID,
+		Policies: []policyEnforcementLevel{
+			{Name: "s3-encryption", EnforcementLevel: "hard-mandatory", Recommended: true},
+			{Name: "ec2-imdsv2", EnforcementLevel: "soft-mandatory", Recommended: true},
+			{Name: "tags", EnforcementLevel: "advisory"},
+			{Name: "unknown", EnforcementLevel: "advisory"},
+		},
+	}
+	if actual := listPolicyEnforcementLevels(policyID, details); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
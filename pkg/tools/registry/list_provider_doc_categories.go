@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// providerDocCategories is the structured list of the doc categories of a provider version
+type providerDocCategories struct {
+	Provider   string                `json:"provider"`
+	Version    string                `json:"version"`
+	Categories []providerDocCategory `json:"categories"`
+}
+
+type providerDocCategory struct {
+	Category string `json:"category"`
+	Count    int    `json:"count"`
+}
+
+// ListProviderDocCategories creates a tool that returns the documentation categories of a provider version.
+func ListProviderDocCategories(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_provider_doc_categories",
+			mcp.WithDescription(`Lists the documentation categories a Terraform provider version actually has (resources, data-sources, functions, guides, overview, actions, list-resources, ephemeral-resources) with the number of documents in each, as structured data.
+Use it to learn the structure of a provider's documentation before listing or searching a category, e.g. to check whether it has functions or guides at all.`),
+			mcp.WithTitleAnnotation("List the documentation categories of a Terraform provider"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The version of the Terraform provider in the format 'x.y.z', or 'latest' to get the latest version"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listProviderDocCategoriesHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func listProviderDocCategoriesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve provider: %v", err)
+	}
+
+	providerDocs, err := getProviderDocsList(ctx, httpClient, providerDetail, logger)
+	if err != nil {
+		return ToolError(logger, "failed to list provider documentation", err)
+	}
+
+	categories := countProviderDocCategories(providerDocs, providerDetail)
+	result, err := json.MarshalIndent(categories, "", "  ")
+	if err != nil {
+		return ToolError(logger, "failed to marshal provider doc categories", err)
+	}
+	return mcp.NewToolResultStructured(categories, string(result)), nil
+}
+
+// countProviderDocCategories counts the HCL docs per category. Known categories come in the order of
+// utils.ProviderDocumentTypes, categories the server does not know yet follow alphabetically.
+func countProviderDocCategories(docs client.ProviderDocs, providerDetail client.ProviderDetail) providerDocCategories {
+	counts := make(map[string]int)
+	for _, doc := range docs.Docs {
+		if doc.Language == "hcl" {
+			counts[strings.ToLower(doc.Category)]++
+		}
+	}
+
+	categories := providerDocCategories{
+		Provider:   providerDetail.ProviderNamespace + "/" + providerDetail.ProviderName,
+		Version:    providerDetail.ProviderVersion,
+		Categories: []providerDocCategory{},
+	}
+	for _, category := range utils.ProviderDocumentTypes {
+		if counts[category] > 0 {
+			categories.Categories = append(categories.Categories, providerDocCategory{Category: category, Count: counts[category]})
+		}
+	}
+	var unknown []string
+	for category := range counts {
+		if !slices.Contains(utils.ProviderDocumentTypes, category) {
+			unknown = append(unknown, category)
+		}
+	}
+	sort.Strings(unknown)
+	for _, category := range unknown {
+		categories.Categories = append(categories.Categories, providerDocCategory{Category: category, Count: counts[category]})
+	}
+	return categories
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestCountProviderDocCategories(t *testing.T) {
+	docs := client.ProviderDocs{
+		Docs: []client.ProviderDoc{
+			{ID: "1", Category: "guides", Language: "hcl"},
+			{ID: "2", Category: "resources", Language: "hcl"},
+			{ID: "3", Category: "resources", Language: "hcl"},
+			{ID: "4", Category: "data-sources", Language: "hcl"},
+			{ID: "5", Category: "functions", Language: "hcl"},
+			{ID: "6", Category: "ephemeral-resources", Language: "hcl"},
+			{ID: "7", Category: "resources", Language: "python"},
+			{ID: "8", Category: "experimental", Language: "hcl"},
+		},
+	}
+	providerDetail := client.ProviderDetail{ProviderNamespace: "hashicorp", ProviderName: "aws", ProviderVersion: "6.0.0"}
+
+	categories := countProviderDocCategories(docs, providerDetail)
+	expected := providerDocCategories{
+		Provider: "hashicorp/aws",
+		Version:  "6.0.0",
+		Categories: []providerDocCategory{
+			{Category: "resources", Count: 2},
+			{Category: "data-sources", Count: 1},
+			{Category: "functions", Count: 1},
+			{Category: "guides", Count: 1},
+			{Category: "ephemeral-resources", Count: 1},
+			{Category: "experimental", Count: 1},
+		},
+	}
+	if !reflect.DeepEqual(categories, expected) {
+		t.Errorf("expected %+v, got %+v", expected, categories)
+	}
+}
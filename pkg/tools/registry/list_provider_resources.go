@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// listableDocCategories are the provider doc categories returned by list_provider_resources, in display order
+var listableDocCategories = []string{"resources", "data-sources"}
+
+// providerResourceList is the typed result of list_provider_resources
+type providerResourceList struct {
+	Provider  string             `json:"provider"`
+	Version   string             `json:"version"`
+	Filter    string             `json:"filter,omitempty"`
+	Resources []providerResource `json:"resources"`
+}
+
+type providerResource struct {
+	Name          string `json:"name"`
+	Category      string `json:"category"`
+	ProviderDocID string `json:"provider_doc_id"`
+}
+
+// ListProviderResources creates a tool that lists the resource and data source names of a provider version.
+func ListProviderResources(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_provider_resources",
+			mcp.WithDescription(`Lists the names of all resources and data sources of a Terraform provider version, grouped by category.
+Returns a compact list with the provider_doc_id of each entry instead of full documentation, so it is cheap to call before fetching individual pages with 'get_provider_details'.
+Use 'filter' to narrow the list to names containing a substring, e.g. 's3' or 'iam_role'.`),
+			mcp.WithTitleAnnotation("List the resources and data sources of a Terraform provider"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The version of the Terraform provider in the format 'x.y.z', or 'latest' to get the latest version"),
+			),
+			mcp.WithString("filter",
+				mcp.Description("Optional case-insensitive substring the resource or data source name must contain"),
+			),
+			mcp.WithString("provider_document_type",
+				mcp.Description("Restrict the list to resources or data sources, 'all' returns both"),
+				mcp.Enum("all", "resources", "data-sources"),
+				mcp.DefaultString("all"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listProviderResourcesHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func listProviderResourcesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	filter := strings.ToLower(strings.TrimSpace(request.GetString("filter", "")))
+
+	categories := listableDocCategories
+	switch documentType := strings.ToLower(request.GetString("provider_document_type", "all")); documentType {
+	case "all", "":
+	case "resources", "data-sources":
+		categories = []string{documentType}
+	default:
+		return ToolArgumentError(logger, "provider_document_type", fmt.Sprintf("must be one of all, resources, data-sources, got '%s'", documentType))
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve provider: %v", err)
+	}
+
+	providerDocs, err := getProviderDocsList(ctx, httpClient, providerDetail, logger)
+	if err != nil {
+		return ToolError(logger, "failed to list provider documentation", err)
+	}
+
+	output, resources := formatProviderResourceList(providerDocs, providerDetail, filter, categories)
+	if len(resources) == 0 {
+		if filter != "" {
+			return ToolNotFoundErrorf(logger, "no %s matching '%s' found in provider %s/%s version %s", strings.Join(categories, " or "), filter, providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion)
+		}
+		return ToolNotFoundErrorf(logger, "no %s found in provider %s/%s version %s", strings.Join(categories, " or "), providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion)
+	}
+
+	return utils.NewToolResultData(output, providerResourceList{
+		Provider:  providerDetail.ProviderNamespace + "/" + providerDetail.ProviderName,
+		Version:   providerDetail.ProviderVersion,
+		Filter:    filter,
+		Resources: resources,
+	}), nil
+}
+
+// getProviderDocsList returns the documentation index of a provider version from the v1 API
+func getProviderDocsList(ctx context.Context, httpClient *http.Client, providerDetail client.ProviderDetail, logger *log.Logger) (client.ProviderDocs, error) {
+	uri := path.Join("providers", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion)
+	response, err := client.SendRegistryCall(ctx, httpClient, http.MethodGet, uri, logger, client.RegistryAPIVersions().Providers)
+	if err != nil {
+		return client.ProviderDocs{}, fmt.Errorf("getting provider %s/%s version %s: %w", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, err)
+	}
+
+	var providerDocs client.ProviderDocs
+	if err := client.DecodeRegistryResponse(response, &providerDocs); err != nil {
+		return client.ProviderDocs{}, fmt.Errorf("unmarshalling provider docs: %w", err)
+	}
+	return providerDocs, nil
+}
+
+// providerResourceName returns the full type name of a resource or data source doc, e.g. "aws_instance"
+func providerResourceName(providerName string, doc client.ProviderDoc) string {
+	if strings.HasPrefix(doc.Slug, providerName+"_") {
+		return doc.Slug
+	}
+	return providerName + "_" + doc.Slug
+}
+
+// formatProviderResourceList renders the names of the docs in the given categories, returning the output and the listed entries
+func formatProviderResourceList(docs client.ProviderDocs, providerDetail client.ProviderDetail, filter string, categories []string) (string, []providerResource) {
+	grouped := make(map[string][]client.ProviderDoc)
+	for _, doc := range docs.Docs {
+		if doc.Language != "hcl" {
+			continue
+		}
+		name := providerResourceName(providerDetail.ProviderName, doc)
+		if filter != "" && !strings.Contains(name, filter) {
+			continue
+		}
+		grouped[doc.Category] = append(grouped[doc.Category], doc)
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# %s/%s %s\n\n", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion))
+	if filter != "" {
+		builder.WriteString(fmt.Sprintf("Filtered by: `%s`\n\n", filter))
+	}
+
+	var resources []providerResource
+	for _, category := range categories {
+		items := grouped[category]
+		if len(items) == 0 {
+			continue
+		}
+		sort.Slice(items, func(i, j int) bool { return items[i].Slug < items[j].Slug })
+
+		builder.WriteString(fmt.Sprintf("## %s (%d)\n\n", category, len(items)))
+		for _, item := range items {
+			name := providerResourceName(providerDetail.ProviderName, item)
+			builder.WriteString(fmt.Sprintf("- %s (provider_doc_id: %s)\n", name, item.ID))
+			resources = append(resources, providerResource{Name: name, Category: category, ProviderDocID: item.ID})
+		}
+		builder.WriteString("\n")
+	}
+
+	return builder.String(), resources
+}
@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestFormatProviderResourceList(t *testing.T) {
+	docs := client.ProviderDocs{
+		Docs: []client.ProviderDoc{
+			{ID: "3", Slug: "s3_bucket", Category: "resources", Language: "hcl"},
+			{ID: "1", Slug: "instance", Category: "resources", Language: "hcl"},
+			{ID: "2", Slug: "ami", Category: "data-sources", Language: "hcl"},
+			{ID: "4", Slug: "s3_bucket", Category: "data-sources", Language: "hcl"},
+			{ID: "5", Slug: "arn", Category: "functions", Language: "hcl"},
+			{ID: "6", Slug: "instance", Category: "resources", Language: "python"},
+		},
+	}
+	detail := client.ProviderDetail{ProviderNamespace: "hashicorp", ProviderName: "aws", ProviderVersion: "5.0.0"}
+
+	out, resources := formatProviderResourceList(docs, detail, "", listableDocCategories)
+	if len(resources) != 4 {
+		t.Fatalf("expected 4 entries, got %d:\n%s", len(resources), out)
+	}
+	if resources[0] != (providerResource{Name: "aws_instance", Category: "resources", ProviderDocID: "1"}) {
+		t.Errorf("expected aws_instance first, got %+v", resources[0])
+	}
+	if !strings.Contains(out, "## resources (2)\n\n- aws_instance (provider_doc_id: 1)\n- aws_s3_bucket (provider_doc_id: 3)") {
+		t.Errorf("expected sorted resources section, got:\n%s", out)
+	}
+	if !strings.Contains(out, "## data-sources (2)") {
+		t.Errorf("expected data sources section, got:\n%s", out)
+	}
+	if strings.Contains(out, "aws_arn") {
+		t.Errorf("functions should not be listed, got:\n%s", out)
+	}
+
+	out, resources = formatProviderResourceList(docs, detail, "s3", []string{"data-sources"})
+	if len(resources) != 1 || !strings.Contains(out, "aws_s3_bucket (provider_doc_id: 4)") {
+		t.Errorf("expected only the s3 data source, got %d:\n%s", len(resources), out)
+	}
+
+	_, resources = formatProviderResourceList(docs, detail, "does_not_exist", listableDocCategories)
+	if len(resources) != 0 {
+		t.Errorf("expected no entries for unmatched filter, got %d", len(resources))
+	}
+}
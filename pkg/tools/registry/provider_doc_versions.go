@@ -0,0 +1,212 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// defaultDocMergeMaxVersions is the number of versions a merged doc is built from unless TERRAFORM_DOC_MERGE_MAX_VERSIONS is set
+	defaultDocMergeMaxVersions = 6
+	// maxCachedDocFieldVersions bounds the in-memory cache of parsed doc fields
+	maxCachedDocFieldVersions = 512
+)
+
+// docFieldsCache holds the parsed fields of doc pages per provider version. The docs of a published version do not
+// change, so entries never expire.
+var docFieldsCache = struct {
+	sync.Mutex
+	entries map[string]utils.VersionedDocFields
+}{entries: make(map[string]utils.VersionedDocFields)}
+
+// docMergeMaxVersions returns the maximum number of versions to fetch for a merged doc
+func docMergeMaxVersions(logger *log.Logger) int {
+	value := strings.TrimSpace(utils.GetEnv("TERRAFORM_DOC_MERGE_MAX_VERSIONS", ""))
+	if value == "" {
+		return defaultDocMergeMaxVersions
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 2 {
+		logger.Warnf("Invalid TERRAFORM_DOC_MERGE_MAX_VERSIONS value %q, using %d", value, defaultDocMergeMaxVersions)
+		return defaultDocMergeMaxVersions
+	}
+	return limit
+}
+
+// sampleVersions returns at most limit versions, always keeping the first and the last and spreading the rest evenly
+func sampleVersions(versions []string, limit int) []string {
+	if len(versions) <= limit || limit < 2 {
+		return versions
+	}
+	sampled := make([]string, 0, limit)
+	for i := 0; i < limit; i++ {
+		sampled = append(sampled, versions[i*(len(versions)-1)/(limit-1)])
+	}
+	return sampled
+}
+
+// getVersionedDocFields returns the documented fields of a resource or data source in the version of providerDetail
+func getVersionedDocFields(ctx context.Context, httpClient *http.Client, providerDetail client.ProviderDetail, slug string, logger *log.Logger) (utils.VersionedDocFields, error) {
+	key := strings.Join([]string{providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, providerDetail.ProviderDocumentType, slug}, "/")
+	docFieldsCache.Lock()
+	cached, ok := docFieldsCache.entries[key]
+	docFieldsCache.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	fields := utils.VersionedDocFields{Version: providerDetail.ProviderVersion}
+	providerDocs, err := getProviderDocsList(ctx, httpClient, providerDetail, logger)
+	if err != nil {
+		return fields, err
+	}
+	if doc, ok := findProviderDocBySlug(providerDocs, providerDetail.ProviderName, providerDetail.ProviderDocumentType, slug); ok {
+		content, err := client.GetProviderResourceDocs(ctx, httpClient, doc.ID, logger)
+		if err != nil {
+			return fields, err
+		}
+		fields.Present = true
+		fields.Fields = utils.DocFields(content)
+	}
+
+	docFieldsCache.Lock()
+	if len(docFieldsCache.entries) >= maxCachedDocFieldVersions {
+		docFieldsCache.entries = make(map[string]utils.VersionedDocFields)
+	}
+	docFieldsCache.entries[key] = fields
+	docFieldsCache.Unlock()
+	return fields, nil
+}
+
+// getMergedProviderDocs fetches the fields of a doc page in the versions between from and to and merges them
+func getMergedProviderDocs(ctx context.Context, httpClient *http.Client, providerDetail client.ProviderDetail, slug string, fromVersion string, toVersion string, logger *log.Logger) ([]utils.VersionedDocFields, int, error) {
+	fromDetail, err := withComparedVersion(ctx, httpClient, providerDetail, fromVersion, logger)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid from_version: %w", err)
+	}
+	toDetail, err := withComparedVersion(ctx, httpClient, providerDetail, toVersion, logger)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid to_version: %w", err)
+	}
+
+	versions, err := client.GetProviderVersionsInRange(ctx, httpClient, providerDetail.ProviderNamespace, providerDetail.ProviderName, fromDetail.ProviderVersion, toDetail.ProviderVersion, logger)
+	if err != nil {
+		return nil, 0, err
+	}
+	sampled := sampleVersions(versions, docMergeMaxVersions(logger))
+
+	results := client.ParallelRegistryCalls(sampled, 0, func(version string) (utils.VersionedDocFields, error) {
+		versionDetail := providerDetail
+		versionDetail.ProviderVersion = version
+		return getVersionedDocFields(ctx, httpClient, versionDetail, slug, logger)
+	})
+	versionedFields := make([]utils.VersionedDocFields, 0, len(results))
+	for i, result := range results {
+		if result.Err != nil {
+			return nil, 0, fmt.Errorf("fetching documentation for version %s: %w", sampled[i], result.Err)
+		}
+		versionedFields = append(versionedFields, result.Value)
+	}
+	return versionedFields, len(versions), nil
+}
+
+// mergedProviderDoc is the typed result of get_provider_details with from_version
+type mergedProviderDoc struct {
+	ProviderDocID string   `json:"provider_doc_id"`
+	Title         string   `json:"title"`
+	Versions      []string `json:"versions"`
+	// TotalVersions is the number of versions in the range, more than Versions when they were sampled
+	TotalVersions int                    `json:"total_versions"`
+	Undocumented  []string               `json:"undocumented_in,omitempty"`
+	Fields        []utils.MergedDocField `json:"fields"`
+}
+
+func newMergedProviderDoc(details client.ProviderResourceDetails, versions []utils.VersionedDocFields, totalVersions int) mergedProviderDoc {
+	merged := mergedProviderDoc{
+		ProviderDocID: details.Data.ID,
+		Title:         details.Data.Attributes.Title,
+		TotalVersions: totalVersions,
+		Fields:        utils.MergeDocFieldVersions(versions),
+	}
+	for _, version := range versions {
+		merged.Versions = append(merged.Versions, version.Version)
+		if !version.Present {
+			merged.Undocumented = append(merged.Undocumented, version.Version)
+		}
+	}
+	return merged
+}
+
+// formatMergedProviderDocs renders the fields of a doc page merged across versions, version-specific fields first
+func formatMergedProviderDocs(title string, versions []utils.VersionedDocFields, totalVersions int) string {
+	var builder strings.Builder
+	first, last := versions[0].Version, versions[len(versions)-1].Version
+	builder.WriteString(fmt.Sprintf("# %s: versions %s to %s\n\n", title, first, last))
+
+	var compared, missing []string
+	for _, version := range versions {
+		compared = append(compared, version.Version)
+		if !version.Present {
+			missing = append(missing, version.Version)
+		}
+	}
+	builder.WriteString(fmt.Sprintf("Compared versions: %s", strings.Join(compared, ", ")))
+	if totalVersions > len(versions) {
+		builder.WriteString(fmt.Sprintf(" (sampled from %d versions in the range, so a change is reported at the first sampled version that shows it)", totalVersions))
+	}
+	builder.WriteString("\n")
+	if len(missing) > 0 {
+		builder.WriteString(fmt.Sprintf("Not documented in: %s\n", strings.Join(missing, ", ")))
+	}
+	builder.WriteString("\n")
+
+	var specific, stable []utils.MergedDocField
+	for _, field := range utils.MergeDocFieldVersions(versions) {
+		if field.VersionSpecific() {
+			specific = append(specific, field)
+		} else {
+			stable = append(stable, field)
+		}
+	}
+
+	if len(specific) > 0 {
+		builder.WriteString(fmt.Sprintf("## Version-specific fields (%d)\n\n", len(specific)))
+		for _, field := range specific {
+			var notes []string
+			if field.AddedIn != "" {
+				notes = append(notes, "added in "+field.AddedIn)
+			}
+			if field.DeprecatedIn != "" {
+				notes = append(notes, "deprecated in "+field.DeprecatedIn)
+			}
+			if field.RemovedIn != "" {
+				notes = append(notes, "removed in "+field.RemovedIn)
+			}
+			builder.WriteString(fmt.Sprintf("- %s [%s]\n", formatDocField(field.Path, field.Field), strings.Join(notes, "; ")))
+		}
+		builder.WriteString("\n")
+	}
+
+	if len(specific) == 0 && len(stable) == 0 {
+		builder.WriteString("No arguments or attributes could be extracted from the documentation of these versions, use get_provider_details without from_version to read the page content.\n")
+	}
+
+	if len(stable) > 0 {
+		builder.WriteString(fmt.Sprintf("## Fields available in all compared versions (%d)\n\n", len(stable)))
+		for _, field := range stable {
+			builder.WriteString(fmt.Sprintf("- %s\n", formatDocField(field.Path, field.Field)))
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+)
+
+func TestSampleVersions(t *testing.T) {
+	versions := []string{"1.0.0", "1.1.0", "1.2.0", "1.3.0", "1.4.0", "1.5.0", "1.6.0"}
+
+	if got := sampleVersions(versions, 10); !reflect.DeepEqual(got, versions) {
+		t.Errorf("expected all versions when under the limit, got %v", got)
+	}
+	expected := []string{"1.0.0", "1.3.0", "1.6.0"}
+	if got := sampleVersions(versions, 3); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestFormatMergedProviderDocs(t *testing.T) {
+	versions := []utils.VersionedDocFields{
+		{Version: "5.0.0", Present: true, Fields: map[string]utils.DocArgument{
+			"name":   {Name: "name", Qualifier: "Required", Description: "The name."},
+			"legacy": {Name: "legacy", Qualifier: "Optional"},
+		}},
+		{Version: "5.10.0", Present: true, Fields: map[string]utils.DocArgument{
+			"name": {Name: "name", Qualifier: "Required", Description: "The name."},
+			"tags": {Name: "tags", Qualifier: "Optional"},
+		}},
+	}
+
+	out := formatMergedProviderDocs("aws_example", versions, 11)
+	expected := []string{
+		"# aws_example: versions 5.0.0 to 5.10.0",
+		"Compared versions: 5.0.0, 5.10.0 (sampled from 11 versions",
+		"## Version-specific fields (2)\n\n- legacy (Optional) [removed in 5.10.0]\n- tags (Optional) [added in 5.10.0]",
+		"## Fields available in all compared versions (1)\n\n- name (Required): The name.",
+	}
+	for _, want := range expected {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output, got:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
+)
+
+// hashicorpNamespace is the namespace providers are looked up in when neither the call nor DEFAULT_PROVIDER_NAMESPACE
+// names one
+const hashicorpNamespace = "hashicorp"
+
+// DefaultProviderNamespace returns the namespace of providers looked up without a provider_namespace argument:
+// DEFAULT_PROVIDER_NAMESPACE when it is set to a valid namespace, 'hashicorp' otherwise
+func DefaultProviderNamespace() string {
+	namespace := strings.ToLower(strings.TrimSpace(utils.GetEnv("DEFAULT_PROVIDER_NAMESPACE", "")))
+	if namespace == "" || !registryNameRegex.MatchString(namespace) {
+		return hashicorpNamespace
+	}
+	return namespace
+}
+
+// CheckDefaultProviderNamespace logs the configured DEFAULT_PROVIDER_NAMESPACE at startup, warning when it is not a
+// valid registry namespace and therefore ignored
+func CheckDefaultProviderNamespace(logger *log.Logger) {
+	value := strings.TrimSpace(utils.GetEnv("DEFAULT_PROVIDER_NAMESPACE", ""))
+	if value == "" {
+		return
+	}
+	if !registryNameRegex.MatchString(value) {
+		logger.Warnf("Invalid DEFAULT_PROVIDER_NAMESPACE value %q, using default %q", value, hashicorpNamespace)
+		return
+	}
+	logger.Infof("Providers are looked up in the %q namespace when no provider_namespace is given", DefaultProviderNamespace())
+}
+
+// providerNamespaceArgument returns the provider_namespace argument of a call, lowercased, or the default provider
+// namespace when it is omitted or empty. An explicit namespace always takes precedence over the default.
+func providerNamespaceArgument(request mcp.CallToolRequest) string {
+	namespace := strings.ToLower(strings.TrimSpace(request.GetString("provider_namespace", "")))
+	if namespace == "" {
+		return DefaultProviderNamespace()
+	}
+	return namespace
+}
+
+// providerNamespaceDescription is the description of the provider_namespace argument of the tools looking up a
+// provider by name
+func providerNamespaceDescription() string {
+	return "The publisher of the Terraform provider, defaults to '" + DefaultProviderNamespace() + "'"
+}
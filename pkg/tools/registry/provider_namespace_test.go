@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestProviderNamespaceArgument(t *testing.T) {
+	tests := []struct {
+		name      string
+		env       string
+		namespace any
+		expected  string
+	}{
+		{name: "hashicorp without a default", expected: "hashicorp"},
+		{name: "configured default", env: "Integrations", expected: "integrations"},
+		{name: "explicit namespace overrides the default", env: "integrations", namespace: "DataDog", expected: "datadog"},
+		{name: "empty namespace uses the default", env: "integrations", namespace: " ", expected: "integrations"},
+		{name: "invalid default is ignored", env: "not/valid", expected: "hashicorp"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("DEFAULT_PROVIDER_NAMESPACE", tt.env)
+			request := mcp.CallToolRequest{}
+			arguments := map[string]any{"provider_name": "aws"}
+			if tt.namespace != nil {
+				arguments["provider_namespace"] = tt.namespace
+			}
+			request.Params.Arguments = arguments
+
+			if got := providerNamespaceArgument(request); got != tt.expected {
+				t.Errorf("providerNamespaceArgument() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
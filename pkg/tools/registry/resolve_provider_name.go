@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxProviderNameCandidates bounds the candidates returned for a name that does not resolve to a single provider
+const maxProviderNameCandidates = 5
+
+// providerNameAliases maps the common names of providers to their namespace/name in the registry
+var providerNameAliases = map[string]string{
+	"amazon":               "hashicorp/aws",
+	"amazon web services":  "hashicorp/aws",
+	"gcp":                  "hashicorp/google",
+	"google cloud":         "hashicorp/google",
+	"google-cloud":         "hashicorp/google",
+	"azure":                "hashicorp/azurerm",
+	"microsoft azure":      "hashicorp/azurerm",
+	"azure ad":             "hashicorp/azuread",
+	"entra":                "hashicorp/azuread",
+	"entra id":             "hashicorp/azuread",
+	"k8s":                  "hashicorp/kubernetes",
+	"hcp terraform":        "hashicorp/tfe",
+	"terraform cloud":      "hashicorp/tfe",
+	"terraform enterprise": "hashicorp/tfe",
+	"github":               "integrations/github",
+	"gitlab":               "gitlabhq/gitlab",
+	"oracle":               "oracle/oci",
+	"oracle cloud":         "oracle/oci",
+	"oci":                  "oracle/oci",
+	"cloudflare":           "cloudflare/cloudflare",
+	"datadog":              "datadog/datadog",
+	"digitalocean":         "digitalocean/digitalocean",
+	"digital ocean":        "digitalocean/digitalocean",
+	"alibaba":              "aliyun/alicloud",
+	"alicloud":             "aliyun/alicloud",
+	"ibm cloud":            "ibm-cloud/ibm",
+	"vsphere":              "hashicorp/vsphere",
+	"vmware":               "hashicorp/vsphere",
+}
+
+// providerNameResolution is the outcome of resolving a provider name
+type providerNameResolution struct {
+	Query string `json:"query"`
+	// ProviderID is the namespace/name other tools expect, empty when the name is ambiguous or unknown
+	ProviderID    string `json:"provider_id,omitempty"`
+	Namespace     string `json:"namespace,omitempty"`
+	Name          string `json:"name,omitempty"`
+	LatestVersion string `json:"latest_version,omitempty"`
+	// ResolvedBy is how the provider was found: "exact", "alias", "namespace" or "search"
+	ResolvedBy string `json:"resolved_by,omitempty"`
+	// Candidates are the providers matching an ambiguous name, best match first
+	Candidates []providerNameCandidate `json:"candidates,omitempty"`
+}
+
+type providerNameCandidate struct {
+	ProviderID  string `json:"provider_id"`
+	Tier        string `json:"tier,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// ResolveProviderName creates a tool that maps a common provider name to its canonical namespace/name.
+func ResolveProviderName(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("resolve_provider_name",
+			mcp.WithDescription(`Resolves the name of a Terraform provider as users say it, e.g. 'google', 'gcp', 'azure' or 'github', to the canonical 'namespace/name' that the provider tools expect (e.g. 'hashicorp/google', 'hashicorp/azurerm', 'integrations/github'), together with its latest version.
+Use it before calling a provider tool when the namespace is not known. When the name matches several providers, the candidates are returned instead so the right one can be picked.`),
+			mcp.WithTitleAnnotation("Resolve a Terraform provider name to its namespace and name"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("The provider name as given by the user, e.g. 'aws', 'gcp', 'microsoft azure' or 'hashicorp/google'"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return resolveProviderNameHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func resolveProviderNameHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	name, err := request.RequireString("name")
+	query := normalizeProviderName(name)
+	if err != nil || query == "" {
+		return ToolArgumentError(logger, "name", "is required")
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	resolution, err := resolveProviderName(ctx, httpClient, query, logger)
+	if err != nil {
+		return ToolError(logger, fmt.Sprintf("failed to resolve provider name %s", query), err)
+	}
+	if resolution.ProviderID == "" && len(resolution.Candidates) == 0 {
+		return ToolNotFoundErrorf(logger, "no provider found for '%s' - use search_registry with a description of what to provision", query)
+	}
+
+	result, err := json.MarshalIndent(resolution, "", "  ")
+	if err != nil {
+		return ToolError(logger, "failed to marshal provider name resolution", err)
+	}
+	return mcp.NewToolResultStructured(resolution, string(result)), nil
+}
+
+// normalizeProviderName lowercases a provider name and drops the registry host and the terraform-provider- prefix of
+// source addresses and repository names
+func normalizeProviderName(raw string) string {
+	name := strings.ToLower(strings.TrimSpace(raw))
+	name = strings.TrimPrefix(name, "registry.terraform.io/")
+	if namespace, providerName, found := strings.Cut(name, "/"); found {
+		return namespace + "/" + strings.TrimPrefix(providerName, "terraform-provider-")
+	}
+	return strings.Join(strings.Fields(strings.TrimPrefix(name, "terraform-provider-")), " ")
+}
+
+// resolveProviderName looks the name up as a namespace/name, an alias, a provider of the default or hashicorp
+// namespace, and finally among the official and partner providers
+func resolveProviderName(ctx context.Context, httpClient *http.Client, query string, logger *log.Logger) (providerNameResolution, error) {
+	resolution := providerNameResolution{Query: query}
+	var attempts []struct{ id, by string }
+	if strings.Contains(query, "/") {
+		attempts = append(attempts, struct{ id, by string }{query, "exact"})
+	} else {
+		if alias, ok := providerNameAliases[query]; ok {
+			attempts = append(attempts, struct{ id, by string }{alias, "alias"})
+		}
+		if registryNameRegex.MatchString(query) {
+			for _, namespace := range []string{DefaultProviderNamespace(), hashicorpNamespace} {
+				attempts = append(attempts, struct{ id, by string }{namespace + "/" + query, "namespace"})
+			}
+		}
+	}
+
+	for _, attempt := range attempts {
+		namespace, name, _ := strings.Cut(attempt.id, "/")
+		version, err := client.GetLatestProviderVersion(ctx, httpClient, namespace, name, logger)
+		if err != nil {
+			// Only a provider that does not exist moves on to the next attempt, a failed lookup is not a miss
+			if client.RegistryErrorCode(err) != utils.ErrorCodeNotFound {
+				return resolution, fmt.Errorf("looking up provider %s: %w", attempt.id, err)
+			}
+			logger.Debugf("Provider %s not found while resolving %q: %v", attempt.id, query, err)
+			continue
+		}
+		resolution.ProviderID, resolution.Namespace, resolution.Name = attempt.id, namespace, name
+		resolution.LatestVersion, resolution.ResolvedBy = version, attempt.by
+		return resolution, nil
+	}
+	if strings.Contains(query, "/") {
+		return resolution, nil
+	}
+
+	matches, err := searchRegistryProviders(ctx, httpClient, query, logger)
+	if err != nil {
+		return resolution, err
+	}
+	// A single provider named exactly like the query is an unambiguous match
+	var named []registrySearchResult
+	for _, match := range matches {
+		if _, name, _ := strings.Cut(match.ID, "/"); name == strings.ReplaceAll(query, " ", "") {
+			named = append(named, match)
+		}
+	}
+	if len(named) == 1 {
+		resolution.ProviderID = named[0].ID
+		resolution.Namespace, resolution.Name, _ = strings.Cut(named[0].ID, "/")
+		resolution.ResolvedBy = "search"
+		if version, err := client.GetLatestProviderVersion(ctx, httpClient, resolution.Namespace, resolution.Name, logger); err == nil {
+			resolution.LatestVersion = version
+		}
+		return resolution, nil
+	}
+	for _, match := range matches {
+		if len(resolution.Candidates) == maxProviderNameCandidates {
+			break
+		}
+		resolution.Candidates = append(resolution.Candidates, providerNameCandidate{ProviderID: match.ID, Tier: match.Tier, Description: match.Description})
+	}
+	return resolution, nil
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+func TestNormalizeProviderName(t *testing.T) {
+	for input, expected := range map[string]string{
+		"aws":                                 "aws",
+		" Google ":                            "google",
+		"Microsoft   Azure":                   "microsoft azure",
+		"terraform-provider-aws":              "aws",
+		"hashicorp/terraform-provider-google": "hashicorp/google",
+		"registry.terraform.io/hashicorp/aws": "hashicorp/aws",
+		"Integrations/GitHub":                 "integrations/github",
+		"":                                    "",
+	} {
+		if actual := normalizeProviderName(input); actual != expected {
+			t.Errorf("normalizeProviderName(%q): expected %q, got %q", input, expected, actual)
+		}
+	}
+}
+
+func TestProviderNameAliases(t *testing.T) {
+	for alias, provider := range providerNameAliases {
+		if normalizeProviderName(alias) != alias {
+			t.Errorf("alias %q is not normalized, it can never match", alias)
+		}
+		namespace, name, found := strings.Cut(provider, "/")
+		if !found || !registryNameRegex.MatchString(namespace) || !registryNameRegex.MatchString(name) {
+			t.Errorf("alias %q maps to %q, expected namespace/name", alias, provider)
+		}
+	}
+	if providerNameAliases["gcp"] != "hashicorp/google" {
+		t.Errorf("expected gcp to resolve to hashicorp/google, got %q", providerNameAliases["gcp"])
+	}
+}
+
+func TestResolveProviderNameLookupErrors(t *testing.T) {
+	notFound, err := resolveProviderName(context.Background(), &http.Client{Transport: policyStatusTransport(http.StatusNotFound)}, "acme/example", log.New())
+	if err != nil || notFound.ProviderID != "" {
+		t.Fatalf("expected an unresolved name without error, got %+v, %v", notFound, err)
+	}
+
+	_, err = resolveProviderName(context.Background(), &http.Client{Transport: policyStatusTransport(http.StatusTooManyRequests)}, "acme/example", log.New())
+	if code := errorCodeOf(err); err == nil || code != utils.ErrorCodeRateLimited {
+		t.Errorf("expected a %s error, got %v", utils.ErrorCodeRateLimited, err)
+	}
+}
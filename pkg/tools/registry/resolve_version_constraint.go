@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// versionConstraintResolution is the structured result of resolving a version constraint against the registry
+type versionConstraintResolution struct {
+	Source            string   `json:"source"`
+	Kind              string   `json:"kind"`
+	Constraint        string   `json:"constraint"`
+	Explanation       []string `json:"explanation"`
+	HighestMatch      string   `json:"highest_match,omitempty"`
+	MatchingVersions  []string `json:"matching_versions"`
+	PublishedVersions int      `json:"published_versions"`
+	Note              string   `json:"note,omitempty"`
+}
+
+// ResolveVersionConstraint creates a tool that lists the published versions of a module or provider satisfying a version constraint.
+func ResolveVersionConstraint(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("resolve_version_constraint",
+			mcp.WithDescription(`Explains a Terraform version constraint such as '>= 3.0, < 4.0' or '~> 5.1' and resolves it against the versions currently published in the public registry for a module or provider.
+Returns, as structured data, what each part of the constraint allows, the matching versions newest first and the highest matching version, which is the one 'terraform init' would select. Use it to explain or choose version pinning.`),
+			mcp.WithTitleAnnotation("Resolve a Terraform version constraint against the published versions"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("source",
+				mcp.Required(),
+				mcp.Description("The registry source address, 'namespace/name' for a provider (e.g. 'hashicorp/aws') or 'namespace/name/provider' for a module (e.g. 'terraform-aws-modules/vpc/aws'). A module_id from search_modules is accepted too, its version is ignored"),
+			),
+			mcp.WithString("constraint",
+				mcp.Required(),
+				mcp.Description("The version constraint as written in a 'version' argument, e.g. '>= 3.0, < 4.0' or '~> 5.1'"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return resolveVersionConstraintHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func resolveVersionConstraintHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	source, err := request.RequireString("source")
+	if err != nil || strings.TrimSpace(source) == "" {
+		return ToolArgumentError(logger, "source", "is required")
+	}
+	kind, uri, err := registrySourceURI(source)
+	if err != nil {
+		return ToolArgumentError(logger, "source", err.Error())
+	}
+
+	constraint, err := request.RequireString("constraint")
+	if err != nil || strings.TrimSpace(constraint) == "" {
+		return ToolArgumentError(logger, "constraint", "is required")
+	}
+	constraint = strings.TrimSpace(constraint)
+	explanation, err := utils.ExplainVersionConstraint(constraint)
+	if err != nil {
+		return ToolArgumentError(logger, "constraint", "must be a Terraform version constraint such as '>= 3.0, < 4.0' or '~> 5.1'")
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	apiVersion := client.RegistryAPIVersions().Providers
+	if kind == "module" {
+		apiVersion = client.RegistryAPIVersions().Modules
+	}
+	response, err := client.SendRegistryCall(ctx, httpClient, http.MethodGet, uri, logger, apiVersion)
+	if err != nil {
+		return ToolErrorf(logger, "failed to get the versions of %s %s: %v%s", kind, source, err, notFoundHint(err, "verify the source address is correct"))
+	}
+	// The module and provider responses both list the published versions
+	var published struct {
+		Versions []string `json:"versions"`
+	}
+	if err := client.DecodeRegistryResponse(response, &published); err != nil {
+		return ToolErrorf(logger, "failed to parse the versions of %s %s: %v", kind, source, err)
+	}
+
+	resolution, err := resolveVersionConstraint(strings.TrimSpace(source), kind, constraint, explanation, published.Versions)
+	if err != nil {
+		return ToolArgumentError(logger, "constraint", err.Error())
+	}
+	result, err := json.MarshalIndent(resolution, "", "  ")
+	if err != nil {
+		return ToolError(logger, "failed to marshal the version constraint resolution", err)
+	}
+	return mcp.NewToolResultStructured(resolution, string(result)), nil
+}
+
+// registrySourceURI returns whether a source address names a provider or a module, and the v1 registry path listing its
+// versions. The version of a module ID such as "terraform-aws-modules/vpc/aws/5.0.0" is dropped.
+func registrySourceURI(source string) (string, string, error) {
+	trimmed := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(source), "registry.terraform.io/"))
+	parts := strings.Split(trimmed, "/")
+	switch len(parts) {
+	case 2:
+		if parts[0] == "" || parts[1] == "" {
+			break
+		}
+		return "provider", path.Join("providers", parts[0], parts[1]), nil
+	case 3, 4:
+		moduleID, err := utils.ParseModuleID(trimmed, false)
+		if err != nil {
+			return "", "", err
+		}
+		return "module", path.Join("modules", moduleID.Namespace, moduleID.Name, moduleID.Provider), nil
+	}
+	return "", "", fmt.Errorf("must be 'namespace/name' for a provider or 'namespace/name/provider' for a module, got '%s'", source)
+}
+
+// resolveVersionConstraint matches the published versions against the constraint
+func resolveVersionConstraint(source string, kind string, constraint string, explanation []string, published []string) (versionConstraintResolution, error) {
+	matching, err := utils.MatchingVersions(published, constraint)
+	if err != nil {
+		return versionConstraintResolution{}, err
+	}
+	resolution := versionConstraintResolution{
+		Source:            source,
+		Kind:              kind,
+		Constraint:        constraint,
+		Explanation:       explanation,
+		MatchingVersions:  []string{},
+		PublishedVersions: len(published),
+	}
+	if len(matching) > 0 {
+		resolution.HighestMatch = matching[0]
+		resolution.MatchingVersions = matching
+	} else if latest, err := utils.LatestVersion(published, false); err == nil {
+		resolution.Note = fmt.Sprintf("no published version satisfies the constraint, the latest published version is %s", latest)
+	} else {
+		resolution.Note = "no published version satisfies the constraint"
+	}
+	return resolution, nil
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRegistrySourceURI(t *testing.T) {
+	tests := []struct {
+		source  string
+		kind    string
+		uri     string
+		wantErr bool
+	}{
+		{source: "hashicorp/aws", kind: "provider", uri: "providers/hashicorp/aws"},
+		{source: " registry.terraform.io/Hashicorp/AWS ", kind: "provider", uri: "providers/hashicorp/aws"},
+		{source: "terraform-aws-modules/vpc/aws", kind: "module", uri: "modules/terraform-aws-modules/vpc/aws"},
+		{source: "aws", wantErr: true},
+		{source: "hashicorp/", wantErr: true},
+		{source: "terraform-aws-modules/vpc/aws/5.0.0", kind: "module", uri: "modules/terraform-aws-modules/vpc/aws"},
+		{source: "terraform-aws-modules/vpc/aws/latest", wantErr: true},
+		{source: "terraform-aws-modules/vpc/aws/5.0.0/extra", wantErr: true},
+	}
+	for _, tt := range tests {
+		kind, uri, err := registrySourceURI(tt.source)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("registrySourceURI(%q) error = %v, wantErr %v", tt.source, err, tt.wantErr)
+			continue
+		}
+		if kind != tt.kind || uri != tt.uri {
+			t.Errorf("registrySourceURI(%q) = %q, %q, expected %q, %q", tt.source, kind, uri, tt.kind, tt.uri)
+		}
+	}
+}
+
+func TestResolveVersionConstraint(t *testing.T) {
+	published := []string{"2.9.0", "3.0.0", "3.1.0", "3.10.0", "4.0.0"}
+
+	resolution, err := resolveVersionConstraint("acme/vpc/aws", "module", ">= 3.0, < 4.0", nil, published)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resolution.HighestMatch != "3.10.0" || !reflect.DeepEqual(resolution.MatchingVersions, []string{"3.10.0", "3.1.0", "3.0.0"}) {
+		t.Errorf("unexpected resolution: %+v", resolution)
+	}
+	if resolution.PublishedVersions != 5 || resolution.Note != "" {
+		t.Errorf("unexpected resolution: %+v", resolution)
+	}
+
+	resolution, err = resolveVersionConstraint("hashicorp/aws", "provider", "~> 5.0", nil, published)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resolution.HighestMatch != "" || len(resolution.MatchingVersions) != 0 {
+		t.Errorf("expected no match, got %+v", resolution)
+	}
+	if resolution.Note != "no published version satisfies the constraint, the latest published version is 4.0.0" {
+		t.Errorf("unexpected note: %q", resolution.Note)
+	}
+}
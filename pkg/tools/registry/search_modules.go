@@ -5,7 +5,6 @@ package tools
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -13,12 +12,19 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
+const (
+	moduleSortDownloads = "downloads"
+	moduleSortPublished = "published"
+	moduleSortRelevance = "relevance"
+)
+
 func SearchModules(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
 		Tool: mcp.NewTool("search_modules",
@@ -44,9 +50,18 @@ If no modules were found, reattempt the search with a new moduleName query.`),
 				mcp.Min(0),
 				mcp.DefaultNumber(0),
 			),
+			mcp.WithString("sort",
+				mcp.Description("Order of the modules in the page of results: 'downloads' (most downloaded first), 'published' (most recently published first) or 'relevance' (the registry's search ranking)"),
+				mcp.Enum(moduleSortDownloads, moduleSortPublished, moduleSortRelevance),
+				mcp.DefaultString(moduleSortDownloads),
+			),
+			mcp.WithBoolean("verified_only",
+				mcp.Description("Only return verified modules, published by HashiCorp partners and reviewed for production use"),
+				mcp.DefaultBool(false),
+			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getSearchModulesHandler(ctx, request, logger)
+			return getSearchModulesHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -54,66 +69,85 @@ If no modules were found, reattempt the search with a new moduleName query.`),
 func getSearchModulesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	moduleQuery, err := request.RequireString("module_query")
 	if err != nil {
-		return ToolError(logger, "missing required input: module_query", err)
+		return ToolArgumentError(logger, "module_query", "is required")
 	}
 	moduleQuery = strings.ToLower(moduleQuery)
 	currentOffsetValue := request.GetInt("current_offset", 0)
+	sortBy := strings.ToLower(strings.TrimSpace(request.GetString("sort", moduleSortDownloads)))
+	switch sortBy {
+	case "":
+		sortBy = moduleSortDownloads
+	case moduleSortDownloads, moduleSortPublished, moduleSortRelevance:
+	default:
+		return ToolArgumentError(logger, "sort", fmt.Sprintf("must be one of %s, %s, %s, got '%s'", moduleSortDownloads, moduleSortPublished, moduleSortRelevance, sortBy))
+	}
 
 	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
 	if err != nil {
 		return ToolError(logger, "failed to get http client for public Terraform registry", err)
 	}
 
-	response, err := sendSearchModulesCall(httpClient, moduleQuery, currentOffsetValue, logger)
+	verifiedOnly := request.GetBool("verified_only", false)
+	response, err := sendSearchModulesCall(ctx, httpClient, moduleQuery, currentOffsetValue, verifiedOnly, logger)
 	if err != nil {
-		return ToolErrorf(logger, "no modules found for query: %s - try a different search term", moduleQuery)
+		return ToolErrorf(logger, "failed to search modules for query: %s: %v%s", moduleQuery, err, notFoundHint(err, noModulesFoundHint(verifiedOnly)))
 	}
 
-	modulesData, err := unmarshalTerraformModules(response, moduleQuery, logger)
+	modulesData, modules, err := unmarshalTerraformModules(response, moduleQuery, sortBy, logger)
 	if err != nil {
 		return ToolErrorf(logger, "failed to parse module results for query: %s", moduleQuery)
 	}
 
 	if modulesData == "" {
-		return ToolErrorf(logger, "no modules found for query: %s - try a different search term", moduleQuery)
+		return ToolNotFoundErrorf(logger, "no modules found for query: %s - %s", moduleQuery, noModulesFoundHint(verifiedOnly))
 	}
 
-	return mcp.NewToolResultText(modulesData), nil
+	return utils.NewToolResultData(modulesData, modules), nil
 }
 
-func sendSearchModulesCall(providerClient *http.Client, moduleQuery string, currentOffset int, logger *log.Logger) ([]byte, error) {
+// noModulesFoundHint suggests how to widen a module search that found nothing
+func noModulesFoundHint(verifiedOnly bool) string {
+	if verifiedOnly {
+		return "no verified module matches, try a different search term or search without verified_only"
+	}
+	return "try a different search term"
+}
+
+// sendSearchModulesCall searches the registry for modules, only returning verified modules when verifiedOnly is set
+func sendSearchModulesCall(ctx context.Context, providerClient *http.Client, moduleQuery string, currentOffset int, verifiedOnly bool, logger *log.Logger) ([]byte, error) {
 	uri := "modules"
 	if moduleQuery != "" {
 		uri = fmt.Sprintf("%s/search?q='%s'&offset=%v", uri, url.PathEscape(moduleQuery), currentOffset)
 	} else {
 		uri = fmt.Sprintf("%s?offset=%v", uri, currentOffset)
 	}
+	if verifiedOnly {
+		uri += "&verified=true"
+	}
 
-	response, err := client.SendRegistryCall(providerClient, "GET", uri, logger)
+	response, err := client.SendRegistryCall(ctx, providerClient, "GET", uri, logger, client.RegistryAPIVersions().Modules)
 	if err != nil {
-		return nil, fmt.Errorf("getting module(s) for: %v, call error: %v", moduleQuery, err)
+		return nil, fmt.Errorf("getting module(s) for: %v, call error: %w", moduleQuery, err)
 	}
 
 	return response, nil
 }
 
-func unmarshalTerraformModules(response []byte, moduleQuery string, logger *log.Logger) (string, error) {
+func unmarshalTerraformModules(response []byte, moduleQuery string, sortBy string, logger *log.Logger) (string, client.TerraformModules, error) {
 	var terraformModules client.TerraformModules
-	err := json.Unmarshal(response, &terraformModules)
+	err := client.DecodeRegistryResponse(response, &terraformModules)
 	if err != nil {
-		return "", fmt.Errorf("unmarshalling modules: %w", err)
+		return "", client.TerraformModules{}, fmt.Errorf("unmarshalling modules: %w", err)
 	}
 
 	if len(terraformModules.Data) == 0 {
-		return "", fmt.Errorf("no modules found for query: %s", moduleQuery)
+		return "", client.TerraformModules{}, fmt.Errorf("no modules found for query: %s", moduleQuery)
 	}
 
-	sort.Slice(terraformModules.Data, func(i, j int) bool {
-		return terraformModules.Data[i].Downloads > terraformModules.Data[j].Downloads
-	})
+	sortTerraformModules(terraformModules, sortBy)
 
 	var builder strings.Builder
-	builder.WriteString(fmt.Sprintf("Available Terraform Modules (top matches) for %s\n\n Each result includes:\n", moduleQuery))
+	builder.WriteString(fmt.Sprintf("Available Terraform Modules (top matches) for %s, sorted by %s\n\n Each result includes:\n", moduleQuery, sortBy))
 	builder.WriteString("- module_id: The module ID (format: namespace/name/provider-name/module-version)\n")
 	builder.WriteString("- Name: The name of the module\n")
 	builder.WriteString("- Description: A short description of the module\n")
@@ -130,5 +164,45 @@ func unmarshalTerraformModules(response []byte, moduleQuery string, logger *log.
 		builder.WriteString(fmt.Sprintf("- Published: %s\n", module.PublishedAt))
 		builder.WriteString("---\n\n")
 	}
-	return builder.String(), nil
+	builder.WriteString(formatModuleSearchPagination(terraformModules))
+	return builder.String(), terraformModules, nil
+}
+
+// sortTerraformModules orders a page of search results. The registry search has no sort parameter, so the
+// modules are sorted within the page and 'relevance' keeps the order they were returned in.
+func sortTerraformModules(terraformModules client.TerraformModules, sortBy string) {
+	modules := terraformModules.Data
+	switch sortBy {
+	case moduleSortDownloads:
+		sort.SliceStable(modules, func(i, j int) bool {
+			return modules[i].Downloads > modules[j].Downloads
+		})
+	case moduleSortPublished:
+		sort.SliceStable(modules, func(i, j int) bool {
+			return modules[i].PublishedAt.After(modules[j].PublishedAt)
+		})
+	}
+}
+
+// formatModuleSearchPagination describes where the page sits in the search results. The registry does not report
+// the total number of matches, only whether there is a next page, so the total is exact on the last page only.
+func formatModuleSearchPagination(terraformModules client.TerraformModules) string {
+	meta := terraformModules.Metadata
+	returned := len(terraformModules.Data)
+	// The registry leaves out next_offset and next_url on the last page
+	truncated := meta.NextURL != "" || meta.NextOffset > meta.CurrentOffset
+
+	var builder strings.Builder
+	builder.WriteString("Pagination:\n")
+	builder.WriteString(fmt.Sprintf("- returned: %d\n", returned))
+	builder.WriteString(fmt.Sprintf("- current_offset: %d\n", meta.CurrentOffset))
+	if truncated {
+		builder.WriteString(fmt.Sprintf("- total_matches: more than %d\n", meta.CurrentOffset+returned))
+		builder.WriteString("- truncated: true\n")
+		builder.WriteString(fmt.Sprintf("- next_offset: %d (pass it as current_offset to get the next page)\n", meta.NextOffset))
+	} else {
+		builder.WriteString(fmt.Sprintf("- total_matches: %d\n", meta.CurrentOffset+returned))
+		builder.WriteString("- truncated: false\n")
+	}
+	return builder.String()
 }
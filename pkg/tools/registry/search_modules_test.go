@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func TestUnmarshalTerraformModulesPagination(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected []string
+	}{
+		{
+			name: "truncated",
+			response: `{
+  "meta": {"limit": 2, "current_offset": 0, "next_offset": 2, "next_url": "/v1/modules/search?limit=2&offset=2&q=vpc"},
+  "modules": [
+    {"id": "terraform-aws-modules/vpc/aws/5.0.0", "name": "vpc", "downloads": 10},
+    {"id": "cloudposse/vpc/aws/2.1.0", "name": "vpc", "downloads": 5}
+  ]
+}`,
+			expected: []string{"- returned: 2", "- current_offset: 0", "- total_matches: more than 2", "- truncated: true", "- next_offset: 2"},
+		},
+		{
+			name: "last page",
+			response: `{
+  "meta": {"limit": 15, "current_offset": 15, "prev_offset": 0},
+  "modules": [{"id": "acme/vpc/aws/1.0.0", "name": "vpc", "downloads": 1}]
+}`,
+			expected: []string{"- returned: 1", "- current_offset: 15", "- total_matches: 16", "- truncated: false"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, _, err := unmarshalTerraformModules([]byte(tc.response), "vpc", moduleSortDownloads, log.New())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, want := range tc.expected {
+				if !strings.Contains(result, want) {
+					t.Errorf("expected %q in result, got:\n%s", want, result)
+				}
+			}
+			if tc.name == "last page" && strings.Contains(result, "next_offset") {
+				t.Errorf("expected no next_offset on the last page, got:\n%s", result)
+			}
+		})
+	}
+}
+
+func TestUnmarshalTerraformModulesSort(t *testing.T) {
+	response := `{
+  "meta": {"limit": 15, "current_offset": 0},
+  "modules": [
+    {"id": "acme/vpc/aws/1.0.0", "name": "vpc", "downloads": 5, "published_at": "2024-01-01T00:00:00Z"},
+    {"id": "cloudposse/vpc/aws/2.1.0", "name": "vpc", "downloads": 1, "published_at": "2025-06-01T00:00:00Z"},
+    {"id": "terraform-aws-modules/vpc/aws/5.0.0", "name": "vpc", "downloads": 10, "published_at": "2023-03-01T00:00:00Z"}
+  ]
+}`
+	tests := []struct {
+		sortBy   string
+		expected []string
+	}{
+		{sortBy: moduleSortDownloads, expected: []string{"terraform-aws-modules/vpc/aws/5.0.0", "acme/vpc/aws/1.0.0", "cloudposse/vpc/aws/2.1.0"}},
+		{sortBy: moduleSortPublished, expected: []string{"cloudposse/vpc/aws/2.1.0", "acme/vpc/aws/1.0.0", "terraform-aws-modules/vpc/aws/5.0.0"}},
+		{sortBy: moduleSortRelevance, expected: []string{"acme/vpc/aws/1.0.0", "cloudposse/vpc/aws/2.1.0", "terraform-aws-modules/vpc/aws/5.0.0"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.sortBy, func(t *testing.T) {
+			result, _, err := unmarshalTerraformModules([]byte(response), "vpc", tc.sortBy, log.New())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			previous := -1
+			for _, id := range tc.expected {
+				index := strings.Index(result, "- module_id: "+id)
+				if index < 0 || index < previous {
+					t.Fatalf("expected %s in order %v, got:\n%s", id, tc.expected, result)
+				}
+				previous = index
+			}
+		})
+	}
+}
+
+// moduleSearchTransport records the query of the module search requests and answers with an empty page
+type moduleSearchTransport struct {
+	query string
+}
+
+func (t *moduleSearchTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.query = req.URL.RawQuery
+	return &http.Response{StatusCode: http.StatusOK, Status: "200 OK", Body: io.NopCloser(strings.NewReader(`{"modules":[]}`)), Header: http.Header{}}, nil
+}
+
+func TestSendSearchModulesCallVerifiedOnly(t *testing.T) {
+	for _, verifiedOnly := range []bool{false, true} {
+		transport := &moduleSearchTransport{}
+		httpClient := &http.Client{Transport: transport}
+		if _, err := sendSearchModulesCall(context.Background(), httpClient, "vpc", 15, verifiedOnly, log.New()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(transport.query, "offset=15") {
+			t.Errorf("expected the offset in the query, got %q", transport.query)
+		}
+		if got := strings.Contains(transport.query, "verified=true"); got != verifiedOnly {
+			t.Errorf("verified_only=%v: expected the verified filter %v, got query %q", verifiedOnly, verifiedOnly, transport.query)
+		}
+	}
+}
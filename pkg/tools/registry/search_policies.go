@@ -5,10 +5,13 @@ package tools
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
 	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
@@ -30,7 +33,8 @@ When selecting the best match, consider the following:
 	- Verification status (verified)
 	- Download counts (popularity)
 Return the selected policyID and explain your choice. If there are multiple good matches, mention this but proceed with the most relevant one.
-If no policies were found, reattempt the search with a new policy_query.`),
+If no policies were found, reattempt the search with a new policy_query.
+Policies are searched one registry page at a time; the response ends with the total count of policies and the next page_number to search when more pages exist.`),
 			mcp.WithTitleAnnotation("Search and match Terraform policies based on name and relevance"),
 			mcp.WithOpenWorldHintAnnotation(true),
 			mcp.WithReadOnlyHintAnnotation(true),
@@ -39,9 +43,24 @@ If no policies were found, reattempt the search with a new policy_query.`),
 				mcp.Required(),
 				mcp.Description("The query to search for Terraform modules."),
 			),
+			mcp.WithString("provider",
+				mcp.Description("Only return policies for this cloud provider, e.g. 'aws', 'azurerm' or 'google'"),
+			),
+			mcp.WithString("namespace",
+				mcp.Description("Only return policies published in this namespace, e.g. 'hashicorp'"),
+			),
+			mcp.WithNumber("page_number",
+				mcp.Description("Page of the registry policy catalog to search (starts at 1)"),
+				mcp.Min(1),
+			),
+			mcp.WithNumber("page_size",
+				mcp.Description("Number of policies per registry page (max 100, defaults to 100)"),
+				mcp.Min(1),
+				mcp.Max(100),
+			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getSearchPoliciesHandler(ctx, request, logger)
+			return getSearchPoliciesHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -49,12 +68,22 @@ If no policies were found, reattempt the search with a new policy_query.`),
 func getSearchPoliciesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	pq, err := request.RequireString("policy_query")
 	if err != nil {
-		return ToolError(logger, "missing required input: policy_query", err)
+		return ToolArgumentError(logger, "policy_query", "is required")
 	}
 	if pq == "" {
-		return ToolError(logger, "policy_query cannot be empty", nil)
+		return ToolArgumentError(logger, "policy_query", "cannot be empty")
 	}
 	pq = strings.ToLower(pq)
+	provider := strings.ToLower(strings.TrimSpace(request.GetString("provider", "")))
+	namespace := strings.ToLower(strings.TrimSpace(request.GetString("namespace", "")))
+	pageNumber := request.GetInt("page_number", 1)
+	if pageNumber < 1 {
+		return ToolArgumentError(logger, "page_number", "must be at least 1")
+	}
+	pageSize := request.GetInt("page_size", 100)
+	if pageSize < 1 || pageSize > 100 {
+		return ToolArgumentError(logger, "page_size", "must be between 1 and 100")
+	}
 
 	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
 	if err != nil {
@@ -64,18 +93,19 @@ func getSearchPoliciesHandler(ctx context.Context, request mcp.CallToolRequest,
 	uri := (&url.URL{
 		Path: "policies",
 		RawQuery: url.Values{
-			"page[size]": {"100"},
-			"include":    {"latest-version"},
+			"page[number]": {strconv.Itoa(pageNumber)},
+			"page[size]":   {strconv.Itoa(pageSize)},
+			"include":      {"latest-version"},
 		}.Encode(),
 	}).String()
 
-	policyResp, err := client.SendRegistryCall(httpClient, "GET", uri, logger, "v2")
+	policyResp, err := client.SendRegistryCall(ctx, httpClient, "GET", uri, logger, client.RegistryAPIVersions().Policies)
 	if err != nil {
 		return ToolError(logger, "failed to fetch policies from registry", err)
 	}
 
 	var terraformPolicies client.TerraformPolicyList
-	if err := json.Unmarshal(policyResp, &terraformPolicies); err != nil {
+	if err := client.DecodeRegistryResponse(policyResp, &terraformPolicies); err != nil {
 		return ToolError(logger, "failed to parse policy list", err)
 	}
 
@@ -83,13 +113,18 @@ func getSearchPoliciesHandler(ctx context.Context, request mcp.CallToolRequest,
 	builder.WriteString(fmt.Sprintf("Matching Terraform Policies for query: %s\n\n", pq))
 	builder.WriteString("Each result includes:\n- terraform_policy_id: Unique identifier to be used with get_policy_details tool\n- Name: Policy name\n- Title: Policy description\n- Downloads: Policy downloads\n---\n\n")
 
-	contentAvailable := false
+	results := policySearchResults{Query: pq, Policies: []policySearchResult{}}
 	for _, policy := range terraformPolicies.Data {
 		cs, err := utils.ContainsSlug(strings.ToLower(policy.Attributes.Title), pq)
 		cs_pn, err_pn := utils.ContainsSlug(strings.ToLower(policy.Attributes.Name), pq)
+		if namespace != "" && strings.ToLower(policy.Attributes.Namespace) != namespace {
+			continue
+		}
+		if provider != "" && !policyMatchesProvider(policy.Attributes.Name, policy.Attributes.Title, provider) {
+			continue
+		}
 		if (cs || cs_pn) && err == nil && err_pn == nil {
-			contentAvailable = true
-			ID := strings.ReplaceAll(policy.Relationships.LatestVersion.Links.Related, "/v2/", "")
+			ID := policyIDFromLink(policy.Relationships.LatestVersion.Links.Related)
 			builder.WriteString(fmt.Sprintf(
 				"- terraform_policy_id: %s\n- Name: %s\n- Title: %s\n- Downloads: %d\n---\n",
 				ID,
@@ -97,12 +132,108 @@ func getSearchPoliciesHandler(ctx context.Context, request mcp.CallToolRequest,
 				policy.Attributes.Title,
 				policy.Attributes.Downloads,
 			))
+			results.Policies = append(results.Policies, policySearchResult{
+				TerraformPolicyID: ID,
+				Name:              policy.Attributes.Name,
+				Title:             policy.Attributes.Title,
+				Downloads:         policy.Attributes.Downloads,
+			})
 		}
 	}
 
-	if !contentAvailable {
-		return ToolErrorf(logger, "no policies found matching query: %s - try a different search term", pq)
+	nextPage := registryPageNumber(terraformPolicies.Meta.Pagination.NextPage)
+	if len(results.Policies) == 0 {
+		if nextPage > 0 {
+			return ToolNotFoundErrorf(logger, "no policies found matching query: %s on page %d of %d - search page_number %d next", pq, pageNumber, terraformPolicies.Meta.Pagination.TotalPages, nextPage)
+		}
+		if provider != "" || namespace != "" {
+			return ToolNotFoundErrorf(logger, "no policies found matching query: %s (provider: '%s', namespace: '%s') - try a different search term or remove the filters", pq, provider, namespace)
+		}
+		return ToolNotFoundErrorf(logger, "no policies found matching query: %s - try a different search term", pq)
+	}
+
+	pagination := terraformPolicies.Meta.Pagination
+	results.Page, results.TotalPages, results.TotalCount, results.NextPage = pageNumber, pagination.TotalPages, pagination.TotalCount, nextPage
+	builder.WriteString("\nPagination:\n")
+	builder.WriteString(fmt.Sprintf("- Current Page: %d\n", pageNumber))
+	builder.WriteString(fmt.Sprintf("- Page Size: %d\n", pageSize))
+	builder.WriteString(fmt.Sprintf("- Total Pages: %d\n", pagination.TotalPages))
+	builder.WriteString(fmt.Sprintf("- Total Count: %d\n", pagination.TotalCount))
+	if nextPage > 0 {
+		builder.WriteString(fmt.Sprintf("- Next Page: %d\n", nextPage))
+	}
+	if previousPage := registryPageNumber(pagination.PrevPage); previousPage > 0 {
+		builder.WriteString(fmt.Sprintf("- Previous Page: %d\n", previousPage))
+	}
+
+	return utils.NewToolResultData(builder.String(), results), nil
+}
+
+// policySearchResults is the typed result of search_policies
+type policySearchResults struct {
+	Query      string               `json:"query"`
+	Policies   []policySearchResult `json:"policies"`
+	Page       int                  `json:"page"`
+	TotalPages int                  `json:"total_pages"`
+	TotalCount int                  `json:"total_count"`
+	// NextPage is 0 on the last page
+	NextPage int `json:"next_page,omitempty"`
+}
+
+type policySearchResult struct {
+	TerraformPolicyID string `json:"terraform_policy_id"`
+	Name              string `json:"name"`
+	Title             string `json:"title"`
+	Downloads         int    `json:"downloads"`
+}
+
+// registryPageNumber reads a page number of the registry pagination metadata, which is null on the first and last
+// pages, and returns 0 when there is no such page
+func registryPageNumber(page any) int {
+	switch value := page.(type) {
+	case float64:
+		return int(value)
+	case string:
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return 0
+		}
+		return number
+	default:
+		return 0
 	}
+}
 
-	return mcp.NewToolResultText(builder.String()), nil
+// policyLinkVersionRegex matches the API version segment a policy link starts with, e.g. "/v2/"
+var policyLinkVersionRegex = regexp.MustCompile(`^/?v[0-9]+/`)
+
+// policyIDFromLink returns the terraform_policy_id of a latest version link, e.g.
+// "/v2/policies/hashicorp/CIS-Policy-Set-for-AWS-Terraform/1.0.1" gives
+// "policies/hashicorp/CIS-Policy-Set-for-AWS-Terraform/1.0.1". The version is read from the link, which the registry
+// may serve under another version than the one configured for the search.
+func policyIDFromLink(link string) string {
+	return strings.TrimPrefix(policyLinkVersionRegex.ReplaceAllString(link, ""), "/")
+}
+
+// policyProviderAliases are the other names a provider goes by in policy names and titles
+var policyProviderAliases = map[string][]string{
+	"azurerm": {"azure"},
+	"azure":   {"azurerm"},
+	"google":  {"gcp"},
+	"gcp":     {"google"},
+}
+
+// policyMatchesProvider reports whether a policy name or title mentions the provider as a whole word,
+// e.g. "aws" matches "CIS-Policy-Set-for-AWS-Terraform" but not "laws"
+func policyMatchesProvider(name string, title string, provider string) bool {
+	words := strings.FieldsFunc(strings.ToLower(name+" "+title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	candidates := append([]string{provider}, policyProviderAliases[provider]...)
+	for _, word := range words {
+		if slices.Contains(candidates, word) {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import "testing"
+
+func TestPolicyMatchesProvider(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		provider string
+		expected bool
+	}{
+		{"CIS-Policy-Set-for-AWS-Terraform", "Pre-written Sentinel Policies for AWS CIS Foundations Benchmark", "aws", true},
+		{"CIS-Policy-Set-for-Azure-Terraform", "Pre-written Sentinel Policies for Azure", "azurerm", true},
+		{"cis-policy-set-for-gcp-terraform", "Pre-written Sentinel Policies for GCP", "google", true},
+		{"policy-library-for-laws", "Compliance with laws", "aws", false},
+		{"CIS-Policy-Set-for-AWS-Terraform", "Pre-written Sentinel Policies for AWS", "azurerm", false},
+	}
+
+	for _, tt := range tests {
+		if got := policyMatchesProvider(tt.name, tt.title, tt.provider); got != tt.expected {
+			t.Errorf("policyMatchesProvider(%q, %q, %q) = %v, expected %v", tt.name, tt.title, tt.provider, got, tt.expected)
+		}
+	}
+}
+
+func TestRegistryPageNumber(t *testing.T) {
+	tests := []struct {
+		page     any
+		expected int
+	}{
+		{float64(2), 2},
+		{"3", 3},
+		{nil, 0},
+		{"next", 0},
+	}
+
+	for _, tt := range tests {
+		if got := registryPageNumber(tt.page); got != tt.expected {
+			t.Errorf("registryPageNumber(%v) = %d, expected %d", tt.page, got, tt.expected)
+		}
+	}
+}
+
+func TestPolicyIDFromLink(t *testing.T) {
+	tests := []struct {
+		link     string
+		expected string
+	}{
+		{"/v2/policies/hashicorp/CIS-Policy-Set-for-AWS-Terraform/1.0.1", "policies/hashicorp/CIS-Policy-Set-for-AWS-Terraform/1.0.1"},
+		{"/v3/policies/hashicorp/CIS-Policy-Set-for-AWS-Terraform/1.0.1", "policies/hashicorp/CIS-Policy-Set-for-AWS-Terraform/1.0.1"},
+		{"v2/policies/hashicorp/vault/2.0.0", "policies/hashicorp/vault/2.0.0"},
+		{"/policies/hashicorp/vault/2.0.0", "policies/hashicorp/vault/2.0.0"},
+	}
+
+	for _, tt := range tests {
+		if got := policyIDFromLink(tt.link); got != tt.expected {
+			t.Errorf("policyIDFromLink(%q) = %q, expected %q", tt.link, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// maxCachedAttributeIndexes bounds the in-memory cache of provider attribute indexes, each one holds every
+	// documented field of a provider version
+	maxCachedAttributeIndexes = 16
+	// maxAttributeMatches bounds the matches listed in one result
+	maxAttributeMatches = 100
+	// attributeIndexRetryBackoff is how long a cached index with missing docs is served before a search fetches the
+	// missing docs again
+	attributeIndexRetryBackoff = time.Minute
+)
+
+// providerAttribute is a documented field of a resource or data source
+type providerAttribute struct {
+	Resource string            `json:"resource"`
+	Path     string            `json:"path"`
+	Field    utils.DocArgument `json:"field"`
+}
+
+// providerAttributeMatches is the typed result of search_provider_attributes
+type providerAttributeMatches struct {
+	Provider  string              `json:"provider"`
+	Version   string              `json:"version"`
+	Category  string              `json:"category"`
+	Attribute string              `json:"attribute"`
+	Matches   []providerAttribute `json:"matches"`
+	// Omitted is the number of matches beyond the listed ones
+	Omitted int `json:"omitted,omitempty"`
+	// Failed is the number of docs that could not be fetched and were not searched
+	Failed int `json:"failed,omitempty"`
+}
+
+// attributeIndex holds the documented fields of the docs of a provider version category that could be fetched, and
+// the docs that could not, which are fetched again by the first search after retryAfter
+type attributeIndex struct {
+	attributes []providerAttribute
+	missing    []client.ProviderDoc
+	retryAfter time.Time
+}
+
+// attributeIndexCache holds the attribute index of every searched provider version category. The docs of a published
+// version do not change, so entries never expire.
+var attributeIndexCache = struct {
+	sync.Mutex
+	entries map[string]attributeIndex
+}{entries: make(map[string]attributeIndex)}
+
+// SearchProviderAttributes creates a tool that finds the resources of a provider documenting a given attribute.
+func SearchProviderAttributes(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("search_provider_attributes",
+			mcp.WithDescription(`Searches the documented arguments and attributes of every resource (or data source) of a Terraform provider version for an attribute name, e.g. which 'aws' resources have a 'kms_key_id'.
+Returns the matching resource names with the path, type and qualifier of the attribute. Nested block arguments match as well and are shown as 'block.argument'.
+The first search of a provider version reads all of its resource docs, which can take a while for large providers; later searches of the same version are answered from memory, and docs that could not be fetched are retried by the searches after a minute.`),
+			mcp.WithTitleAnnotation("Search the resources of a Terraform provider for an attribute"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("provider_name",
+				mcp.Required(),
+				mcp.Description("The name of the Terraform provider, e.g. 'aws', 'google', 'azurerm'"),
+			),
+			mcp.WithString("attribute",
+				mcp.Required(),
+				mcp.Description("The attribute or argument name to search for, e.g. 'kms_key_id'"),
+			),
+			mcp.WithString("provider_namespace",
+				mcp.Description(providerNamespaceDescription()),
+			),
+			mcp.WithString("provider_version",
+				mcp.Description("The version of the Terraform provider in the format 'x.y.z', or 'latest' to get the latest version"),
+			),
+			mcp.WithString("provider_document_type",
+				mcp.Description("Search the resources or the data sources of the provider"),
+				mcp.Enum("resources", "data-sources"),
+				mcp.DefaultString("resources"),
+			),
+			mcp.WithString("match",
+				mcp.Description("'exact' matches attributes named exactly 'attribute', 'contains' matches attribute names containing it"),
+				mcp.Enum("exact", "contains"),
+				mcp.DefaultString("exact"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return searchProviderAttributesHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func searchProviderAttributesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	attribute, err := request.RequireString("attribute")
+	attribute = strings.ToLower(strings.Trim(strings.TrimSpace(attribute), "`"))
+	if err != nil || attribute == "" {
+		return ToolArgumentError(logger, "attribute", "is required")
+	}
+	match := strings.ToLower(request.GetString("match", "exact"))
+	if match != "exact" && match != "contains" {
+		return ToolArgumentError(logger, "match", fmt.Sprintf("must be 'exact' or 'contains', got '%s'", match))
+	}
+	switch documentType := strings.ToLower(request.GetString("provider_document_type", "resources")); documentType {
+	case "resources", "data-sources":
+	default:
+		return ToolArgumentError(logger, "provider_document_type", fmt.Sprintf("must be 'resources' or 'data-sources', got '%s'", documentType))
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to resolve provider: %v", err)
+	}
+
+	index, failed, err := getProviderAttributeIndex(ctx, httpClient, providerDetail, logger)
+	if err != nil {
+		return ToolError(logger, "failed to list provider documentation", err)
+	}
+
+	matches := matchProviderAttributes(index, attribute, match == "contains")
+	if len(matches) == 0 {
+		return ToolNotFoundErrorf(logger, "no %s of %s/%s version %s document an attribute matching '%s' - try match 'contains' or the other provider_document_type", providerDetail.ProviderDocumentType, providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, attribute)
+	}
+	result := providerAttributeMatches{
+		Provider:  providerDetail.ProviderNamespace + "/" + providerDetail.ProviderName,
+		Version:   providerDetail.ProviderVersion,
+		Category:  providerDetail.ProviderDocumentType,
+		Attribute: attribute,
+		Matches:   matches,
+		Failed:    failed,
+	}
+	if len(matches) > maxAttributeMatches {
+		result.Matches, result.Omitted = matches[:maxAttributeMatches], len(matches)-maxAttributeMatches
+	}
+	return utils.NewToolResultData(formatProviderAttributeMatches(providerDetail, attribute, matches, failed), result), nil
+}
+
+// getProviderAttributeIndex returns the documented fields of every doc in the category of providerDetail, and the
+// number of docs that could not be fetched. An index with missing docs is cached as well, so a search within
+// attributeIndexRetryBackoff does not read all the docs again, and the first search after it only fetches the missing
+// docs.
+func getProviderAttributeIndex(ctx context.Context, httpClient *http.Client, providerDetail client.ProviderDetail, logger *log.Logger) ([]providerAttribute, int, error) {
+	key := strings.Join([]string{providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, providerDetail.ProviderDocumentType}, "/")
+	attributeIndexCache.Lock()
+	cached, ok := attributeIndexCache.entries[key]
+	attributeIndexCache.Unlock()
+	if ok && (len(cached.missing) == 0 || time.Now().Before(cached.retryAfter)) {
+		return cached.attributes, len(cached.missing), nil
+	}
+
+	docs := cached.missing
+	if !ok {
+		providerDocs, err := getProviderDocsList(ctx, httpClient, providerDetail, logger)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, doc := range providerDocs.Docs {
+			if doc.Language == "hcl" && doc.Category == providerDetail.ProviderDocumentType {
+				docs = append(docs, doc)
+			}
+		}
+	} else {
+		logger.Debugf("Fetching the %d missing docs of the attribute index of %s", len(docs), key)
+	}
+
+	results := client.ParallelRegistryCalls(docs, 0, func(doc client.ProviderDoc) (map[string]utils.DocArgument, error) {
+		content, err := client.GetProviderResourceDocs(ctx, httpClient, doc.ID, logger)
+		if err != nil {
+			return nil, err
+		}
+		return utils.DocFields(content), nil
+	})
+
+	index := slices.Clone(cached.attributes)
+	var missing []client.ProviderDoc
+	for i, result := range results {
+		if result.Err != nil {
+			// The request was cancelled, the rest of the docs failed with it
+			if ctx.Err() != nil {
+				return nil, 0, ctx.Err()
+			}
+			logger.Debugf("Skipping provider doc %s in the attribute index: %v", docs[i].ID, result.Err)
+			missing = append(missing, docs[i])
+			continue
+		}
+		resource := providerResourceName(providerDetail.ProviderName, docs[i])
+		for path, field := range result.Value {
+			index = append(index, providerAttribute{Resource: resource, Path: path, Field: field})
+		}
+	}
+	sort.Slice(index, func(i, j int) bool {
+		if index[i].Resource != index[j].Resource {
+			return index[i].Resource < index[j].Resource
+		}
+		return index[i].Path < index[j].Path
+	})
+
+	attributeIndexCache.Lock()
+	if len(attributeIndexCache.entries) >= maxCachedAttributeIndexes {
+		attributeIndexCache.entries = make(map[string]attributeIndex)
+	}
+	attributeIndexCache.entries[key] = attributeIndex{attributes: index, missing: missing, retryAfter: time.Now().Add(attributeIndexRetryBackoff)}
+	attributeIndexCache.Unlock()
+	return index, len(missing), nil
+}
+
+// matchProviderAttributes returns the fields whose name, the last segment of their path, is attribute or contains it
+func matchProviderAttributes(index []providerAttribute, attribute string, contains bool) []providerAttribute {
+	var matches []providerAttribute
+	for _, entry := range index {
+		name := strings.ToLower(entry.Path[strings.LastIndex(entry.Path, ".")+1:])
+		if name == attribute || (contains && strings.Contains(name, attribute)) {
+			matches = append(matches, entry)
+		}
+	}
+	return matches
+}
+
+func formatProviderAttributeMatches(providerDetail client.ProviderDetail, attribute string, matches []providerAttribute, failed int) string {
+	resources := make(map[string]bool)
+	for _, match := range matches {
+		resources[match.Resource] = true
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# %s/%s %s: %s with `%s`\n\n", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, providerDetail.ProviderDocumentType, attribute))
+	builder.WriteString(fmt.Sprintf("%d matching attributes in %d %s.\n", len(matches), len(resources), providerDetail.ProviderDocumentType))
+	if failed > 0 {
+		builder.WriteString(fmt.Sprintf("%d docs could not be fetched and were not searched, search again in a minute to retry them.\n", failed))
+	}
+	builder.WriteString("\n")
+
+	for i, match := range matches {
+		if i == maxAttributeMatches {
+			builder.WriteString(fmt.Sprintf("\n...%d more matches not shown, search with match 'exact' or a longer attribute name to narrow them down.\n", len(matches)-maxAttributeMatches))
+			break
+		}
+		details := []string{"type not documented"}
+		if match.Field.Type != "" {
+			details[0] = match.Field.Type
+		}
+		if match.Field.Qualifier != "" {
+			details = append(details, match.Field.Qualifier)
+		}
+		builder.WriteString(fmt.Sprintf("- %s: `%s` (%s)\n", match.Resource, match.Path, strings.Join(details, ", ")))
+	}
+	return builder.String()
+}
@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+var testAttributeIndex = []providerAttribute{
+	{Resource: "aws_ebs_volume", Path: "kms_key_id", Field: utils.DocArgument{Name: "kms_key_id", Type: "String", Qualifier: "Optional"}},
+	{Resource: "aws_instance", Path: "root_block_device.kms_key_id", Field: utils.DocArgument{Name: "kms_key_id"}},
+	{Resource: "aws_rds_cluster", Path: "master_user_secret_kms_key_id", Field: utils.DocArgument{Name: "master_user_secret_kms_key_id"}},
+	{Resource: "aws_s3_bucket", Path: "bucket", Field: utils.DocArgument{Name: "bucket"}},
+}
+
+func TestMatchProviderAttributes(t *testing.T) {
+	exact := matchProviderAttributes(testAttributeIndex, "kms_key_id", false)
+	if len(exact) != 2 || exact[0].Resource != "aws_ebs_volume" || exact[1].Path != "root_block_device.kms_key_id" {
+		t.Errorf("expected the top-level and nested kms_key_id, got %+v", exact)
+	}
+
+	contains := matchProviderAttributes(testAttributeIndex, "kms_key_id", true)
+	if len(contains) != 3 {
+		t.Errorf("expected 3 matches containing kms_key_id, got %+v", contains)
+	}
+
+	if matches := matchProviderAttributes(testAttributeIndex, "nonexistent", true); len(matches) != 0 {
+		t.Errorf("expected no matches, got %+v", matches)
+	}
+}
+
+func TestFormatProviderAttributeMatches(t *testing.T) {
+	providerDetail := client.ProviderDetail{ProviderNamespace: "hashicorp", ProviderName: "aws", ProviderVersion: "5.0.0", ProviderDocumentType: "resources"}
+	output := formatProviderAttributeMatches(providerDetail, "kms_key_id", matchProviderAttributes(testAttributeIndex, "kms_key_id", false), 1)
+
+	for _, expected := range []string{
+		"# hashicorp/aws 5.0.0: resources with `kms_key_id`",
+		"2 matching attributes in 2 resources.",
+		"1 docs could not be fetched",
+		"- aws_ebs_volume: `kms_key_id` (String, Optional)",
+		"- aws_instance: `root_block_device.kms_key_id` (type not documented)",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, output)
+		}
+	}
+}
+
+func TestGetProviderAttributeIndexCached(t *testing.T) {
+	providerDetail := client.ProviderDetail{ProviderNamespace: "hashicorp", ProviderName: "cached", ProviderVersion: "1.0.0", ProviderDocumentType: "resources"}
+	attributeIndexCache.Lock()
+	attributeIndexCache.entries["hashicorp/cached/1.0.0/resources"] = attributeIndex{attributes: testAttributeIndex}
+	attributeIndexCache.Unlock()
+	t.Cleanup(func() {
+		attributeIndexCache.Lock()
+		delete(attributeIndexCache.entries, "hashicorp/cached/1.0.0/resources")
+		attributeIndexCache.Unlock()
+	})
+
+	// A cached index is returned without a registry request, the nil client would fail one
+	index, failed, err := getProviderAttributeIndex(context.Background(), nil, providerDetail, log.New())
+	if err != nil || failed != 0 || len(index) != len(testAttributeIndex) {
+		t.Errorf("expected the cached index, got %d entries, %d failed, %v", len(index), failed, err)
+	}
+}
+
+func TestGetProviderAttributeIndexRetriesMissingDocs(t *testing.T) {
+	var mu sync.Mutex
+	requests := make(map[string]int)
+	docAvailable := false
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/v1/providers/hashicorp/partial/1.0.0":
+			fmt.Fprint(w, `{"docs": [
+				{"id": "1", "slug": "bucket", "category": "resources", "language": "hcl"},
+				{"id": "2", "slug": "volume", "category": "resources", "language": "hcl"}
+			]}`)
+		case "/v2/provider-docs/1":
+			fmt.Fprint(w, `{"data": {"id": "1", "attributes": {"content": "## Argument Reference\n\n* `+"`bucket`"+` - (Optional) Name.\n"}}}`)
+		case "/v2/provider-docs/2":
+			if !docAvailable {
+				http.NotFound(w, r)
+				return
+			}
+			fmt.Fprint(w, `{"data": {"id": "2", "attributes": {"content": "## Argument Reference\n\n* `+"`kms_key_id`"+` - (Optional) Key.\n"}}}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer registry.Close()
+	t.Setenv("TERRAFORM_REGISTRY_URL", registry.URL)
+	t.Cleanup(func() {
+		attributeIndexCache.Lock()
+		delete(attributeIndexCache.entries, "hashicorp/partial/1.0.0/resources")
+		attributeIndexCache.Unlock()
+	})
+
+	providerDetail := client.ProviderDetail{ProviderNamespace: "hashicorp", ProviderName: "partial", ProviderVersion: "1.0.0", ProviderDocumentType: "resources"}
+	index, failed, err := getProviderAttributeIndex(context.Background(), registry.Client(), providerDetail, log.New())
+	if err != nil || failed != 1 || len(index) != 1 || index[0].Resource != "partial_bucket" {
+		t.Fatalf("expected the bucket doc with 1 failure, got %+v, %d failed, %v", index, failed, err)
+	}
+
+	// Within the backoff the partial index is served without a registry request
+	if _, failed, _ := getProviderAttributeIndex(context.Background(), registry.Client(), providerDetail, log.New()); failed != 1 {
+		t.Errorf("expected the cached partial index, got %d failed", failed)
+	}
+	mu.Lock()
+	if requests["/v1/providers/hashicorp/partial/1.0.0"] != 1 || requests["/v2/provider-docs/2"] != 1 {
+		t.Errorf("expected no request within the backoff, got %v", requests)
+	}
+	docAvailable = true
+	mu.Unlock()
+
+	// After the backoff only the missing doc is fetched
+	attributeIndexCache.Lock()
+	entry := attributeIndexCache.entries["hashicorp/partial/1.0.0/resources"]
+	entry.retryAfter = time.Now().Add(-time.Second)
+	attributeIndexCache.entries["hashicorp/partial/1.0.0/resources"] = entry
+	attributeIndexCache.Unlock()
+	index, failed, err = getProviderAttributeIndex(context.Background(), registry.Client(), providerDetail, log.New())
+	if err != nil || failed != 0 || len(index) != 2 || index[1].Path != "kms_key_id" {
+		t.Fatalf("expected both docs, got %+v, %d failed, %v", index, failed, err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if requests["/v1/providers/hashicorp/partial/1.0.0"] != 1 || requests["/v2/provider-docs/1"] != 1 || requests["/v2/provider-docs/2"] != 2 {
+		t.Errorf("expected only the missing doc to be fetched again, got %v", requests)
+	}
+}
@@ -5,10 +5,10 @@ package tools
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"path"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
@@ -40,29 +40,37 @@ If there are multiple good matches, mention this but proceed with the most relev
 				mcp.Description("The name of the Terraform provider to perform the read or deployment operation"),
 			),
 			mcp.WithString("provider_namespace",
-				mcp.Required(),
-				mcp.Description("The publisher of the Terraform provider, typically the name of the company, or their GitHub organization name that created the provider"),
+				mcp.Description("The publisher of the Terraform provider, typically the name of the company, or their GitHub organization name that created the provider. Defaults to '"+DefaultProviderNamespace()+"' when not set"),
 			),
 			mcp.WithString("service_slug",
 				mcp.Required(),
 				mcp.Description("The slug of the service you want to deploy or read using the Terraform provider, prefer using a single word, use underscores for multiple words and if unsure about the service_slug, use the provider_name for its value"),
 			),
 			mcp.WithString("provider_document_type",
-				mcp.Required(),
 				mcp.Description(`The type of the document to retrieve,
 for general overview of the provider use 'overview',
 for guidance on upgrading a provider or custom configuration information use 'guides',
 for deploying resources use 'resources', for reading pre-deployed resources use 'data-sources',
 for functions use 'functions',
 for Terraform actions use 'actions',
-for listing resources using Terraform Search use 'list-resources'`),
-				mcp.Enum("resources", "data-sources", "functions", "guides", "overview", "actions", "list-resources"),
+for listing resources using Terraform Search use 'list-resources',
+for short-lived values such as credentials and tokens that are never stored in state use 'ephemeral-resources'`),
+				mcp.Enum("resources", "data-sources", "functions", "guides", "overview", "actions", "list-resources", "ephemeral-resources"),
+				mcp.DefaultString("resources"),
 			),
 			mcp.WithString("provider_version",
 				mcp.Description("The version of the Terraform provider to retrieve in the format 'x.y.z', or 'latest' to get the latest version")),
+			mcp.WithBoolean("rank_results",
+				mcp.Description("Order the results by how well their title matches the service_slug (exact match, then prefix match, then shared words) instead of the registry order. Recommended together with max_results"),
+				mcp.DefaultBool(false),
+			),
+			mcp.WithNumber("max_results",
+				mcp.Description("The maximum number of results to return, all matches are returned when not set"),
+				mcp.Min(1),
+			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return resolveProviderDocIDHandler(ctx, request, logger)
+			return resolveProviderDocIDHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -75,26 +83,40 @@ func resolveProviderDocIDHandler(ctx context.Context, request mcp.CallToolReques
 		return ToolError(logger, "failed to get http client for public Terraform registry", err)
 	}
 
-	providerDetail, err := resolveProviderDetails(request, httpClient, logger)
+	providerDetail, err := resolveProviderDetails(ctx, request, httpClient, logger)
 	if err != nil {
 		return ToolErrorf(logger, "failed to resolve provider: %v - %s", err, defaultErrorGuide)
 	}
 
 	serviceSlug, err := request.RequireString("service_slug")
 	if err != nil {
-		return ToolError(logger, "missing required input: service_slug", err)
+		return ToolArgumentError(logger, "service_slug", "is required")
 	}
 	if serviceSlug == "" {
-		return ToolError(logger, "service_slug cannot be empty", nil)
+		return ToolArgumentError(logger, "service_slug", "cannot be empty")
 	}
 	serviceSlug = strings.ToLower(serviceSlug)
 
 	providerDocumentType := request.GetString("provider_document_type", "resources")
 	providerDetail.ProviderDocumentType = providerDocumentType
 
-	// Check if we need to use v2 API for guides, functions, or overview
+	maxResults, err := utils.OptionalIntParam(request, "max_results")
+	if err != nil {
+		return ToolArgumentError(logger, "max_results", err.Error())
+	}
+	if maxResults < 0 {
+		return ToolArgumentError(logger, "max_results", fmt.Sprintf("must be at least 1, got %d", maxResults))
+	}
+	selection := docSelection{
+		Query:        serviceSlug,
+		ProviderName: providerDetail.ProviderName,
+		Rank:         request.GetBool("rank_results", false),
+		MaxResults:   maxResults,
+	}
+
+	// Check if we need to use v2 API for guides, functions, overview, or the newer categories
 	if utils.IsV2ProviderDocumentType(providerDetail.ProviderDocumentType) {
-		content, err := providerDetailsV2(httpClient, providerDetail, logger)
+		content, results, err := providerDetailsV2(ctx, httpClient, providerDetail, selection, logger)
 		if err != nil {
 			return ToolErrorf(logger, "failed to find %s documentation for provider '%s' in the '%s' namespace - %s",
 				providerDetail.ProviderDocumentType, providerDetail.ProviderName, providerDetail.ProviderNamespace, defaultErrorGuide)
@@ -103,19 +125,19 @@ func resolveProviderDocIDHandler(ctx context.Context, request mcp.CallToolReques
 		fullContent := fmt.Sprintf("# %s provider docs\n\n%s",
 			providerDetail.ProviderName, content)
 
-		return mcp.NewToolResultText(fullContent), nil
+		return utils.NewToolResultData(fullContent, results), nil
 	}
 
 	// For resources/data-sources, use the v1 API for better performance (single response)
 	uri := path.Join("providers", providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion)
-	response, err := client.SendRegistryCall(httpClient, "GET", uri, logger)
+	response, err := client.SendRegistryCall(ctx, httpClient, "GET", uri, logger, client.RegistryAPIVersions().Providers)
 	if err != nil {
 		return ToolErrorf(logger, "failed to get provider '%s' version '%s' in namespace '%s' - %s",
 			providerDetail.ProviderName, providerDetail.ProviderVersion, providerDetail.ProviderNamespace, defaultErrorGuide)
 	}
 
 	var providerDocs client.ProviderDocs
-	if err := json.Unmarshal(response, &providerDocs); err != nil {
+	if err := client.DecodeRegistryResponse(response, &providerDocs); err != nil {
 		return ToolError(logger, "failed to parse provider docs", err)
 	}
 
@@ -124,30 +146,35 @@ func resolveProviderDocIDHandler(ctx context.Context, request mcp.CallToolReques
 	builder.WriteString("Each result includes:\n- providerDocID: tfprovider-compatible identifier\n- Title: Service or resource name\n- Category: Type of document\n- Description: Brief summary of the document\n")
 	builder.WriteString("For best results, select libraries based on the service_slug match and category of information requested.\n\n---\n\n")
 
-	contentAvailable := false
+	var matches []client.ProviderDoc
 	for _, doc := range providerDocs.Docs {
 		if doc.Language == "hcl" && doc.Category == providerDetail.ProviderDocumentType {
 			cs, err := utils.ContainsSlug(doc.Slug, serviceSlug)
 			cs_pn, err_pn := utils.ContainsSlug(fmt.Sprintf("%s_%s", providerDetail.ProviderName, doc.Slug), serviceSlug)
 			if (cs || cs_pn) && err == nil && err_pn == nil {
-				contentAvailable = true
-				descriptionSnippet, err := getContentSnippet(httpClient, doc.ID, logger)
-				if err != nil {
-					logger.Warnf("Error fetching content snippet for provider doc ID: %s: %v", doc.ID, err)
-				}
-				builder.WriteString(fmt.Sprintf("- providerDocID: %s\n- Title: %s\n- Category: %s\n- Description: %s\n---\n", doc.ID, doc.Title, doc.Category, descriptionSnippet))
+				matches = append(matches, doc)
 			}
 		}
 	}
 
-	if !contentAvailable {
-		return ToolErrorf(logger, "no documentation found for service_slug '%s' - try a more relevant service_slug, or use the provider_name as the value", serviceSlug)
+	if len(matches) == 0 {
+		return ToolNotFoundErrorf(logger, "no documentation found for service_slug '%s' - try a more relevant service_slug, or use the provider_name as the value", serviceSlug)
 	}
 
-	return mcp.NewToolResultText(builder.String()), nil
+	// Snippets cost a registry call each, so they are only fetched for the results that are kept
+	selected, omitted := selectDocs(matches, func(doc client.ProviderDoc) string { return doc.Title }, selection)
+	snippets := getContentSnippets(ctx, httpClient, selected, func(doc client.ProviderDoc) string { return doc.ID }, logger)
+	results := newProviderDocSearchResults(providerDetail, omitted)
+	for i, doc := range selected {
+		builder.WriteString(fmt.Sprintf("- providerDocID: %s\n- Title: %s\n- Category: %s\n- Description: %s\n---\n", doc.ID, doc.Title, doc.Category, snippets[i]))
+		results.Results = append(results.Results, providerDocSearchResult{ProviderDocID: doc.ID, Title: doc.Title, Category: doc.Category, Description: snippets[i]})
+	}
+	writeOmittedResults(&builder, omitted)
+
+	return utils.NewToolResultData(builder.String(), results), nil
 }
 
-func resolveProviderDetails(request mcp.CallToolRequest, httpClient *http.Client, logger *log.Logger) (client.ProviderDetail, error) {
+func resolveProviderDetails(ctx context.Context, request mcp.CallToolRequest, httpClient *http.Client, logger *log.Logger) (client.ProviderDetail, error) {
 	providerDetail := client.ProviderDetail{}
 	providerName := request.GetString("provider_name", "")
 	if providerName == "" {
@@ -155,12 +182,10 @@ func resolveProviderDetails(request mcp.CallToolRequest, httpClient *http.Client
 	}
 	providerName = strings.ToLower(providerName)
 
-	providerNamespace := request.GetString("provider_namespace", "")
-	if providerNamespace == "" {
-		logger.Debugf(`provider_namespace not provided, trying the hashicorp namespace`)
-		providerNamespace = "hashicorp"
+	providerNamespace := providerNamespaceArgument(request)
+	if strings.TrimSpace(request.GetString("provider_namespace", "")) == "" {
+		logger.Debugf(`provider_namespace not provided, trying the default %s namespace`, providerNamespace)
 	}
-	providerNamespace = strings.ToLower(providerNamespace)
 
 	providerVersion := request.GetString("provider_version", "latest")
 	providerVersion = strings.ToLower(providerVersion)
@@ -170,10 +195,10 @@ func resolveProviderDetails(request mcp.CallToolRequest, httpClient *http.Client
 
 	var err error
 	providerVersionValue := ""
-	if utils.IsValidProviderVersionFormat(providerVersion) {
-		providerVersionValue = providerVersion
+	if normalized, err := utils.NormalizeVersion(providerVersion); err == nil {
+		providerVersionValue = normalized
 	} else {
-		providerVersionValue, err = client.GetLatestProviderVersion(httpClient, providerNamespace, providerName, logger)
+		providerVersionValue, err = client.GetLatestProviderVersion(ctx, httpClient, providerNamespace, providerName, logger)
 		if err != nil {
 			providerVersionValue = ""
 			logger.Debugf("Error getting latest provider version in %s namespace: %v", providerNamespace, err)
@@ -182,8 +207,8 @@ func resolveProviderDetails(request mcp.CallToolRequest, httpClient *http.Client
 
 	// If the provider version doesn't exist, try the hashicorp namespace
 	if providerVersionValue == "" {
-		tryProviderNamespace := "hashicorp"
-		providerVersionValue, err = client.GetLatestProviderVersion(httpClient, tryProviderNamespace, providerName, logger)
+		tryProviderNamespace := hashicorpNamespace
+		providerVersionValue, err = client.GetLatestProviderVersion(ctx, httpClient, tryProviderNamespace, providerName, logger)
 		if err != nil {
 			namespaceTried := providerNamespace
 			if providerNamespace != tryProviderNamespace {
@@ -207,52 +232,136 @@ func resolveProviderDetails(request mcp.CallToolRequest, httpClient *http.Client
 }
 
 // providerDetailsV2 retrieves a list of documentation items for a specific provider category using v2 API
-func providerDetailsV2(httpClient *http.Client, providerDetail client.ProviderDetail, logger *log.Logger) (string, error) {
-	providerVersionID, err := client.GetProviderVersionID(httpClient, providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, logger)
+func providerDetailsV2(ctx context.Context, httpClient *http.Client, providerDetail client.ProviderDetail, selection docSelection, logger *log.Logger) (string, providerDocSearchResults, error) {
+	providerVersionID, err := client.GetProviderVersionID(ctx, httpClient, providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion, logger)
 	if err != nil {
-		return "", fmt.Errorf("getting provider version ID: %w", err)
+		return "", providerDocSearchResults{}, fmt.Errorf("getting provider version ID: %w", err)
 	}
 
 	category := providerDetail.ProviderDocumentType
 	if category == "overview" {
-		return client.GetProviderOverviewDocs(httpClient, providerVersionID, logger)
+		content, err := client.GetProviderOverviewDocs(ctx, httpClient, providerVersionID, logger)
+		if err != nil {
+			return "", providerDocSearchResults{}, err
+		}
+		results := newProviderDocSearchResults(providerDetail, 0)
+		results.Content = content
+		return content, results, nil
 	}
 
 	uriPrefix := fmt.Sprintf("provider-docs?filter[provider-version]=%s&filter[category]=%s&filter[language]=hcl",
 		providerVersionID, category)
 
-	docs, err := client.SendPaginatedRegistryCall(httpClient, uriPrefix, logger)
+	docs, err := client.SendPaginatedRegistryCall(ctx, httpClient, uriPrefix, logger)
 	if err != nil {
-		return "", fmt.Errorf("getting provider documentation: %w", err)
+		return "", providerDocSearchResults{}, fmt.Errorf("getting provider documentation: %w", err)
 	}
 
 	if len(docs) == 0 {
-		return "", fmt.Errorf("no %s documentation found for provider version %s", category, providerVersionID)
+		return "", providerDocSearchResults{}, fmt.Errorf("no %s documentation found for provider version %s", category, providerVersionID)
 	}
 
 	var builder strings.Builder
 	builder.WriteString(fmt.Sprintf("Available Documentation (top matches) for %s in Terraform provider %s/%s version: %s\n\n", providerDetail.ProviderDocumentType, providerDetail.ProviderNamespace, providerDetail.ProviderName, providerDetail.ProviderVersion))
 	builder.WriteString("Each result includes:\n- providerDocID: tfprovider-compatible identifier\n- Title: Service or resource name\n- Category: Type of document\n- Description: Brief summary of the document\n")
 	builder.WriteString("For best results, select libraries based on the service_slug match and category of information requested.\n\n---\n\n")
-	for _, doc := range docs {
-		descriptionSnippet, err := getContentSnippet(httpClient, doc.ID, logger)
-		if err != nil {
-			logger.Warnf("Error fetching content snippet for provider doc ID: %s: %v", doc.ID, err)
+	selected, omitted := selectDocs(docs, func(doc client.ProviderDocData) string { return doc.Attributes.Title }, selection)
+	snippets := getContentSnippets(ctx, httpClient, selected, func(doc client.ProviderDocData) string { return doc.ID }, logger)
+	results := newProviderDocSearchResults(providerDetail, omitted)
+	for i, doc := range selected {
+		builder.WriteString(fmt.Sprintf("- providerDocID: %s\n- Title: %s\n- Category: %s\n- Description: %s\n---\n", doc.ID, doc.Attributes.Title, doc.Attributes.Category, snippets[i]))
+		results.Results = append(results.Results, providerDocSearchResult{ProviderDocID: doc.ID, Title: doc.Attributes.Title, Category: doc.Attributes.Category, Description: snippets[i]})
+	}
+	writeOmittedResults(&builder, omitted)
+
+	return builder.String(), results, nil
+}
+
+// providerDocSearchResults is the typed result of search_providers
+type providerDocSearchResults struct {
+	Provider string                    `json:"provider"`
+	Version  string                    `json:"version"`
+	Category string                    `json:"category"`
+	Results  []providerDocSearchResult `json:"results"`
+	// Omitted is the number of less relevant results left out by max_results
+	Omitted int `json:"omitted,omitempty"`
+	// Content is the overview page, which has no results to choose from
+	Content string `json:"content,omitempty"`
+}
+
+type providerDocSearchResult struct {
+	ProviderDocID string `json:"provider_doc_id"`
+	Title         string `json:"title"`
+	Category      string `json:"category"`
+	Description   string `json:"description"`
+}
+
+func newProviderDocSearchResults(providerDetail client.ProviderDetail, omitted int) providerDocSearchResults {
+	return providerDocSearchResults{
+		Provider: providerDetail.ProviderNamespace + "/" + providerDetail.ProviderName,
+		Version:  providerDetail.ProviderVersion,
+		Category: providerDetail.ProviderDocumentType,
+		Results:  []providerDocSearchResult{},
+		Omitted:  omitted,
+	}
+}
+
+// docSelection controls the order and number of documents listed by search_providers
+type docSelection struct {
+	Query        string
+	ProviderName string
+	Rank         bool
+	MaxResults   int // 0 keeps all results
+}
+
+// selectDocs orders the documents by how well their title matches the query when ranking is enabled, keeping
+// the registry order between equally relevant documents, and trims the list to MaxResults. The number of
+// documents left out is returned with the selection.
+func selectDocs[T any](docs []T, title func(T) string, selection docSelection) ([]T, int) {
+	selected := slices.Clone(docs)
+	if selection.Rank {
+		scores := make(map[string]int, len(selected))
+		for _, doc := range selected {
+			scores[title(doc)] = utils.ScoreTitleMatch(title(doc), selection.Query, selection.ProviderName)
 		}
-		builder.WriteString(fmt.Sprintf("- providerDocID: %s\n- Title: %s\n- Category: %s\n- Description: %s\n---\n", doc.ID, doc.Attributes.Title, doc.Attributes.Category, descriptionSnippet))
+		slices.SortStableFunc(selected, func(a, b T) int { return scores[title(b)] - scores[title(a)] })
+	}
+	if selection.MaxResults > 0 && len(selected) > selection.MaxResults {
+		return selected[:selection.MaxResults], len(selected) - selection.MaxResults
+	}
+	return selected, 0
+}
+
+func writeOmittedResults(builder *strings.Builder, omitted int) {
+	if omitted > 0 {
+		builder.WriteString(fmt.Sprintf("\n%d less relevant results were omitted, raise max_results to see them.\n", omitted))
 	}
+}
 
-	return builder.String(), nil
+// getContentSnippets fetches the description snippets of the docs concurrently, in the order of the docs. A snippet
+// that cannot be fetched is left empty.
+func getContentSnippets[T any](ctx context.Context, httpClient *http.Client, docs []T, docID func(T) string, logger *log.Logger) []string {
+	results := client.ParallelRegistryCalls(docs, 0, func(doc T) (string, error) {
+		return getContentSnippet(ctx, httpClient, docID(doc), logger)
+	})
+	snippets := make([]string, len(results))
+	for i, result := range results {
+		if result.Err != nil {
+			logger.Warnf("Error fetching content snippet for provider doc ID: %s: %v", docID(docs[i]), result.Err)
+		}
+		snippets[i] = result.Value
+	}
+	return snippets
 }
 
-func getContentSnippet(httpClient *http.Client, docID string, logger *log.Logger) (string, error) {
-	docContent, err := client.SendRegistryCall(httpClient, "GET", fmt.Sprintf("provider-docs/%s", docID), logger, "v2")
+func getContentSnippet(ctx context.Context, httpClient *http.Client, docID string, logger *log.Logger) (string, error) {
+	docContent, err := client.SendRegistryCall(ctx, httpClient, "GET", fmt.Sprintf("provider-docs/%s", docID), logger, client.RegistryAPIVersions().ProviderDocs)
 	if err != nil {
 		return "", fmt.Errorf("fetching provider-docs/%s: %w", docID, err)
 	}
 
 	var docDescription client.ProviderResourceDetails
-	if err := json.Unmarshal(docContent, &docDescription); err != nil {
+	if err := client.DecodeRegistryResponse(docContent, &docDescription); err != nil {
 		return "", fmt.Errorf("unmarshalling provider-docs/%s: %w", docID, err)
 	}
 
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestSelectDocs(t *testing.T) {
+	docs := []client.ProviderDoc{
+		{ID: "1", Title: "s3_bucket_policy"},
+		{ID: "2", Title: "s3control_bucket"},
+		{ID: "3", Title: "s3_bucket"},
+		{ID: "4", Title: "s3_bucket_acl"},
+	}
+	title := func(doc client.ProviderDoc) string { return doc.Title }
+	ids := func(docs []client.ProviderDoc) []string {
+		var result []string
+		for _, doc := range docs {
+			result = append(result, doc.ID)
+		}
+		return result
+	}
+
+	selected, omitted := selectDocs(docs, title, docSelection{Query: "s3_bucket", ProviderName: "aws", Rank: true})
+	if got := ids(selected); !slices.Equal(got, []string{"3", "1", "4", "2"}) || omitted != 0 {
+		t.Errorf("expected the exact match first and equally relevant docs in registry order, got %v (%d omitted)", got, omitted)
+	}
+
+	selected, omitted = selectDocs(docs, title, docSelection{Query: "s3_bucket", ProviderName: "aws", Rank: true, MaxResults: 2})
+	if got := ids(selected); !slices.Equal(got, []string{"3", "1"}) || omitted != 2 {
+		t.Errorf("expected the two best matches, got %v (%d omitted)", got, omitted)
+	}
+
+	selected, _ = selectDocs(docs, title, docSelection{Query: "s3_bucket", ProviderName: "aws", MaxResults: 3})
+	if got := ids(selected); !slices.Equal(got, []string{"1", "2", "3"}) {
+		t.Errorf("expected the registry order without ranking, got %v", got)
+	}
+	if ids(docs)[0] != "1" {
+		t.Errorf("expected the input to be left untouched")
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	defaultRegistrySearchResults = 10
+	maxRegistrySearchResults     = 50
+	// registrySearchProviderPageSize is the largest page the providers API serves
+	registrySearchProviderPageSize = 100
+	// maxRegistrySearchProviderPages bounds the pages listed per provider tier, well above the size of the partner tier
+	maxRegistrySearchProviderPages = 10
+)
+
+// registrySearchProviderTiers are the provider tiers searched by search_registry, the community tier is too large
+// to list and is covered by search_providers
+var registrySearchProviderTiers = []string{"official", "partner"}
+
+// registrySearchStopWords are left out when matching providers against a natural language query
+var registrySearchStopWords = []string{"a", "an", "and", "create", "deploy", "do", "for", "how", "i", "in", "manage", "of", "on", "provision", "set", "the", "to", "up", "use", "using", "with", "terraform"}
+
+// registrySearchResult is a module or provider matching a search_registry query
+type registrySearchResult struct {
+	Type        string `json:"type"` // "module" or "provider"
+	ID          string `json:"id"`   // module_id, or namespace/name of a provider
+	Description string `json:"description"`
+	Downloads   int64  `json:"downloads"`
+	Verified    bool   `json:"verified,omitempty"` // modules only
+	Tier        string `json:"tier,omitempty"`     // providers only
+}
+
+// registrySearchResults is the typed result of search_registry
+type registrySearchResults struct {
+	Query   string                 `json:"query"`
+	Results []registrySearchResult `json:"results"`
+	// ModulesFound and ProvidersFound are the number of matches before max_results was applied
+	ModulesFound   int `json:"modules_found"`
+	ProvidersFound int `json:"providers_found"`
+}
+
+// SearchRegistry creates a tool that searches both the modules and the providers of the registry.
+func SearchRegistry(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("search_registry",
+			mcp.WithDescription(`Searches the public Terraform registry for both modules and providers matching a query, e.g. "kubernetes cluster" or "dns", and returns the results tagged with their type, modules and providers interleaved.
+Use it when it is not clear whether a module or the resources of a provider are the better fit. Follow up with 'get_module_details' for a module_id, or with 'search_providers' for a provider.
+Providers are searched among the official and partner tiers; use 'search_modules' or 'search_providers' directly to page through more results of one type.`),
+			mcp.WithTitleAnnotation("Search Terraform modules and providers"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("query",
+				mcp.Required(),
+				mcp.Description("What to provision, e.g. 'vpc', 'kubernetes cluster', 'dns records'"),
+			),
+			mcp.WithNumber("max_results",
+				mcp.Description(fmt.Sprintf("Maximum number of modules and providers returned in total, at most %d", maxRegistrySearchResults)),
+				mcp.Min(1),
+				mcp.Max(maxRegistrySearchResults),
+				mcp.DefaultNumber(defaultRegistrySearchResults),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return searchRegistryHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func searchRegistryHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	query, err := request.RequireString("query")
+	if err != nil || strings.TrimSpace(query) == "" {
+		return ToolArgumentError(logger, "query", "is required")
+	}
+	query = strings.ToLower(strings.TrimSpace(query))
+	maxResults := request.GetInt("max_results", defaultRegistrySearchResults)
+	if maxResults < 1 || maxResults > maxRegistrySearchResults {
+		return ToolArgumentError(logger, "max_results", fmt.Sprintf("must be between 1 and %d, got %d", maxRegistrySearchResults, maxResults))
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	// A failed search of one type still returns the results of the other
+	modules, moduleErr := searchRegistryModules(ctx, httpClient, query, logger)
+	if moduleErr != nil {
+		logger.Warnf("Module search for %q failed: %v", query, moduleErr)
+	}
+	providers, providerErr := searchRegistryProviders(ctx, httpClient, query, logger)
+	if providerErr != nil {
+		logger.Warnf("Provider search for %q failed: %v", query, providerErr)
+	}
+	if moduleErr != nil && providerErr != nil {
+		return ToolErrorf(logger, "failed to search the registry for: %s: %v", query, moduleErr)
+	}
+
+	results := interleaveRegistrySearchResults(providers, modules, maxResults)
+	if len(results) == 0 {
+		// Nothing found by one search does not mean there is nothing to find when the other one failed
+		if searchErr := cmp.Or(moduleErr, providerErr); searchErr != nil {
+			return ToolErrorf(logger, "failed to search the registry for: %s: %v", query, searchErr)
+		}
+		return ToolNotFoundErrorf(logger, "no modules or providers found for query: %s - try a different search term", query)
+	}
+	return utils.NewToolResultData(formatRegistrySearchResults(query, results, len(modules), len(providers)), registrySearchResults{
+		Query:          query,
+		Results:        results,
+		ModulesFound:   len(modules),
+		ProvidersFound: len(providers),
+	}), nil
+}
+
+// searchRegistryModules returns the modules matching the query, in the registry's search order
+func searchRegistryModules(ctx context.Context, httpClient *http.Client, query string, logger *log.Logger) ([]registrySearchResult, error) {
+	response, err := sendSearchModulesCall(ctx, httpClient, query, 0, false, logger)
+	if err != nil {
+		return nil, err
+	}
+	var terraformModules client.TerraformModules
+	if err := client.DecodeRegistryResponse(response, &terraformModules); err != nil {
+		return nil, fmt.Errorf("unmarshalling modules: %w", err)
+	}
+	results := make([]registrySearchResult, 0, len(terraformModules.Data))
+	for _, module := range terraformModules.Data {
+		results = append(results, registrySearchResult{
+			Type:        "module",
+			ID:          module.ID,
+			Description: module.Description,
+			Downloads:   module.Downloads,
+			Verified:    module.Verified,
+		})
+	}
+	return results, nil
+}
+
+// searchRegistryProviders lists the official and partner providers and keeps those matching the query, best
+// matches first
+func searchRegistryProviders(ctx context.Context, httpClient *http.Client, query string, logger *log.Logger) ([]registrySearchResult, error) {
+	lists := client.ParallelRegistryCalls(registrySearchProviderTiers, 0, func(tier string) ([]client.ProviderList, error) {
+		return listRegistryProviderTier(ctx, httpClient, tier, logger)
+	})
+	if err := client.ParallelErrors(lists); err != nil && !slices.ContainsFunc(lists, func(list client.ParallelResult[[]client.ProviderList]) bool { return list.Err == nil }) {
+		return nil, err
+	}
+
+	var providers []client.ProviderList
+	for _, list := range lists {
+		if list.Err == nil {
+			providers = append(providers, list.Value...)
+		}
+	}
+	return matchRegistryProviders(query, providers), nil
+}
+
+// listRegistryProviderTier returns the pages of the providers of a tier, following the next page of the pagination
+// up to maxRegistrySearchProviderPages pages
+func listRegistryProviderTier(ctx context.Context, httpClient *http.Client, tier string, logger *log.Logger) ([]client.ProviderList, error) {
+	var pages []client.ProviderList
+	for page := 1; page > 0 && len(pages) < maxRegistrySearchProviderPages; {
+		query := url.Values{"filter[tier]": {tier}, "page[size]": {strconv.Itoa(registrySearchProviderPageSize)}, "page[number]": {strconv.Itoa(page)}}
+		uri := (&url.URL{Path: "providers", RawQuery: query.Encode()}).String()
+		response, err := client.SendRegistryCall(ctx, httpClient, http.MethodGet, uri, logger, client.RegistryAPIVersions().ProviderDocs)
+		if err != nil {
+			return nil, err
+		}
+		var providers client.ProviderList
+		if err := client.DecodeRegistryResponse(response, &providers); err != nil {
+			return nil, fmt.Errorf("unmarshalling %s providers: %w", tier, err)
+		}
+		pages = append(pages, providers)
+		if next := providers.Meta.Pagination.NextPage; next > page {
+			page = next
+		} else {
+			page = 0
+		}
+	}
+	return pages, nil
+}
+
+// matchRegistryProviders scores the providers against the words of the query: a word naming the provider counts
+// more than one found in its description. Providers without any matching word are dropped.
+func matchRegistryProviders(query string, lists []client.ProviderList) []registrySearchResult {
+	words := registrySearchWords(query)
+	type scoredProvider struct {
+		result registrySearchResult
+		score  int
+	}
+	var scored []scoredProvider
+	seen := make(map[string]bool)
+	for _, list := range lists {
+		for _, provider := range list.Data {
+			attributes := provider.Attributes
+			id := strings.ToLower(attributes.Namespace + "/" + attributes.Name)
+			if attributes.Unlisted || seen[id] {
+				continue
+			}
+			names := registrySearchWords(strings.Join([]string{attributes.Name, attributes.Alias, attributes.FullName}, " "))
+			description := registrySearchWords(attributes.Description)
+			score := 0
+			for _, word := range words {
+				switch {
+				case slices.Contains(names, word):
+					score += 3
+				case slices.Contains(description, word):
+					score++
+				}
+			}
+			if score == 0 {
+				continue
+			}
+			seen[id] = true
+			scored = append(scored, scoredProvider{
+				result: registrySearchResult{
+					Type:        "provider",
+					ID:          id,
+					Description: attributes.Description,
+					Downloads:   int64(attributes.Downloads),
+					Tier:        attributes.Tier,
+				},
+				score: score,
+			})
+		}
+	}
+	sort.SliceStable(scored, func(i, j int) bool {
+		if scored[i].score != scored[j].score {
+			return scored[i].score > scored[j].score
+		}
+		return scored[i].result.Downloads > scored[j].result.Downloads
+	})
+
+	results := make([]registrySearchResult, 0, len(scored))
+	for _, provider := range scored {
+		results = append(results, provider.result)
+	}
+	return results
+}
+
+// registrySearchWords splits text into lowercase words, without stop words
+func registrySearchWords(text string) []string {
+	var words []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if !slices.Contains(registrySearchStopWords, word) {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// interleaveRegistrySearchResults alternates providers and modules, so both are represented within the limit
+func interleaveRegistrySearchResults(providers, modules []registrySearchResult, limit int) []registrySearchResult {
+	results := make([]registrySearchResult, 0, min(limit, len(providers)+len(modules)))
+	for i := 0; len(results) < limit && (i < len(providers) || i < len(modules)); i++ {
+		if i < len(providers) {
+			results = append(results, providers[i])
+		}
+		if i < len(modules) && len(results) < limit {
+			results = append(results, modules[i])
+		}
+	}
+	return results
+}
+
+func formatRegistrySearchResults(query string, results []registrySearchResult, moduleMatches, providerMatches int) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("Terraform registry results for %s (%d of %d provider and %d module matches)\n\n", query, len(results), providerMatches, moduleMatches))
+	builder.WriteString("Each result includes:\n")
+	builder.WriteString("- type: 'provider' or 'module'\n")
+	builder.WriteString("- id: namespace/name of a provider, to use with search_providers, or the module_id of a module, to use with get_module_details\n")
+	builder.WriteString("- Description: A short description\n")
+	builder.WriteString("- Downloads: The total number of downloads\n")
+	builder.WriteString("- Tier (providers) or Verified (modules)\n")
+	builder.WriteString("\n---\n\n")
+	for _, result := range results {
+		builder.WriteString(fmt.Sprintf("- type: %s\n", result.Type))
+		builder.WriteString(fmt.Sprintf("- id: %s\n", result.ID))
+		builder.WriteString(fmt.Sprintf("- Description: %s\n", result.Description))
+		builder.WriteString(fmt.Sprintf("- Downloads: %d\n", result.Downloads))
+		if result.Type == "provider" {
+			builder.WriteString(fmt.Sprintf("- Tier: %s\n", result.Tier))
+		} else {
+			builder.WriteString(fmt.Sprintf("- Verified: %t\n", result.Verified))
+		}
+		builder.WriteString("---\n")
+	}
+	return builder.String()
+}
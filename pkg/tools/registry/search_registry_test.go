@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	log "github.com/sirupsen/logrus"
+)
+
+func TestMatchRegistryProviders(t *testing.T) {
+	response := `{"data": [
+  {"id": "1", "attributes": {"namespace": "hashicorp", "name": "kubernetes", "full-name": "hashicorp/kubernetes", "description": "Manage Kubernetes objects", "downloads": 100, "tier": "official"}},
+  {"id": "2", "attributes": {"namespace": "hashicorp", "name": "helm", "full-name": "hashicorp/helm", "description": "Deploy Helm charts on Kubernetes", "downloads": 500, "tier": "official"}},
+  {"id": "3", "attributes": {"namespace": "hashicorp", "name": "dns", "full-name": "hashicorp/dns", "description": "DNS records", "downloads": 900, "tier": "official"}},
+  {"id": "4", "attributes": {"namespace": "acme", "name": "kubernetes", "full-name": "acme/kubernetes", "description": "Hidden", "downloads": 1, "tier": "partner", "unlisted": true}}
+]}`
+	var providers client.ProviderList
+	if err := json.Unmarshal([]byte(response), &providers); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	results := matchRegistryProviders("Provision a Kubernetes cluster", []client.ProviderList{providers})
+	var ids []string
+	for _, result := range results {
+		ids = append(ids, result.ID)
+	}
+	if got, expected := strings.Join(ids, ","), "hashicorp/kubernetes,hashicorp/helm"; got != expected {
+		t.Errorf("expected providers %s, got %s", expected, got)
+	}
+}
+
+func TestInterleaveRegistrySearchResults(t *testing.T) {
+	providers := []registrySearchResult{{Type: "provider", ID: "p1"}, {Type: "provider", ID: "p2"}}
+	modules := []registrySearchResult{{Type: "module", ID: "m1"}, {Type: "module", ID: "m2"}, {Type: "module", ID: "m3"}, {Type: "module", ID: "m4"}}
+
+	tests := []struct {
+		limit    int
+		expected string
+	}{
+		{limit: 10, expected: "p1,m1,p2,m2,m3,m4"},
+		{limit: 3, expected: "p1,m1,p2"},
+		{limit: 1, expected: "p1"},
+	}
+	for _, tc := range tests {
+		var ids []string
+		for _, result := range interleaveRegistrySearchResults(providers, modules, tc.limit) {
+			ids = append(ids, result.ID)
+		}
+		if got := strings.Join(ids, ","); got != tc.expected {
+			t.Errorf("limit %d: expected %s, got %s", tc.limit, tc.expected, got)
+		}
+	}
+}
+
+func TestFormatRegistrySearchResults(t *testing.T) {
+	results := []registrySearchResult{
+		{Type: "provider", ID: "hashicorp/dns", Description: "DNS records", Downloads: 900, Tier: "official"},
+		{Type: "module", ID: "acme/dns/aws/1.0.0", Description: "Route53 zones", Downloads: 12, Verified: true},
+	}
+	output := formatRegistrySearchResults("dns", results, 3, 1)
+	for _, expected := range []string{
+		"(2 of 1 provider and 3 module matches)",
+		"- type: provider\n- id: hashicorp/dns\n- Description: DNS records\n- Downloads: 900\n- Tier: official\n",
+		"- type: module\n- id: acme/dns/aws/1.0.0\n- Description: Route53 zones\n- Downloads: 12\n- Verified: true\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, output)
+		}
+	}
+}
+
+// providerPagesTransport serves three pages of one provider each
+type providerPagesTransport struct{}
+
+func (providerPagesTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	page := req.URL.Query().Get("page[number]")
+	next := "null"
+	if page != "3" {
+		next = fmt.Sprintf("%c", page[0]+1)
+	}
+	body := fmt.Sprintf(`{"data":[{"attributes":{"name":"provider%s","namespace":"acme"}}],"meta":{"pagination":{"current-page":%s,"next-page":%s}}}`, page, page, next)
+	return &http.Response{StatusCode: http.StatusOK, Status: "200 OK", Body: io.NopCloser(strings.NewReader(body)), Header: http.Header{}}, nil
+}
+
+func TestListRegistryProviderTierFollowsPages(t *testing.T) {
+	pages, err := listRegistryProviderTier(context.Background(), &http.Client{Transport: providerPagesTransport{}}, "partner", log.New())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var names []string
+	for _, page := range pages {
+		for _, provider := range page.Data {
+			names = append(names, provider.Attributes.Name)
+		}
+	}
+	if got := strings.Join(names, ","); got != "provider1,provider2,provider3" {
+		t.Errorf("expected the providers of every page, got %s", got)
+	}
+}
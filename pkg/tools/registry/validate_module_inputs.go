@@ -0,0 +1,507 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	// allowedValuesRegex matches the allowed values modules commonly document in input descriptions,
+	// e.g. "Valid values are `default` or `dedicated`"
+	allowedValuesRegex = regexp.MustCompile("(?i)(?:valid|allowed|possible|supported) values (?:are|is|include)?:?\\s*([^.\\n]+)")
+	// quotedValueRegex matches the backticked or double-quoted values of an allowed values list
+	quotedValueRegex = regexp.MustCompile("`([^`]+)`|\"([^\"]+)\"")
+)
+
+// ValidateModuleInputs creates a tool that checks proposed input values against the inputs declared by a module.
+func ValidateModuleInputs(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("validate_module_inputs",
+			mcp.WithDescription(`Checks proposed input values for a Terraform module before running Terraform: each value is checked against the input's declared type (e.g. passing a string where a list is expected), required inputs must be set, unknown inputs are reported, and values must be one of the allowed values documented in the input description.
+Validation blocks are not published by the registry, so custom validation conditions are not evaluated. You must call 'search_modules' first to obtain a valid module_id.`),
+			mcp.WithTitleAnnotation("Validate input values against the inputs declared by a Terraform module"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("module_id",
+				mcp.Required(),
+				mcp.Description("Exact valid and compatible module_id retrieved from search_modules (e.g., 'terraform-aws-modules/vpc/aws/5.0.0')"),
+			),
+			mcp.WithObject("inputs",
+				mcp.Required(),
+				mcp.Description("The proposed input values keyed by input name, as JSON values, e.g. {\"cidr\": \"10.0.0.0/16\", \"azs\": [\"eu-west-1a\"]}"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return validateModuleInputsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func validateModuleInputsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	moduleID, err := request.RequireString("module_id")
+	if err != nil || moduleID == "" {
+		return ToolArgumentError(logger, "module_id", "is required")
+	}
+	if err := validateModuleID(moduleID); err != nil {
+		return ToolArgumentError(logger, "module_id", err.Error())
+	}
+	moduleID = strings.ToLower(moduleID)
+
+	values, err := moduleInputValues(request)
+	if err != nil {
+		return ToolArgumentError(logger, "inputs", err.Error())
+	}
+
+	httpClient, err := client.GetHttpClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get http client for public Terraform registry", err)
+	}
+
+	inputs, err := getModuleInputs(ctx, httpClient, moduleID, logger)
+	if err != nil {
+		return ToolErrorf(logger, "failed to get module %s: %v%s", moduleID, err, notFoundHint(err, "use search_modules first to find valid module IDs"))
+	}
+
+	findings := validateModuleInputValues(inputs, values)
+	validation := moduleInputValidation{ModuleID: moduleID, Valid: true, Findings: append([]moduleInputFinding{}, findings...)}
+	for _, finding := range findings {
+		validation.Valid = validation.Valid && finding.Status == "ok"
+	}
+	return utils.NewToolResultData(formatModuleInputFindings(moduleID, findings), validation), nil
+}
+
+// moduleInputValues returns the inputs argument, accepting an object or a JSON-encoded object
+func moduleInputValues(request mcp.CallToolRequest) (map[string]any, error) {
+	switch v := request.GetArguments()["inputs"].(type) {
+	case map[string]any:
+		return v, nil
+	case string:
+		var values map[string]any
+		if err := json.Unmarshal([]byte(v), &values); err != nil {
+			return nil, fmt.Errorf("inputs must be an object of input names to values: %w", err)
+		}
+		return values, nil
+	case nil:
+		return nil, fmt.Errorf("inputs is required")
+	default:
+		return nil, fmt.Errorf("inputs must be an object of input names to values, got %T", v)
+	}
+}
+
+// moduleInputFinding is the validation result of a single module input
+type moduleInputFinding struct {
+	Input    string `json:"input"`
+	Status   string `json:"status"` // "ok", "type_mismatch", "rule_violation", "missing_required" or "unknown_input"
+	Expected string `json:"expected,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// moduleInputValidation is the typed result of validate_module_inputs
+type moduleInputValidation struct {
+	ModuleID string               `json:"module_id"`
+	Valid    bool                 `json:"valid"`
+	Findings []moduleInputFinding `json:"findings"`
+}
+
+// validateModuleInputValues checks the proposed values against the declared inputs, returning one finding per
+// proposed or required input sorted by input name
+func validateModuleInputValues(inputs []client.ModuleInput, values map[string]any) []moduleInputFinding {
+	declared := make(map[string]client.ModuleInput, len(inputs))
+	var findings []moduleInputFinding
+	for _, input := range inputs {
+		declared[input.Name] = input
+		if _, ok := values[input.Name]; !ok && input.Required {
+			findings = append(findings, moduleInputFinding{Input: input.Name, Status: "missing_required", Expected: hclTypeExpression(input.Type), Message: "required input has no value"})
+		}
+	}
+
+	for name, value := range values {
+		input, ok := declared[name]
+		if !ok {
+			findings = append(findings, moduleInputFinding{Input: name, Status: "unknown_input", Message: "the module does not declare this input"})
+			continue
+		}
+
+		expected := hclTypeExpression(input.Type)
+		finding := moduleInputFinding{Input: name, Status: "ok", Expected: expected}
+		constraint, err := parseTypeConstraint(expected)
+		if err != nil {
+			finding.Message = fmt.Sprintf("type %s could not be parsed, value not checked", expected)
+		} else if problems := constraint.check(value, name); len(problems) > 0 {
+			finding.Status = "type_mismatch"
+			finding.Message = strings.Join(problems, "; ")
+		} else if allowed := documentedAllowedValues(input.Description); len(allowed) > 0 {
+			if s, ok := value.(string); ok && !slices.Contains(allowed, s) {
+				finding.Status = "rule_violation"
+				finding.Message = fmt.Sprintf("%q is not one of the documented values: %s", s, strings.Join(allowed, ", "))
+			}
+		}
+		findings = append(findings, finding)
+	}
+
+	sort.Slice(findings, func(i, j int) bool { return findings[i].Input < findings[j].Input })
+	return findings
+}
+
+// documentedAllowedValues extracts the allowed values listed in an input description, if any
+func documentedAllowedValues(description string) []string {
+	match := allowedValuesRegex.FindStringSubmatch(description)
+	if match == nil {
+		return nil
+	}
+	var values []string
+	for _, quoted := range quotedValueRegex.FindAllStringSubmatch(match[1], -1) {
+		value := quoted[1]
+		if value == "" {
+			value = quoted[2]
+		}
+		values = append(values, value)
+	}
+	// A single value is more likely an example than an exhaustive list
+	if len(values) < 2 {
+		return nil
+	}
+	return values
+}
+
+func formatModuleInputFindings(moduleID string, findings []moduleInputFinding) string {
+	problems := 0
+	for _, finding := range findings {
+		if finding.Status != "ok" {
+			problems++
+		}
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# Input validation for %s\n\n", moduleID))
+	if problems == 0 {
+		builder.WriteString("All proposed inputs are valid.\n\n")
+	} else {
+		builder.WriteString(fmt.Sprintf("**Problems:** %d of %d inputs\n\n", problems, len(findings)))
+	}
+	builder.WriteString("| input | status | expected type | finding |\n")
+	builder.WriteString("|---|---|---|---|\n")
+	for _, finding := range findings {
+		message := strings.ReplaceAll(finding.Message, "|", "\\|")
+		expected := strings.Join(strings.Fields(finding.Expected), " ")
+		builder.WriteString(fmt.Sprintf("| %s | %s | %s | %s |\n", finding.Input, finding.Status, expected, message))
+	}
+	return builder.String()
+}
+
+// typeConstraint is a parsed Terraform type constraint, e.g. list(object({ name = string }))
+type typeConstraint struct {
+	Kind     string // "string", "number", "bool", "any", "list", "set", "map", "tuple" or "object"
+	Elem     *typeConstraint
+	Elems    []*typeConstraint
+	Attrs    map[string]*typeConstraint
+	Optional map[string]bool
+}
+
+// parseTypeConstraint parses a Terraform type constraint expression
+func parseTypeConstraint(expression string) (*typeConstraint, error) {
+	p := &typeParser{input: expression}
+	constraint, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos != len(p.input) {
+		return nil, fmt.Errorf("unexpected %q at offset %d", p.input[p.pos:], p.pos)
+	}
+	return constraint, nil
+}
+
+type typeParser struct {
+	input string
+	pos   int
+}
+
+func (p *typeParser) skipSpace() {
+	for p.pos < len(p.input) && strings.ContainsRune(" \t\r\n,", rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *typeParser) expect(token byte) error {
+	p.skipSpace()
+	if p.pos >= len(p.input) || p.input[p.pos] != token {
+		return fmt.Errorf("expected %q at offset %d", token, p.pos)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *typeParser) peek(token byte) bool {
+	p.skipSpace()
+	return p.pos < len(p.input) && p.input[p.pos] == token
+}
+
+func (p *typeParser) ident() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if c != '_' && c != '-' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			break
+		}
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+// skipExpression skips the default value of optional(type, default), which may contain nested brackets
+func (p *typeParser) skipExpression() {
+	depth := 0
+	for p.pos < len(p.input) {
+		switch p.input[p.pos] {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			if depth == 0 {
+				return
+			}
+			depth--
+		case '"':
+			p.pos++
+			for p.pos < len(p.input) && p.input[p.pos] != '"' {
+				if p.input[p.pos] == '\\' {
+					p.pos++
+				}
+				p.pos++
+			}
+		}
+		p.pos++
+	}
+}
+
+func (p *typeParser) parseType() (*typeConstraint, error) {
+	name := p.ident()
+	switch name {
+	case "string", "number", "bool", "any":
+		return &typeConstraint{Kind: name}, nil
+	case "list", "set", "map":
+		if err := p.expect('('); err != nil {
+			return nil, err
+		}
+		elem, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+		return &typeConstraint{Kind: name, Elem: elem}, p.expect(')')
+	case "tuple":
+		if err := p.expect('('); err != nil {
+			return nil, err
+		}
+		if err := p.expect('['); err != nil {
+			return nil, err
+		}
+		constraint := &typeConstraint{Kind: name}
+		for !p.peek(']') {
+			elem, err := p.parseType()
+			if err != nil {
+				return nil, err
+			}
+			constraint.Elems = append(constraint.Elems, elem)
+		}
+		p.pos++
+		return constraint, p.expect(')')
+	case "object":
+		if err := p.expect('('); err != nil {
+			return nil, err
+		}
+		if err := p.expect('{'); err != nil {
+			return nil, err
+		}
+		constraint := &typeConstraint{Kind: name, Attrs: make(map[string]*typeConstraint), Optional: make(map[string]bool)}
+		for !p.peek('}') {
+			var attr string
+			if p.peek('"') {
+				end := strings.IndexByte(p.input[p.pos+1:], '"')
+				if end < 0 {
+					return nil, fmt.Errorf("unterminated attribute name at offset %d", p.pos)
+				}
+				attr = p.input[p.pos+1 : p.pos+1+end]
+				p.pos += end + 2
+			} else {
+				attr = p.ident()
+			}
+			if attr == "" {
+				return nil, fmt.Errorf("expected attribute name at offset %d", p.pos)
+			}
+			if !p.peek('=') && !p.peek(':') {
+				return nil, fmt.Errorf("expected '=' after attribute %s", attr)
+			}
+			p.pos++
+			attrType, optional, err := p.parseAttributeType()
+			if err != nil {
+				return nil, err
+			}
+			constraint.Attrs[attr] = attrType
+			constraint.Optional[attr] = optional
+		}
+		p.pos++
+		return constraint, p.expect(')')
+	case "":
+		return nil, fmt.Errorf("expected a type at offset %d", p.pos)
+	}
+	return nil, fmt.Errorf("unknown type %q", name)
+}
+
+// parseAttributeType parses the type of an object attribute, unwrapping optional(type[, default])
+func (p *typeParser) parseAttributeType() (*typeConstraint, bool, error) {
+	start := p.pos
+	if p.ident() != "optional" || !p.peek('(') {
+		p.pos = start
+		constraint, err := p.parseType()
+		return constraint, false, err
+	}
+	p.pos++
+	constraint, err := p.parseType()
+	if err != nil {
+		return nil, true, err
+	}
+	p.skipSpace()
+	p.skipExpression()
+	return constraint, true, p.expect(')')
+}
+
+// check returns the ways value does not conform to the constraint, applying Terraform's automatic conversions
+// between strings, numbers and bools
+func (t *typeConstraint) check(value any, path string) []string {
+	if value == nil {
+		return nil
+	}
+	mismatch := func() []string {
+		return []string{fmt.Sprintf("%s: expected %s, got %s", path, t.Kind, jsonValueKind(value))}
+	}
+
+	switch t.Kind {
+	case "any":
+		return nil
+	case "string":
+		switch value.(type) {
+		case string, float64, bool:
+			return nil
+		}
+		return mismatch()
+	case "number":
+		switch v := value.(type) {
+		case float64:
+			return nil
+		case string:
+			if _, err := strconv.ParseFloat(v, 64); err == nil {
+				return nil
+			}
+		}
+		return mismatch()
+	case "bool":
+		switch v := value.(type) {
+		case bool:
+			return nil
+		case string:
+			if v == "true" || v == "false" {
+				return nil
+			}
+		}
+		return mismatch()
+	case "list", "set":
+		items, ok := value.([]any)
+		if !ok {
+			return mismatch()
+		}
+		var problems []string
+		for i, item := range items {
+			problems = append(problems, t.Elem.check(item, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return problems
+	case "tuple":
+		items, ok := value.([]any)
+		if !ok {
+			return mismatch()
+		}
+		if len(items) != len(t.Elems) {
+			return []string{fmt.Sprintf("%s: expected a tuple of %d elements, got %d", path, len(t.Elems), len(items))}
+		}
+		var problems []string
+		for i, item := range items {
+			problems = append(problems, t.Elems[i].check(item, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return problems
+	case "map":
+		entries, ok := value.(map[string]any)
+		if !ok {
+			return mismatch()
+		}
+		var problems []string
+		for _, key := range sortedKeys(entries) {
+			problems = append(problems, t.Elem.check(entries[key], fmt.Sprintf("%s[%q]", path, key))...)
+		}
+		return problems
+	case "object":
+		attrs, ok := value.(map[string]any)
+		if !ok {
+			return mismatch()
+		}
+		var problems []string
+		for _, name := range sortedKeys(t.Attrs) {
+			attrValue, present := attrs[name]
+			if !present || attrValue == nil {
+				if !t.Optional[name] {
+					problems = append(problems, fmt.Sprintf("%s.%s: required attribute is missing", path, name))
+				}
+				continue
+			}
+			problems = append(problems, t.Attrs[name].check(attrValue, path+"."+name)...)
+		}
+		for _, name := range sortedKeys(attrs) {
+			if _, ok := t.Attrs[name]; !ok {
+				problems = append(problems, fmt.Sprintf("%s.%s: attribute is not declared by the object type", path, name))
+			}
+		}
+		return problems
+	}
+	return nil
+}
+
+// jsonValueKind names the Terraform kind of a decoded JSON value
+func jsonValueKind(value any) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "bool"
+	case []any:
+		return "list"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+)
+
+func TestParseTypeConstraint(t *testing.T) {
+	valid := []string{
+		"string",
+		"list(string)",
+		"map(list(number))",
+		"tuple([string, number, bool])",
+		"object({ name = string, size = optional(number, 10), tags = optional(map(string), {}) })",
+		"list(object({\n  \"cidr\" = string\n  ports = optional(list(number), [80, 443])\n}))",
+	}
+	for _, expression := range valid {
+		if _, err := parseTypeConstraint(expression); err != nil {
+			t.Errorf("parseTypeConstraint(%q) returned error: %v", expression, err)
+		}
+	}
+
+	invalid := []string{"", "strin", "list(string", "object({ name })", "string extra"}
+	for _, expression := range invalid {
+		if _, err := parseTypeConstraint(expression); err == nil {
+			t.Errorf("parseTypeConstraint(%q) expected an error", expression)
+		}
+	}
+}
+
+func TestTypeConstraintCheck(t *testing.T) {
+	tests := []struct {
+		expression string
+		value      any
+		problems   int
+	}{
+		{"string", "eu-west-1", 0},
+		{"string", float64(3), 0},
+		{"string", []any{"a"}, 1},
+		{"number", "42", 0},
+		{"number", "forty-two", 1},
+		{"bool", "true", 0},
+		{"bool", "yes", 1},
+		{"list(string)", []any{"a", "b"}, 0},
+		{"list(string)", "a", 1},
+		{"list(number)", []any{float64(1), []any{}}, 1},
+		{"tuple([string, number])", []any{"a"}, 1},
+		{"map(string)", map[string]any{"Name": "web"}, 0},
+		{"object({ name = string, size = optional(number) })", map[string]any{"name": "web"}, 0},
+		{"object({ name = string })", map[string]any{"size": float64(1)}, 2},
+		{"any", map[string]any{"x": []any{}}, 0},
+	}
+
+	for _, tt := range tests {
+		constraint, err := parseTypeConstraint(tt.expression)
+		if err != nil {
+			t.Fatalf("parseTypeConstraint(%q) returned error: %v", tt.expression, err)
+		}
+		if problems := constraint.check(tt.value, "var"); len(problems) != tt.problems {
+			t.Errorf("%s with %v: expected %d problems, got %v", tt.expression, tt.value, tt.problems, problems)
+		}
+	}
+}
+
+func TestValidateModuleInputValues(t *testing.T) {
+	inputs := []client.ModuleInput{
+		{Name: "cidr", Type: "string", Required: true},
+		{Name: "azs", Type: "list(string)"},
+		{Name: "name", Type: "string", Required: true},
+		{Name: "instance_tenancy", Type: "string", Description: "A tenancy option for instances launched into the VPC. Valid values are `default` or `dedicated`."},
+	}
+	values := map[string]any{
+		"cidr":             "10.0.0.0/16",
+		"azs":              "eu-west-1a",
+		"instance_tenancy": "shared",
+		"unknown":          true,
+	}
+
+	findings := validateModuleInputValues(inputs, values)
+	statuses := make(map[string]string)
+	for _, finding := range findings {
+		statuses[finding.Input] = finding.Status
+	}
+	expected := map[string]string{
+		"cidr":             "ok",
+		"azs":              "type_mismatch",
+		"name":             "missing_required",
+		"instance_tenancy": "rule_violation",
+		"unknown":          "unknown_input",
+	}
+	for input, status := range expected {
+		if statuses[input] != status {
+			t.Errorf("expected %s to be %s, got %s", input, status, statuses[input])
+		}
+	}
+
+	out := formatModuleInputFindings("terraform-aws-modules/vpc/aws/5.0.0", findings)
+	if !strings.Contains(out, "**Problems:** 4 of 5 inputs") {
+		t.Errorf("expected problem summary, got:\n%s", out)
+	}
+	if !strings.Contains(out, "| azs | type_mismatch | list(string) | azs: expected list, got string |") {
+		t.Errorf("expected azs finding, got:\n%s", out)
+	}
+}
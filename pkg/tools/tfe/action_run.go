@@ -37,7 +37,7 @@ func ActionRun(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return actionRunHandler(ctx, req, logger)
+			return actionRunHandler(ctx, req, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -45,12 +45,12 @@ func ActionRun(logger *log.Logger) server.ServerTool {
 func actionRunHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	runAction, err := request.RequireString("run_action")
 	if err != nil {
-		return ToolError(logger, "missing required input: run_action", err)
+		return ToolArgumentError(logger, "run_action", "is required")
 	}
 
 	runID, err := request.RequireString("run_id")
 	if err != nil {
-		return ToolError(logger, "missing required input: run_id", err)
+		return ToolArgumentError(logger, "run_id", "is required")
 	}
 
 	comment := request.GetString("comment", "Triggered via Terraform MCP Server")
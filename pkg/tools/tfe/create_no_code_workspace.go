@@ -46,7 +46,7 @@ func CreateNoCodeWorkspace(logger *log.Logger, mcpServer *server.MCPServer) serv
 			),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return createNoCodeWorkspaceHandler(ctx, req, logger, mcpServer)
+			return createNoCodeWorkspaceHandler(ctx, req, client.LoggerFromContext(ctx, logger), mcpServer)
 		},
 	}
 }
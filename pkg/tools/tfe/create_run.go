@@ -43,7 +43,7 @@ func CreateRunSafe(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return createRunSafeHandler(ctx, req, logger)
+			return createRunSafeHandler(ctx, req, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -51,13 +51,13 @@ func CreateRunSafe(logger *log.Logger) server.ServerTool {
 func createRunSafeHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	terraformOrgName, err := request.RequireString("terraform_org_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: terraform_org_name", err)
+		return ToolArgumentError(logger, "terraform_org_name", "is required")
 	}
 	terraformOrgName = strings.TrimSpace(terraformOrgName)
 
 	workspaceName, err := request.RequireString("workspace_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: workspace_name", err)
+		return ToolArgumentError(logger, "workspace_name", "is required")
 	}
 	workspaceName = strings.TrimSpace(workspaceName)
 
@@ -135,7 +135,7 @@ func CreateRun(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return createRunHandler(ctx, req, logger)
+			return createRunHandler(ctx, req, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -143,13 +143,13 @@ func CreateRun(logger *log.Logger) server.ServerTool {
 func createRunHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	terraformOrgName, err := request.RequireString("terraform_org_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: terraform_org_name", err)
+		return ToolArgumentError(logger, "terraform_org_name", "is required")
 	}
 	terraformOrgName = strings.TrimSpace(terraformOrgName)
 
 	workspaceName, err := request.RequireString("workspace_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: workspace_name", err)
+		return ToolArgumentError(logger, "workspace_name", "is required")
 	}
 	workspaceName = strings.TrimSpace(workspaceName)
 
@@ -66,7 +66,7 @@ func CreateWorkspace(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return createWorkspaceHandler(ctx, request, logger)
+			return createWorkspaceHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -74,13 +74,13 @@ func CreateWorkspace(logger *log.Logger) server.ServerTool {
 func createWorkspaceHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	terraformOrgName, err := request.RequireString("terraform_org_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: terraform_org_name", err)
+		return ToolArgumentError(logger, "terraform_org_name", "is required")
 	}
 	terraformOrgName = strings.TrimSpace(terraformOrgName)
 
 	workspaceName, err := request.RequireString("workspace_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: workspace_name", err)
+		return ToolArgumentError(logger, "workspace_name", "is required")
 	}
 	workspaceName = strings.TrimSpace(workspaceName)
 
@@ -159,7 +159,7 @@ func createWorkspaceHandler(ctx context.Context, request mcp.CallToolRequest, lo
 
 	if vcsRepoIdentifier != "" {
 		if vcsRepoOAuthTokenID == "" {
-			return ToolError(logger, "vcs_repo_oauth_token_id is required when vcs_repo_identifier is provided", nil)
+			return ToolArgumentError(logger, "vcs_repo_oauth_token_id", "is required when vcs_repo_identifier is provided")
 		}
 
 		vcsRepo := &tfe.VCSRepoOptions{
@@ -30,7 +30,7 @@ func DeleteWorkspaceSafely(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return deleteWorkspaceSafelyHandler(ctx, request, logger)
+			return deleteWorkspaceSafelyHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -38,7 +38,7 @@ func DeleteWorkspaceSafely(logger *log.Logger) server.ServerTool {
 func deleteWorkspaceSafelyHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	workspaceID, err := request.RequireString("workspace_id")
 	if err != nil {
-		return ToolError(logger, "missing required input: workspace_id", err)
+		return ToolArgumentError(logger, "workspace_id", "is required")
 	}
 	workspaceID = strings.TrimSpace(workspaceID)
 
@@ -49,7 +49,7 @@ func deleteWorkspaceSafelyHandler(ctx context.Context, request mcp.CallToolReque
 
 	workspace, err := tfeClient.Workspaces.ReadByID(ctx, workspaceID)
 	if err != nil {
-		return ToolErrorf(logger, "workspace not found: %s", workspaceID)
+		return ToolErrorf(logger, "failed to read workspace %s: %v", workspaceID, err)
 	}
 
 	err = tfeClient.Workspaces.SafeDeleteByID(ctx, workspaceID)
@@ -4,27 +4,61 @@
 package tools
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	"github.com/mark3labs/mcp-go/mcp"
 	log "github.com/sirupsen/logrus"
 )
 
+// ToolError fails a tool call, with an error code derived from the HCP Terraform error err
 func ToolError(logger *log.Logger, message string, err error) (*mcp.CallToolResult, error) {
 	fullMessage := message
 	if err != nil {
 		fullMessage = fmt.Sprintf("%s: %v", message, err)
 	}
+	return toolErrorWithCode(logger, errorCodeOf(err), fullMessage)
+}
+
+// ToolErrorf fails a tool call, with an error code derived from the first error among args
+func ToolErrorf(logger *log.Logger, format string, args ...interface{}) (*mcp.CallToolResult, error) {
+	var err error
+	for _, arg := range args {
+		if argErr, ok := arg.(error); ok {
+			err = argErr
+			break
+		}
+	}
+	return toolErrorWithCode(logger, errorCodeOf(err), fmt.Sprintf(format, args...))
+}
+
+// ToolNotFoundErrorf fails a tool call for an organization, workspace or run that does not exist
+func ToolNotFoundErrorf(logger *log.Logger, format string, args ...interface{}) (*mcp.CallToolResult, error) {
+	return toolErrorWithCode(logger, utils.ErrorCodeNotFound, fmt.Sprintf(format, args...))
+}
+
+// ToolArgumentError rejects a missing or malformed argument with a structured error naming the field
+func ToolArgumentError(logger *log.Logger, field string, reason string) (*mcp.CallToolResult, error) {
+	errs := utils.ArgumentErrors{{Field: field, Reason: reason}}
 	if logger != nil {
-		logger.Errorf("Tool error: %s", fullMessage)
+		logger.Errorf("Tool error: %v", errs)
 	}
-	return mcp.NewToolResultError(fullMessage), nil
+	return utils.NewArgumentErrorResult(errs), nil
 }
 
-func ToolErrorf(logger *log.Logger, format string, args ...interface{}) (*mcp.CallToolResult, error) {
-	message := fmt.Sprintf(format, args...)
+func toolErrorWithCode(logger *log.Logger, code utils.ErrorCode, message string) (*mcp.CallToolResult, error) {
 	if logger != nil {
-		logger.Errorf("Tool error: %s", message)
+		logger.Errorf("Tool error (%s): %s", code, message)
+	}
+	return utils.NewErrorResult(code, message), nil
+}
+
+// errorCodeOf classifies a go-tfe error, HCP Terraform answers 404 for resources the token cannot read as well
+func errorCodeOf(err error) utils.ErrorCode {
+	if errors.Is(err, tfe.ErrResourceNotFound) {
+		return utils.ErrorCodeNotFound
 	}
-	return mcp.NewToolResultError(message), nil
+	return utils.ErrorCodeUpstreamError
 }
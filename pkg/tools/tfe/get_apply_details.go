@@ -28,7 +28,7 @@ func GetApplyDetails(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getApplyDetailsHandler(ctx, req, logger)
+			return getApplyDetailsHandler(ctx, req, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -36,7 +36,7 @@ func GetApplyDetails(logger *log.Logger) server.ServerTool {
 func getApplyDetailsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	applyID, err := request.RequireString("apply_id")
 	if err != nil {
-		return ToolError(logger, "missing required input: apply_id", err)
+		return ToolArgumentError(logger, "apply_id", "is required")
 	}
 
 	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
@@ -46,7 +46,7 @@ func getApplyDetailsHandler(ctx context.Context, request mcp.CallToolRequest, lo
 
 	apply, err := tfeClient.Applies.Read(ctx, applyID)
 	if err != nil {
-		return ToolErrorf(logger, "apply not found: %s", applyID)
+		return ToolErrorf(logger, "failed to read apply %s: %v", applyID, err)
 	}
 
 	buf := bytes.NewBuffer(nil)
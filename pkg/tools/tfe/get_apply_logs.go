@@ -8,11 +8,18 @@ import (
 	"io"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	log "github.com/sirupsen/logrus"
 )
 
+// applyLogs is the typed result of get_apply_logs
+type applyLogs struct {
+	ApplyID string `json:"apply_id"`
+	Logs    string `json:"logs"`
+}
+
 // GetApplyLogs creates a tool to retrieve the logs of a specific Terraform apply.
 func GetApplyLogs(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
@@ -27,7 +34,7 @@ func GetApplyLogs(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getApplyLogsHandler(ctx, req, logger)
+			return getApplyLogsHandler(ctx, req, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -35,7 +42,7 @@ func GetApplyLogs(logger *log.Logger) server.ServerTool {
 func getApplyLogsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	applyID, err := request.RequireString("apply_id")
 	if err != nil {
-		return ToolError(logger, "missing required input: apply_id", err)
+		return ToolArgumentError(logger, "apply_id", "is required")
 	}
 
 	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
@@ -53,5 +60,5 @@ func getApplyLogsHandler(ctx context.Context, request mcp.CallToolRequest, logge
 		return ToolError(logger, "failed to read apply logs", err)
 	}
 
-	return mcp.NewToolResultText(string(logBytes)), nil
+	return utils.NewToolResultData(string(logBytes), applyLogs{ApplyID: applyID, Logs: string(logBytes)}), nil
 }
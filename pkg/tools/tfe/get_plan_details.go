@@ -28,7 +28,7 @@ func GetPlanDetails(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getPlanDetailsHandler(ctx, req, logger)
+			return getPlanDetailsHandler(ctx, req, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -36,7 +36,7 @@ func GetPlanDetails(logger *log.Logger) server.ServerTool {
 func getPlanDetailsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	planID, err := request.RequireString("plan_id")
 	if err != nil {
-		return ToolError(logger, "missing required input: plan_id", err)
+		return ToolArgumentError(logger, "plan_id", "is required")
 	}
 
 	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
@@ -46,7 +46,7 @@ func getPlanDetailsHandler(ctx context.Context, request mcp.CallToolRequest, log
 
 	plan, err := tfeClient.Plans.Read(ctx, planID)
 	if err != nil {
-		return ToolErrorf(logger, "plan not found: %s", planID)
+		return ToolErrorf(logger, "failed to read plan %s: %v", planID, err)
 	}
 
 	buf := bytes.NewBuffer(nil)
@@ -26,7 +26,7 @@ func GetPlanJSONOutput(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getPlanJSONOutputHandler(ctx, req, logger)
+			return getPlanJSONOutputHandler(ctx, req, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -34,7 +34,7 @@ func GetPlanJSONOutput(logger *log.Logger) server.ServerTool {
 func getPlanJSONOutputHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	planID, err := request.RequireString("plan_id")
 	if err != nil {
-		return ToolError(logger, "missing required input: plan_id", err)
+		return ToolArgumentError(logger, "plan_id", "is required")
 	}
 
 	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
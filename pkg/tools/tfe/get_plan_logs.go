@@ -8,11 +8,18 @@ import (
 	"io"
 
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	log "github.com/sirupsen/logrus"
 )
 
+// planLogs is the typed result of get_plan_logs
+type planLogs struct {
+	PlanID string `json:"plan_id"`
+	Logs   string `json:"logs"`
+}
+
 // GetPlanLogs creates a tool to retrieve the logs of a specific Terraform plan.
 func GetPlanLogs(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
@@ -27,7 +34,7 @@ func GetPlanLogs(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getPlanLogsHandler(ctx, req, logger)
+			return getPlanLogsHandler(ctx, req, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -35,7 +42,7 @@ func GetPlanLogs(logger *log.Logger) server.ServerTool {
 func getPlanLogsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	planID, err := request.RequireString("plan_id")
 	if err != nil {
-		return ToolError(logger, "missing required input: plan_id", err)
+		return ToolArgumentError(logger, "plan_id", "is required")
 	}
 
 	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
@@ -53,5 +60,5 @@ func getPlanLogsHandler(ctx context.Context, request mcp.CallToolRequest, logger
 		return ToolError(logger, "failed to read plan logs", err)
 	}
 
-	return mcp.NewToolResultText(string(logBytes)), nil
+	return utils.NewToolResultData(string(logBytes), planLogs{PlanID: planID, Logs: string(logBytes)}), nil
 }
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -48,7 +49,7 @@ The module-namespace is usually the name of the Terraform organization. Obtain t
 			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getPrivateModuleDetailsHandler(ctx, request, logger)
+			return getPrivateModuleDetailsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -56,13 +57,13 @@ The module-namespace is usually the name of the Terraform organization. Obtain t
 func getPrivateModuleDetailsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	terraformOrgName, err := request.RequireString("terraform_org_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: terraform_org_name", err)
+		return ToolArgumentError(logger, "terraform_org_name", "is required")
 	}
 	terraformOrgName = strings.TrimSpace(terraformOrgName)
 
 	moduleID, err := request.RequireString("private_module_id")
 	if err != nil {
-		return ToolError(logger, "missing required input: private_module_id", err)
+		return ToolArgumentError(logger, "private_module_id", "is required")
 	}
 	moduleID = strings.TrimSpace(moduleID)
 
@@ -98,7 +99,7 @@ func getPrivateModuleDetailsHandler(ctx context.Context, request mcp.CallToolReq
 
 	module, err = tfeClient.RegistryModules.Read(ctx, tfeModuleID)
 	if err != nil {
-		return ToolErrorf(logger, "module not found: %s - use search_private_modules to find valid module IDs", moduleID)
+		return ToolErrorf(logger, "failed to read module %s: %v - use search_private_modules to find valid module IDs", moduleID, err)
 	}
 
 	terraformRegistryModule, err = tfeClient.RegistryModules.ReadTerraformRegistryModule(ctx, tfeModuleID, moduleVersion)
@@ -257,7 +258,37 @@ func buildPrivateModuleDetailsResponse(registryModule *tfe.RegistryModule,
 		"has_detailed_info":        terraformRegistryModule != nil,
 	}).Info("Successfully retrieved private module details")
 
-	return mcp.NewToolResultText(builder.String())
+	details := privateModuleDetails{
+		Source:       registryPath,
+		Name:         registryModule.Name,
+		Namespace:    registryModule.Namespace,
+		Provider:     registryModule.Provider,
+		RegistryName: string(registryModule.RegistryName),
+		CreatedAt:    registryModule.CreatedAt,
+		UpdatedAt:    registryModule.UpdatedAt,
+		NoCode:       registryModule.NoCode,
+		Details:      terraformRegistryModule,
+	}
+	for _, versionStatus := range registryModule.VersionStatuses {
+		details.Versions = append(details.Versions, versionStatus.Version)
+	}
+	return utils.NewToolResultData(builder.String(), details)
+}
+
+// privateModuleDetails is the typed result of get_private_module_details
+type privateModuleDetails struct {
+	// Source is the module source address for a module block
+	Source       string   `json:"source"`
+	Name         string   `json:"name"`
+	Namespace    string   `json:"namespace"`
+	Provider     string   `json:"provider"`
+	RegistryName string   `json:"registry_name"`
+	CreatedAt    string   `json:"created_at"`
+	UpdatedAt    string   `json:"updated_at"`
+	NoCode       bool     `json:"no_code"`
+	Versions     []string `json:"versions,omitempty"`
+	// Details holds the inputs, outputs and README, nil when the registry could not return them
+	Details *tfe.TerraformRegistryModule `json:"details,omitempty"`
 }
 
 func removeReadmeSections(readme string) string {
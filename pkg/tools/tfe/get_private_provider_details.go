@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -50,7 +51,7 @@ It provides details on how to use the provider, permissions, available versions,
 			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getPrivateProviderDetailsHandler(ctx, request, logger)
+			return getPrivateProviderDetailsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -58,19 +59,19 @@ It provides details on how to use the provider, permissions, available versions,
 func getPrivateProviderDetailsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	terraformOrgName, err := request.RequireString("terraform_org_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: terraform_org_name", err)
+		return ToolArgumentError(logger, "terraform_org_name", "is required")
 	}
 	terraformOrgName = strings.TrimSpace(terraformOrgName)
 
 	privateProviderNamespace, err := request.RequireString("private_provider_namespace")
 	if err != nil {
-		return ToolError(logger, "missing required input: private_provider_namespace", err)
+		return ToolArgumentError(logger, "private_provider_namespace", "is required")
 	}
 	privateProviderNamespace = strings.TrimSpace(privateProviderNamespace)
 
 	privateProviderName, err := request.RequireString("private_provider_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: private_provider_name", err)
+		return ToolArgumentError(logger, "private_provider_name", "is required")
 	}
 	privateProviderName = strings.TrimSpace(privateProviderName)
 
@@ -105,9 +106,19 @@ func getPrivateProviderDetailsHandler(ctx context.Context, request mcp.CallToolR
 
 	provider, err := tfeClient.RegistryProviders.Read(ctx, providerID, readOptions)
 	if err != nil {
-		return ToolErrorf(logger, "provider not found: %s/%s - use search_private_providers to find valid providers", privateProviderNamespace, privateProviderName)
+		return ToolErrorf(logger, "failed to read provider %s/%s: %v - use search_private_providers to find valid providers", privateProviderNamespace, privateProviderName, err)
 	}
 
+	details := privateProviderDetails{
+		ID:           provider.ID,
+		Namespace:    provider.Namespace,
+		Name:         provider.Name,
+		Source:       fmt.Sprintf("%s/%s", provider.Namespace, provider.Name),
+		RegistryName: string(provider.RegistryName),
+		CreatedAt:    provider.CreatedAt,
+		UpdatedAt:    provider.UpdatedAt,
+		CanDelete:    provider.Permissions.CanDelete,
+	}
 	var builder strings.Builder
 	builder.WriteString(fmt.Sprintf("Private Provider Details: %s/%s\n", provider.Namespace, provider.Name))
 	builder.WriteString(strings.Repeat("=", 50) + "\n\n")
@@ -153,6 +164,13 @@ func getPrivateProviderDetailsHandler(ctx context.Context, request mcp.CallToolR
 		builder.WriteString(fmt.Sprintf("Available Versions (%d):\n", len(provider.RegistryProviderVersions)))
 
 		for i, version := range provider.RegistryProviderVersions {
+			versionDetails := privateProviderVersion{
+				ID:        version.ID,
+				Version:   version.Version,
+				CreatedAt: version.CreatedAt,
+				UpdatedAt: version.UpdatedAt,
+				KeyID:     version.KeyID,
+			}
 			builder.WriteString(fmt.Sprintf("%d. Version: %s\n", i+1, version.Version))
 			builder.WriteString(fmt.Sprintf("   ID: %s\n", version.ID))
 			builder.WriteString(fmt.Sprintf("   Created: %s\n", version.CreatedAt))
@@ -179,11 +197,13 @@ func getPrivateProviderDetailsHandler(ctx context.Context, request mcp.CallToolR
 				for _, platform := range version.RegistryProviderPlatforms {
 					platforms = append(platforms, fmt.Sprintf("%s/%s", platform.OS, platform.Arch))
 				}
+				versionDetails.Platforms = platforms
 				builder.WriteString(strings.Join(platforms, ", "))
 				builder.WriteString("\n")
 			}
 
 			builder.WriteString("\n")
+			details.Versions = append(details.Versions, versionDetails)
 		}
 	} else if includeVersions {
 		builder.WriteString("No version information is available for this provider.\n\n")
@@ -206,5 +226,27 @@ func getPrivateProviderDetailsHandler(ctx context.Context, request mcp.CallToolR
 		"versions_count":             len(provider.RegistryProviderVersions),
 	}).Info("Successfully retrieved private provider details")
 
-	return mcp.NewToolResultText(builder.String()), nil
+	return utils.NewToolResultData(builder.String(), details), nil
+}
+
+// privateProviderDetails is the typed result of get_private_provider_details
+type privateProviderDetails struct {
+	ID           string                   `json:"id"`
+	Namespace    string                   `json:"namespace"`
+	Name         string                   `json:"name"`
+	Source       string                   `json:"source"`
+	RegistryName string                   `json:"registry_name"`
+	CreatedAt    string                   `json:"created_at"`
+	UpdatedAt    string                   `json:"updated_at"`
+	CanDelete    bool                     `json:"can_delete"`
+	Versions     []privateProviderVersion `json:"versions,omitempty"`
+}
+
+type privateProviderVersion struct {
+	ID        string   `json:"id"`
+	Version   string   `json:"version"`
+	CreatedAt string   `json:"created_at"`
+	UpdatedAt string   `json:"updated_at"`
+	KeyID     string   `json:"key_id,omitempty"`
+	Platforms []string `json:"platforms,omitempty"`
 }
@@ -28,7 +28,7 @@ func GetRunDetails(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getRunDetailsHandler(ctx, req, logger)
+			return getRunDetailsHandler(ctx, req, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -36,7 +36,7 @@ func GetRunDetails(logger *log.Logger) server.ServerTool {
 func getRunDetailsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	runID, err := request.RequireString("run_id")
 	if err != nil {
-		return ToolError(logger, "missing required input: run_id", err)
+		return ToolArgumentError(logger, "run_id", "is required")
 	}
 
 	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
@@ -46,7 +46,7 @@ func getRunDetailsHandler(ctx context.Context, request mcp.CallToolRequest, logg
 
 	run, err := tfeClient.Runs.Read(ctx, runID)
 	if err != nil {
-		return ToolErrorf(logger, "run not found: %s", runID)
+		return ToolErrorf(logger, "failed to read run %s: %v", runID, err)
 	}
 
 	buf := bytes.NewBuffer(nil)
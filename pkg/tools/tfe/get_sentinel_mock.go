@@ -30,7 +30,7 @@ func GetSentinelMock(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getSentinelMockHandler(ctx, request, logger)
+			return getSentinelMockHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -38,7 +38,7 @@ func GetSentinelMock(logger *log.Logger) server.ServerTool {
 func getSentinelMockHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	planID, err := request.RequireString("plan_id")
 	if err != nil {
-		return ToolError(logger, "missing required input: plan_id", err)
+		return ToolArgumentError(logger, "plan_id", "is required")
 	}
 
 	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
@@ -35,7 +35,7 @@ func GetStackDetails(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getStackDetailsHandler(ctx, request, logger)
+			return getStackDetailsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -43,13 +43,13 @@ func GetStackDetails(logger *log.Logger) server.ServerTool {
 func getStackDetailsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	terraformOrgName, err := request.RequireString("terraform_org_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: terraform_org_name", err)
+		return ToolArgumentError(logger, "terraform_org_name", "is required")
 	}
 	terraformOrgName = strings.TrimSpace(terraformOrgName)
 
 	stackID, err := request.RequireString("stack_id")
 	if err != nil {
-		return ToolError(logger, "missing required input: stack_id", err)
+		return ToolArgumentError(logger, "stack_id", "is required")
 	}
 	stackID = strings.TrimSpace(stackID)
 
@@ -60,7 +60,7 @@ func getStackDetailsHandler(ctx context.Context, request mcp.CallToolRequest, lo
 
 	stack, err := tfeClient.Stacks.Read(ctx, stackID)
 	if err != nil {
-		return ToolErrorf(logger, "stack %q not found in org %q", stackID, terraformOrgName)
+		return ToolErrorf(logger, "failed to read stack %q in org %q: %v", stackID, terraformOrgName, err)
 	}
 
 	buf := bytes.NewBuffer(nil)
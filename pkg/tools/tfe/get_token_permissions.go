@@ -27,7 +27,7 @@ func GetTokenPermissions(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getTokenPermissionsHandler(ctx, req, logger)
+			return getTokenPermissionsHandler(ctx, req, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -35,7 +35,7 @@ func GetTokenPermissions(logger *log.Logger) server.ServerTool {
 func getTokenPermissionsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	terraformOrgName, err := request.RequireString("terraform_org_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: terraform_org_name", err)
+		return ToolArgumentError(logger, "terraform_org_name", "is required")
 	}
 
 	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
@@ -45,7 +45,7 @@ func getTokenPermissionsHandler(ctx context.Context, request mcp.CallToolRequest
 
 	org, err := tfeClient.Organizations.Read(ctx, terraformOrgName)
 	if err != nil {
-		return ToolErrorf(logger, "organization not found: %q", terraformOrgName)
+		return ToolErrorf(logger, "failed to read organization %q: %v", terraformOrgName, err)
 	}
 
 	permissions := org.Permissions
@@ -42,7 +42,7 @@ func GetWorkspaceDetails(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return getWorkspaceDetailsHandler(ctx, request, logger)
+			return getWorkspaceDetailsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -50,13 +50,13 @@ func GetWorkspaceDetails(logger *log.Logger) server.ServerTool {
 func getWorkspaceDetailsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	terraformOrgName, err := request.RequireString("terraform_org_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: terraform_org_name", err)
+		return ToolArgumentError(logger, "terraform_org_name", "is required")
 	}
 	terraformOrgName = strings.TrimSpace(terraformOrgName)
 
 	workspaceName, err := request.RequireString("workspace_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: workspace_name", err)
+		return ToolArgumentError(logger, "workspace_name", "is required")
 	}
 	workspaceName = strings.TrimSpace(workspaceName)
 
@@ -67,7 +67,7 @@ func getWorkspaceDetailsHandler(ctx context.Context, request mcp.CallToolRequest
 
 	workspace, err := tfeClient.Workspaces.Read(ctx, terraformOrgName, workspaceName)
 	if err != nil {
-		return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, terraformOrgName)
+		return ToolErrorf(logger, "failed to read workspace '%s' in org '%s': %v", workspaceName, terraformOrgName, err)
 	}
 
 	buf, err := getWorkspaceDetailsForTools(ctx, "get_workspace_details", tfeClient, workspace, logger, true)
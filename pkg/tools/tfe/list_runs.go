@@ -71,7 +71,7 @@ func ListRuns(logger *log.Logger) server.ServerTool {
 			utils.WithPagination(),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return listRunsHandler(ctx, req, logger)
+			return listRunsHandler(ctx, req, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -79,7 +79,7 @@ func ListRuns(logger *log.Logger) server.ServerTool {
 func listRunsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	terraformOrgName, err := request.RequireString("terraform_org_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: terraform_org_name", err)
+		return ToolArgumentError(logger, "terraform_org_name", "is required")
 	}
 	terraformOrgName = strings.TrimSpace(terraformOrgName)
 
@@ -115,7 +115,7 @@ func listRunsHandler(ctx context.Context, request mcp.CallToolRequest, logger *l
 
 		workspace, err := tfeClient.Workspaces.Read(ctx, terraformOrgName, workspaceName)
 		if err != nil {
-			return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, terraformOrgName)
+			return ToolErrorf(logger, "failed to read workspace '%s' in org '%s': %v", workspaceName, terraformOrgName, err)
 		}
 
 		runs, err := tfeClient.Runs.List(ctx, workspace.ID, options)
@@ -38,7 +38,7 @@ func ListStacks(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return listTerraformStacksHandler(ctx, request, logger)
+			return listTerraformStacksHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -46,7 +46,7 @@ func ListStacks(logger *log.Logger) server.ServerTool {
 func listTerraformStacksHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	terraformOrgName, err := request.RequireString("terraform_org_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: terraform_org_name", err)
+		return ToolArgumentError(logger, "terraform_org_name", "is required")
 	}
 	terraformOrgName = strings.TrimSpace(terraformOrgName)
 
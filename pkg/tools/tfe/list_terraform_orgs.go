@@ -27,7 +27,7 @@ func ListTerraformOrgs(logger *log.Logger) server.ServerTool {
 			utils.WithPagination(),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return listTerraformOrgsHandler(ctx, req, logger)
+			return listTerraformOrgsHandler(ctx, req, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -30,7 +30,7 @@ func ListTerraformProjects(logger *log.Logger) server.ServerTool {
 			utils.WithPagination(),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return listTerraformProjectsHandler(ctx, req, logger)
+			return listTerraformProjectsHandler(ctx, req, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -38,10 +38,10 @@ func ListTerraformProjects(logger *log.Logger) server.ServerTool {
 func listTerraformProjectsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	terraformOrgName, err := request.RequireString("terraform_org_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: terraform_org_name", err)
+		return ToolArgumentError(logger, "terraform_org_name", "is required")
 	}
 	if terraformOrgName == "" {
-		return ToolError(logger, "terraform_org_name cannot be empty", nil)
+		return ToolArgumentError(logger, "terraform_org_name", "cannot be empty")
 	}
 
 	pagination, err := utils.OptionalPaginationParams(request)
@@ -49,7 +49,7 @@ func ListWorkspaces(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return searchTerraformWorkspacesHandler(ctx, request, logger)
+			return searchTerraformWorkspacesHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -57,7 +57,7 @@ func ListWorkspaces(logger *log.Logger) server.ServerTool {
 func searchTerraformWorkspacesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	terraformOrgName, err := request.RequireString("terraform_org_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: terraform_org_name", err)
+		return ToolArgumentError(logger, "terraform_org_name", "is required")
 	}
 	terraformOrgName = strings.TrimSpace(terraformOrgName)
 
@@ -36,13 +36,14 @@ func AttachPolicySetToWorkspaces(logger *log.Logger) server.ServerTool {
 			mcp.WithString("workspace_ids", mcp.Required(), mcp.Description("Comma-separated list of workspace IDs to attach the policy set to")),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			logger := client.LoggerFromContext(ctx, logger)
 			policySetID, err := request.RequireString("policy_set_id")
 			if err != nil {
-				return ToolError(logger, "missing required input: policy_set_id", err)
+				return ToolArgumentError(logger, "policy_set_id", "is required")
 			}
 			workspaceIDsStr, err := request.RequireString("workspace_ids")
 			if err != nil {
-				return ToolError(logger, "missing required input: workspace_ids", err)
+				return ToolArgumentError(logger, "workspace_ids", "is required")
 			}
 
 			workspaceIDsList := strings.Split(workspaceIDsStr, ",")
@@ -89,7 +90,7 @@ func ListWorkspacePolicySets(logger *log.Logger) server.ServerTool {
 			mcp.WithString("workspace_id", mcp.Required(), mcp.Description("The workspace ID to get policy sets for (e.g., ws-2HRvNs49EWPjDqT1)")),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return listWorkspacePolicySetsHandler(ctx, request, logger)
+			return listWorkspacePolicySetsHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -97,11 +98,11 @@ func ListWorkspacePolicySets(logger *log.Logger) server.ServerTool {
 func listWorkspacePolicySetsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	orgName, err := request.RequireString("terraform_org_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: terraform_org_name", err)
+		return ToolArgumentError(logger, "terraform_org_name", "is required")
 	}
 	workspaceID, err := request.RequireString("workspace_id")
 	if err != nil {
-		return ToolError(logger, "missing required input: workspace_id", err)
+		return ToolArgumentError(logger, "workspace_id", "is required")
 	}
 
 	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -44,7 +45,7 @@ It retrieves a list of private modules that match the search criteria. This tool
 			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return searchPrivateModulesHandler(ctx, request, logger)
+			return searchPrivateModulesHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -52,7 +53,7 @@ It retrieves a list of private modules that match the search criteria. This tool
 func searchPrivateModulesHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	terraformOrgName, err := request.RequireString("terraform_org_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: terraform_org_name", err)
+		return ToolArgumentError(logger, "terraform_org_name", "is required")
 	}
 	searchQuery := request.GetString("search_query", "")
 	pageSize := request.GetInt("page_size", 100)
@@ -96,6 +97,12 @@ func searchPrivateModulesHandler(ctx context.Context, request mcp.CallToolReques
 		return ToolErrorf(logger, "failed to list private modules in org '%s'", terraformOrgName)
 	}
 
+	results := privateModuleSearchResults{
+		Organization: terraformOrgName,
+		Query:        searchQuery,
+		Modules:      []privateModuleSummary{},
+		Pagination:   moduleList.Pagination,
+	}
 	var builder strings.Builder
 	builder.WriteString(fmt.Sprintf("Private Modules in Organization: %s\n", terraformOrgName))
 	if searchQuery != "" {
@@ -111,7 +118,7 @@ func searchPrivateModulesHandler(ctx context.Context, request mcp.CallToolReques
 			builder.WriteString("- Checking the organization name\n")
 			builder.WriteString("- Verifying that private modules exist in this organization\n")
 		}
-		return mcp.NewToolResultText(builder.String()), nil
+		return utils.NewToolResultData(builder.String(), results), nil
 	}
 
 	builder.WriteString(fmt.Sprintf("Found %d module(s):\n", len(moduleList.Items)))
@@ -128,11 +135,23 @@ func searchPrivateModulesHandler(ctx context.Context, request mcp.CallToolReques
 		builder.WriteString(fmt.Sprintf("   Provider: %s\n", module.Provider))
 		builder.WriteString(fmt.Sprintf("   No Code Module: %t\n", module.NoCode))
 
+		summary := privateModuleSummary{
+			PrivateModuleID: moduleID,
+			Name:            module.Name,
+			Namespace:       module.Namespace,
+			Provider:        module.Provider,
+			RegistryName:    string(module.RegistryName),
+			CreatedAt:       module.CreatedAt,
+			UpdatedAt:       module.UpdatedAt,
+			NoCode:          module.NoCode,
+		}
 		if module.NoCode {
 			for _, noCodeModule := range module.RegistryNoCodeModule {
 				builder.WriteString(fmt.Sprintf("     - no_code_module_id: %s\n", noCodeModule.ID))
+				summary.NoCodeModuleIDs = append(summary.NoCodeModuleIDs, noCodeModule.ID)
 			}
 		}
+		results.Modules = append(results.Modules, summary)
 
 		builder.WriteString("\n")
 	}
@@ -156,5 +175,25 @@ func searchPrivateModulesHandler(ctx context.Context, request mcp.CallToolReques
 		"modules_found": len(moduleList.Items),
 	}).Info("Successfully retrieved private modules")
 
-	return mcp.NewToolResultText(builder.String()), nil
+	return utils.NewToolResultData(builder.String(), results), nil
+}
+
+// privateModuleSearchResults is the typed result of search_private_modules
+type privateModuleSearchResults struct {
+	Organization string                 `json:"organization"`
+	Query        string                 `json:"query,omitempty"`
+	Modules      []privateModuleSummary `json:"modules"`
+	Pagination   *tfe.Pagination        `json:"pagination,omitempty"`
+}
+
+type privateModuleSummary struct {
+	PrivateModuleID string   `json:"private_module_id"`
+	Name            string   `json:"name"`
+	Namespace       string   `json:"namespace"`
+	Provider        string   `json:"provider"`
+	RegistryName    string   `json:"registry_name"`
+	CreatedAt       string   `json:"created_at"`
+	UpdatedAt       string   `json:"updated_at"`
+	NoCode          bool     `json:"no_code"`
+	NoCodeModuleIDs []string `json:"no_code_module_ids,omitempty"`
 }
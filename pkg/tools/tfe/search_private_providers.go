@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -49,7 +50,7 @@ It retrieves a list of private providers that match the search criteria. This to
 			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return searchPrivateProvidersHandler(ctx, request, logger)
+			return searchPrivateProvidersHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -57,7 +58,7 @@ It retrieves a list of private providers that match the search criteria. This to
 func searchPrivateProvidersHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	terraformOrgName, err := request.RequireString("terraform_org_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: terraform_org_name", err)
+		return ToolArgumentError(logger, "terraform_org_name", "is required")
 	}
 	terraformOrgName = strings.TrimSpace(terraformOrgName)
 
@@ -110,6 +111,13 @@ func searchPrivateProvidersHandler(ctx context.Context, request mcp.CallToolRequ
 		return ToolErrorf(logger, "failed to list private providers in org '%s'", terraformOrgName)
 	}
 
+	results := privateProviderSearchResults{
+		Organization: terraformOrgName,
+		Query:        searchQuery,
+		RegistryName: registryName,
+		Providers:    []privateProviderSummary{},
+		Pagination:   providerList.Pagination,
+	}
 	var builder strings.Builder
 	builder.WriteString(fmt.Sprintf("Private Providers in Organization: %s\n", terraformOrgName))
 	if searchQuery != "" {
@@ -126,7 +134,7 @@ func searchPrivateProvidersHandler(ctx context.Context, request mcp.CallToolRequ
 			builder.WriteString("- Checking the organization name\n")
 			builder.WriteString("- Verifying that private providers exist in this organization\n")
 		}
-		return mcp.NewToolResultText(builder.String()), nil
+		return utils.NewToolResultData(builder.String(), results), nil
 	}
 
 	builder.WriteString(fmt.Sprintf("Found %d provider(s):\n\n", len(providerList.Items)))
@@ -138,6 +146,14 @@ func searchPrivateProvidersHandler(ctx context.Context, request mcp.CallToolRequ
 		builder.WriteString(fmt.Sprintf("   Created: %s\n", provider.CreatedAt))
 		builder.WriteString(fmt.Sprintf("   Updated: %s\n", provider.UpdatedAt))
 
+		summary := privateProviderSummary{
+			ID:           provider.ID,
+			Namespace:    provider.Namespace,
+			Name:         provider.Name,
+			RegistryName: string(provider.RegistryName),
+			CreatedAt:    provider.CreatedAt,
+			UpdatedAt:    provider.UpdatedAt,
+		}
 		if len(provider.RegistryProviderVersions) > 0 {
 			builder.WriteString("   Versions: ")
 			versions := make([]string, len(provider.RegistryProviderVersions))
@@ -146,7 +162,9 @@ func searchPrivateProvidersHandler(ctx context.Context, request mcp.CallToolRequ
 			}
 			builder.WriteString(strings.Join(versions, ", "))
 			builder.WriteString("\n")
+			summary.Versions = versions
 		}
+		results.Providers = append(results.Providers, summary)
 
 		builder.WriteString("\n")
 	}
@@ -170,5 +188,24 @@ func searchPrivateProvidersHandler(ctx context.Context, request mcp.CallToolRequ
 		"providers_found": len(providerList.Items),
 	}).Info("Successfully retrieved private providers")
 
-	return mcp.NewToolResultText(builder.String()), nil
+	return utils.NewToolResultData(builder.String(), results), nil
+}
+
+// privateProviderSearchResults is the typed result of search_private_providers
+type privateProviderSearchResults struct {
+	Organization string                   `json:"organization"`
+	Query        string                   `json:"query,omitempty"`
+	RegistryName string                   `json:"registry_name"`
+	Providers    []privateProviderSummary `json:"providers"`
+	Pagination   *tfe.Pagination          `json:"pagination,omitempty"`
+}
+
+type privateProviderSummary struct {
+	ID           string   `json:"id"`
+	Namespace    string   `json:"namespace"`
+	Name         string   `json:"name"`
+	RegistryName string   `json:"registry_name"`
+	CreatedAt    string   `json:"created_at"`
+	UpdatedAt    string   `json:"updated_at"`
+	Versions     []string `json:"versions,omitempty"`
 }
@@ -68,7 +68,7 @@ func UpdateWorkspace(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return updateWorkspaceHandler(ctx, request, logger)
+			return updateWorkspaceHandler(ctx, request, client.LoggerFromContext(ctx, logger))
 		},
 	}
 }
@@ -76,13 +76,13 @@ func UpdateWorkspace(logger *log.Logger) server.ServerTool {
 func updateWorkspaceHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
 	terraformOrgName, err := request.RequireString("terraform_org_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: terraform_org_name", err)
+		return ToolArgumentError(logger, "terraform_org_name", "is required")
 	}
 	terraformOrgName = strings.TrimSpace(terraformOrgName)
 
 	workspaceName, err := request.RequireString("workspace_name")
 	if err != nil {
-		return ToolError(logger, "missing required input: workspace_name", err)
+		return ToolArgumentError(logger, "workspace_name", "is required")
 	}
 	workspaceName = strings.TrimSpace(workspaceName)
 
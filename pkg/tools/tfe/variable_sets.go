@@ -29,9 +29,10 @@ func ListVariableSets(logger *log.Logger) server.ServerTool {
 			utils.WithPagination(),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			logger := client.LoggerFromContext(ctx, logger)
 			orgName, err := request.RequireString("terraform_org_name")
 			if err != nil {
-				return ToolError(logger, "missing required input: terraform_org_name", err)
+				return ToolArgumentError(logger, "terraform_org_name", "is required")
 			}
 			query := request.GetString("query", "")
 
@@ -82,13 +83,14 @@ func CreateVariableSet(logger *log.Logger) server.ServerTool {
 			mcp.WithBoolean("global", mcp.Description("Whether variable set is global: true or false"), mcp.DefaultBool(false)),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			logger := client.LoggerFromContext(ctx, logger)
 			orgName, err := request.RequireString("terraform_org_name")
 			if err != nil {
-				return ToolError(logger, "missing required input: terraform_org_name", err)
+				return ToolArgumentError(logger, "terraform_org_name", "is required")
 			}
 			name, err := request.RequireString("name")
 			if err != nil {
-				return ToolError(logger, "missing required input: name", err)
+				return ToolArgumentError(logger, "name", "is required")
 			}
 			description := request.GetString("description", "")
 			global := request.GetBool("global", false)
@@ -130,17 +132,18 @@ func CreateVariableInVariableSet(logger *log.Logger) server.ServerTool {
 			mcp.WithBoolean("sensitive", mcp.Description("Whether variable is sensitive: true or false"), mcp.DefaultBool(false)),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			logger := client.LoggerFromContext(ctx, logger)
 			varSetID, err := request.RequireString("variable_set_id")
 			if err != nil {
-				return ToolError(logger, "missing required input: variable_set_id", err)
+				return ToolArgumentError(logger, "variable_set_id", "is required")
 			}
 			key, err := request.RequireString("key")
 			if err != nil {
-				return ToolError(logger, "missing required input: key", err)
+				return ToolArgumentError(logger, "key", "is required")
 			}
 			value, err := request.RequireString("value")
 			if err != nil {
-				return ToolError(logger, "missing required input: value", err)
+				return ToolArgumentError(logger, "value", "is required")
 			}
 
 			category := tfe.CategoryTerraform
@@ -187,13 +190,14 @@ func DeleteVariableInVariableSet(logger *log.Logger) server.ServerTool {
 			mcp.WithString("variable_id", mcp.Required(), mcp.Description("Variable ID to delete")),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			logger := client.LoggerFromContext(ctx, logger)
 			varSetID, err := request.RequireString("variable_set_id")
 			if err != nil {
-				return ToolError(logger, "missing required input: variable_set_id", err)
+				return ToolArgumentError(logger, "variable_set_id", "is required")
 			}
 			variableID, err := request.RequireString("variable_id")
 			if err != nil {
-				return ToolError(logger, "missing required input: variable_id", err)
+				return ToolArgumentError(logger, "variable_id", "is required")
 			}
 
 			tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
@@ -224,13 +228,14 @@ func AttachVariableSetToWorkspaces(logger *log.Logger) server.ServerTool {
 			mcp.WithString("workspace_ids", mcp.Required(), mcp.Description("Comma-separated list of workspace IDs")),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			logger := client.LoggerFromContext(ctx, logger)
 			varSetID, err := request.RequireString("variable_set_id")
 			if err != nil {
-				return ToolError(logger, "missing required input: variable_set_id", err)
+				return ToolArgumentError(logger, "variable_set_id", "is required")
 			}
 			workspaceIDsStr, err := request.RequireString("workspace_ids")
 			if err != nil {
-				return ToolError(logger, "missing required input: workspace_ids", err)
+				return ToolArgumentError(logger, "workspace_ids", "is required")
 			}
 			workspaceIDsList := strings.Split(workspaceIDsStr, ",")
 
@@ -269,13 +274,14 @@ func DetachVariableSetFromWorkspaces(logger *log.Logger) server.ServerTool {
 			mcp.WithString("workspace_ids", mcp.Required(), mcp.Description("Comma-separated list of workspace IDs")),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			logger := client.LoggerFromContext(ctx, logger)
 			varSetID, err := request.RequireString("variable_set_id")
 			if err != nil {
-				return ToolError(logger, "missing required input: variable_set_id", err)
+				return ToolArgumentError(logger, "variable_set_id", "is required")
 			}
 			workspaceIDsStr, err := request.RequireString("workspace_ids")
 			if err != nil {
-				return ToolError(logger, "missing required input: workspace_ids", err)
+				return ToolArgumentError(logger, "workspace_ids", "is required")
 			}
 			workspaceIDsList := strings.Split(workspaceIDsStr, ",")
 
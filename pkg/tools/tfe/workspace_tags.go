@@ -35,17 +35,18 @@ func CreateWorkspaceTags(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			logger := client.LoggerFromContext(ctx, logger)
 			orgName, err := request.RequireString("terraform_org_name")
 			if err != nil {
-				return ToolError(logger, "missing required input: terraform_org_name", err)
+				return ToolArgumentError(logger, "terraform_org_name", "is required")
 			}
 			workspaceName, err := request.RequireString("workspace_name")
 			if err != nil {
-				return ToolError(logger, "missing required input: workspace_name", err)
+				return ToolArgumentError(logger, "workspace_name", "is required")
 			}
 			tagsStr, err := request.RequireString("tags")
 			if err != nil {
-				return ToolError(logger, "missing required input: tags", err)
+				return ToolArgumentError(logger, "tags", "is required")
 			}
 
 			tagNames := strings.Split(strings.TrimSpace(tagsStr), ",")
@@ -73,7 +74,7 @@ func CreateWorkspaceTags(logger *log.Logger) server.ServerTool {
 
 			workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
 			if err != nil {
-				return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
+				return ToolErrorf(logger, "failed to read workspace '%s' in org '%s': %v", workspaceName, orgName, err)
 			}
 
 			_, err = tfeClient.Workspaces.AddTagBindings(ctx, workspace.ID, tfe.WorkspaceAddTagBindingsOptions{
@@ -109,13 +110,14 @@ func ReadWorkspaceTags(logger *log.Logger) server.ServerTool {
 			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			logger := client.LoggerFromContext(ctx, logger)
 			orgName, err := request.RequireString("terraform_org_name")
 			if err != nil {
-				return ToolError(logger, "missing required input: terraform_org_name", err)
+				return ToolArgumentError(logger, "terraform_org_name", "is required")
 			}
 			workspaceName, err := request.RequireString("workspace_name")
 			if err != nil {
-				return ToolError(logger, "missing required input: workspace_name", err)
+				return ToolArgumentError(logger, "workspace_name", "is required")
 			}
 
 			tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
@@ -125,7 +127,7 @@ func ReadWorkspaceTags(logger *log.Logger) server.ServerTool {
 
 			workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
 			if err != nil {
-				return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
+				return ToolErrorf(logger, "failed to read workspace '%s' in org '%s': %v", workspaceName, orgName, err)
 			}
 
 			var tagNames []string
@@ -28,13 +28,14 @@ func ListWorkspaceVariables(logger *log.Logger) server.ServerTool {
 			utils.WithPagination(),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			logger := client.LoggerFromContext(ctx, logger)
 			orgName, err := request.RequireString("terraform_org_name")
 			if err != nil {
-				return ToolError(logger, "missing required input: terraform_org_name", err)
+				return ToolArgumentError(logger, "terraform_org_name", "is required")
 			}
 			workspaceName, err := request.RequireString("workspace_name")
 			if err != nil {
-				return ToolError(logger, "missing required input: workspace_name", err)
+				return ToolArgumentError(logger, "workspace_name", "is required")
 			}
 
 			tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
@@ -49,7 +50,7 @@ func ListWorkspaceVariables(logger *log.Logger) server.ServerTool {
 
 			workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
 			if err != nil {
-				return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
+				return ToolErrorf(logger, "failed to read workspace '%s' in org '%s': %v", workspaceName, orgName, err)
 			}
 
 			vars, err := tfeClient.Variables.List(ctx, workspace.ID, &tfe.VariableListOptions{
@@ -96,21 +97,22 @@ func CreateWorkspaceVariable(logger *log.Logger) server.ServerTool {
 			mcp.WithBoolean("hcl", mcp.Description("Whether variable is HCL: true or false"), mcp.DefaultBool(false)),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			logger := client.LoggerFromContext(ctx, logger)
 			orgName, err := request.RequireString("terraform_org_name")
 			if err != nil {
-				return ToolError(logger, "missing required input: terraform_org_name", err)
+				return ToolArgumentError(logger, "terraform_org_name", "is required")
 			}
 			workspaceName, err := request.RequireString("workspace_name")
 			if err != nil {
-				return ToolError(logger, "missing required input: workspace_name", err)
+				return ToolArgumentError(logger, "workspace_name", "is required")
 			}
 			key, err := request.RequireString("key")
 			if err != nil {
-				return ToolError(logger, "missing required input: key", err)
+				return ToolArgumentError(logger, "key", "is required")
 			}
 			value, err := request.RequireString("value")
 			if err != nil {
-				return ToolError(logger, "missing required input: value", err)
+				return ToolArgumentError(logger, "value", "is required")
 			}
 
 			category := tfe.CategoryEnv
@@ -129,7 +131,7 @@ func CreateWorkspaceVariable(logger *log.Logger) server.ServerTool {
 
 			workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
 			if err != nil {
-				return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
+				return ToolErrorf(logger, "failed to read workspace '%s' in org '%s': %v", workspaceName, orgName, err)
 			}
 
 			variable, err := tfeClient.Variables.Create(ctx, workspace.ID, tfe.VariableCreateOptions{
@@ -168,25 +170,26 @@ func UpdateWorkspaceVariable(logger *log.Logger) server.ServerTool {
 			mcp.WithString("description", mcp.Description("Variable description")),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			logger := client.LoggerFromContext(ctx, logger)
 			orgName, err := request.RequireString("terraform_org_name")
 			if err != nil {
-				return ToolError(logger, "missing required input: terraform_org_name", err)
+				return ToolArgumentError(logger, "terraform_org_name", "is required")
 			}
 			workspaceName, err := request.RequireString("workspace_name")
 			if err != nil {
-				return ToolError(logger, "missing required input: workspace_name", err)
+				return ToolArgumentError(logger, "workspace_name", "is required")
 			}
 			variableID, err := request.RequireString("variable_id")
 			if err != nil {
-				return ToolError(logger, "missing required input: variable_id", err)
+				return ToolArgumentError(logger, "variable_id", "is required")
 			}
 			key, err := request.RequireString("key")
 			if err != nil {
-				return ToolError(logger, "missing required input: key", err)
+				return ToolArgumentError(logger, "key", "is required")
 			}
 			value, err := request.RequireString("value")
 			if err != nil {
-				return ToolError(logger, "missing required input: value", err)
+				return ToolArgumentError(logger, "value", "is required")
 			}
 
 			options := tfe.VariableUpdateOptions{
@@ -212,7 +215,7 @@ func UpdateWorkspaceVariable(logger *log.Logger) server.ServerTool {
 
 			workspace, err := tfeClient.Workspaces.Read(ctx, orgName, workspaceName)
 			if err != nil {
-				return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, orgName)
+				return ToolErrorf(logger, "failed to read workspace '%s' in org '%s': %v", workspaceName, orgName, err)
 			}
 
 			variable, err := tfeClient.Variables.Update(ctx, workspace.ID, variableID, options)
@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/hashicorp/terraform-mcp-server/pkg/toolsets"
+	"github.com/hashicorp/terraform-mcp-server/pkg/utils"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// UnboundedCalls marks a tool whose number of API calls grows with the size of the result, e.g. paginated listings
+const UnboundedCalls = -1
+
+// ToolCost describes the typical cost of a single call to a tool, so planning agents can prefer cheaper tools.
+type ToolCost struct {
+	// Backend is the API the tool calls, e.g. the public registry or HCP Terraform/TFE
+	Backend string `json:"backend"`
+	// MinCalls and MaxCalls are the number of backend requests made by one call to the tool
+	MinCalls int `json:"min_calls"`
+	MaxCalls int `json:"max_calls"`
+	// Cacheable is true when the responses can be served from the registry response cache
+	Cacheable bool `json:"cacheable"`
+	// Aggregating is true when the tool combines several backend responses into one result
+	Aggregating bool `json:"aggregating"`
+}
+
+const (
+	publicRegistryBackend = "public registry"
+	tfeBackend            = "HCP Terraform/TFE"
+)
+
+// tfeToolCost is the cost declared for the HCP Terraform/TFE tools, which make one or a few uncached API calls
+var tfeToolCost = ToolCost{Backend: tfeBackend, MinCalls: 1, MaxCalls: 3}
+
+// registeredTool is a tool registered on the server together with its declared cost
+type registeredTool struct {
+	Name        string   `json:"name"`
+	Toolset     string   `json:"toolset"`
+	Description string   `json:"-"`
+	Cost        ToolCost `json:"cost"`
+}
+
+var (
+	toolCatalogMu sync.RWMutex
+	toolCatalog   = make(map[string]registeredTool)
+)
+
+// addTool registers the tool with the server, validating the arguments of its calls against its input schema,
+// and records its declared cost for list_tool_costs
+func addTool(mcpServer *server.MCPServer, tool server.ServerTool, cost ToolCost) {
+	toolset, _ := toolsets.GetToolsetForTool(tool.Tool.Name)
+
+	toolCatalogMu.Lock()
+	toolCatalog[tool.Tool.Name] = registeredTool{
+		Name:        tool.Tool.Name,
+		Toolset:     toolset,
+		Description: tool.Tool.Description,
+		Cost:        cost,
+	}
+	toolCatalogMu.Unlock()
+
+	// Every result goes through the output format middleware, so every tool takes the format argument
+	client.OutputFormatToolOption()(&tool.Tool)
+	mcpServer.AddTool(tool.Tool, withArgumentValidation(tool))
+}
+
+// registeredTools returns the recorded tools sorted by toolset and name
+func registeredTools() []registeredTool {
+	toolCatalogMu.RLock()
+	defer toolCatalogMu.RUnlock()
+
+	result := make([]registeredTool, 0, len(toolCatalog))
+	for _, tool := range toolCatalog {
+		result = append(result, tool)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Toolset != result[j].Toolset {
+			return result[i].Toolset < result[j].Toolset
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// IsCacheableTool reports whether the registered tool of that name declared its responses cacheable
+func IsCacheableTool(name string) bool {
+	toolCatalogMu.RLock()
+	defer toolCatalogMu.RUnlock()
+	return toolCatalog[name].Cost.Cacheable
+}
+
+// ListToolCosts creates a tool that lists the registered tools with their typical cost.
+func ListToolCosts(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("list_tool_costs",
+			mcp.WithDescription(`Lists the tools available on this server with their typical cost: the backend they call, how many API requests one call makes, whether responses are cacheable, and whether the tool aggregates several responses.
+Use it when planning a sequence of tool calls to prefer cheaper tools, e.g. 'list_provider_resources' over several 'get_provider_details' calls. Tools of the terraform toolset only appear once a session with HCP Terraform/TFE credentials has connected.`),
+			mcp.WithTitleAnnotation("List the available tools and their cost characteristics"),
+			mcp.WithOpenWorldHintAnnotation(false),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("toolset",
+				mcp.Description("Only list the tools of this toolset, e.g. 'registry' or 'terraform'"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return listToolCostsHandler(request, client.LoggerFromContext(ctx, logger))
+		},
+	}
+}
+
+func listToolCostsHandler(request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	toolset := strings.ToLower(strings.TrimSpace(request.GetString("toolset", "")))
+
+	tools := registeredTools()
+	if toolset != "" {
+		filtered := tools[:0]
+		for _, tool := range tools {
+			if tool.Toolset == toolset {
+				filtered = append(filtered, tool)
+			}
+		}
+		tools = filtered
+	}
+	if len(tools) == 0 {
+		logger.Debugf("list_tool_costs found no tools for toolset %q", toolset)
+		return mcp.NewToolResultError(fmt.Sprintf("no registered tools found for toolset '%s'", toolset)), nil
+	}
+
+	return utils.NewToolResultData(formatToolCosts(tools), tools), nil
+}
+
+func formatToolCosts(tools []registeredTool) string {
+	var builder strings.Builder
+	builder.WriteString("| Tool | Toolset | Backend | API calls | Cacheable | Aggregating |\n")
+	builder.WriteString("|---|---|---|---|---|---|\n")
+	for _, tool := range tools {
+		builder.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s | %s |\n",
+			tool.Name,
+			tool.Toolset,
+			tool.Cost.Backend,
+			formatCallRange(tool.Cost.MinCalls, tool.Cost.MaxCalls),
+			yesNo(tool.Cost.Cacheable),
+			yesNo(tool.Cost.Aggregating),
+		))
+	}
+	return builder.String()
+}
+
+func formatCallRange(minCalls, maxCalls int) string {
+	switch {
+	case maxCalls == UnboundedCalls:
+		return strconv.Itoa(minCalls) + "+"
+	case minCalls == maxCalls:
+		return strconv.Itoa(minCalls)
+	default:
+		return fmt.Sprintf("%d-%d", minCalls, maxCalls)
+	}
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/toolsets"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterToolsRecordsCosts(t *testing.T) {
+	logger := log.New()
+	hcServer := server.NewMCPServer("test", "0.0.1", server.WithToolCapabilities(true))
+	RegisterTools(hcServer, logger, []string{toolsets.Registry})
+
+	catalog := make(map[string]registeredTool)
+	for _, tool := range registeredTools() {
+		catalog[tool.Name] = tool
+	}
+
+	for name, toolset := range toolsets.ToolToToolset {
+		if toolset != toolsets.Registry {
+			continue
+		}
+		tool, ok := catalog[name]
+		require.True(t, ok, "registry tool %s has no declared cost", name)
+		assert.Equal(t, toolsets.Registry, tool.Toolset)
+		assert.NotEmpty(t, tool.Cost.Backend, "tool %s has no backend", name)
+	}
+
+	assert.True(t, catalog["search_providers"].Cost.Aggregating)
+	assert.Equal(t, UnboundedCalls, catalog["search_providers"].Cost.MaxCalls)
+	assert.Equal(t, 1, catalog["search_modules"].Cost.MaxCalls)
+	assert.Equal(t, 1, catalog["get_provider_details"].Cost.MinCalls)
+}
+
+func TestListToolCostsHandler(t *testing.T) {
+	logger := log.New()
+	hcServer := server.NewMCPServer("test", "0.0.1", server.WithToolCapabilities(true))
+	RegisterTools(hcServer, logger, []string{toolsets.Registry})
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{"toolset": "registry"}
+	result, err := listToolCostsHandler(request, logger)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	text := result.Content[0].(mcp.TextContent).Text
+	assert.Contains(t, text, "| get_provider_details | registry | public registry | 1+ | yes | yes |")
+	assert.Contains(t, text, "| search_providers | registry | public registry | 2+ | yes | yes |")
+
+	request.Params.Arguments = map[string]any{"toolset": "unknown"}
+	result, err = listToolCostsHandler(request, logger)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+}
+
+func TestFormatCallRange(t *testing.T) {
+	assert.Equal(t, "0", formatCallRange(0, 0))
+	assert.Equal(t, "1", formatCallRange(1, 1))
+	assert.Equal(t, "2-3", formatCallRange(2, 3))
+	assert.Equal(t, "2+", formatCallRange(2, UnboundedCalls))
+}
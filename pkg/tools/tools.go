@@ -11,54 +11,252 @@ import (
 )
 
 func RegisterTools(hcServer *server.MCPServer, logger *log.Logger, enabledToolsets []string) {
+	registryTools.CheckDefaultProviderNamespace(logger)
+
 	// Register the dynamic tools (TFE tools that require authentication)
 	registerDynamicTools(hcServer, logger, enabledToolsets)
 
 	// Registry toolset - Provider tools
 	if toolsets.IsToolEnabled("search_providers", enabledToolsets) {
 		tool := registryTools.ResolveProviderDocID(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 2, MaxCalls: UnboundedCalls, Cacheable: true, Aggregating: true})
 	}
 
 	if toolsets.IsToolEnabled("get_provider_details", enabledToolsets) {
 		tool := registryTools.GetProviderDocs(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: UnboundedCalls, Cacheable: true, Aggregating: true})
+	}
+
+	if toolsets.IsToolEnabled("get_provider_details_batch", enabledToolsets) {
+		tool := registryTools.GetProviderDocsBatch(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 20, Cacheable: true, Aggregating: true})
+	}
+
+	if toolsets.IsToolEnabled("get_provider_changelog", enabledToolsets) {
+		tool := registryTools.GetProviderChangelog(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 2, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("get_provider_dependency_lock", enabledToolsets) {
+		tool := registryTools.GetProviderDependencyLock(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 3, MaxCalls: 14})
+	}
+
+	if toolsets.IsToolEnabled("get_provider_terraform_requirement", enabledToolsets) {
+		tool := registryTools.GetProviderTerraformRequirement(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 2, Cacheable: true})
 	}
 
 	if toolsets.IsToolEnabled("get_latest_provider_version", enabledToolsets) {
 		tool := registryTools.GetLatestProviderVersion(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 2, Cacheable: true})
 	}
 
 	if toolsets.IsToolEnabled("get_provider_capabilities", enabledToolsets) {
 		tool := registryTools.GetProviderCapabilities(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 2, Cacheable: true, Aggregating: true})
+	}
+
+	if toolsets.IsToolEnabled("list_provider_resources", enabledToolsets) {
+		tool := registryTools.ListProviderResources(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 2, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("search_provider_attributes", enabledToolsets) {
+		tool := registryTools.SearchProviderAttributes(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 2, MaxCalls: UnboundedCalls, Cacheable: true, Aggregating: true})
+	}
+
+	if toolsets.IsToolEnabled("list_provider_doc_categories", enabledToolsets) {
+		tool := registryTools.ListProviderDocCategories(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 2, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("get_provider_overview", enabledToolsets) {
+		tool := registryTools.GetProviderOverview(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 2, MaxCalls: 3, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("get_provider_config_template", enabledToolsets) {
+		tool := registryTools.GetProviderConfigTemplate(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 2, MaxCalls: 3, Cacheable: true, Aggregating: true})
+	}
+
+	if toolsets.IsToolEnabled("get_provider_config_example", enabledToolsets) {
+		tool := registryTools.GetProviderConfigExample(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 2, MaxCalls: 3, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("get_resource_nested_block", enabledToolsets) {
+		tool := registryTools.GetResourceNestedBlock(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 2, MaxCalls: 3, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("get_resource_arguments", enabledToolsets) {
+		tool := registryTools.GetResourceArguments(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 2, MaxCalls: 3, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("get_resource_examples", enabledToolsets) {
+		tool := registryTools.GetResourceExamples(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 2, MaxCalls: 3, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("get_resource_import_docs", enabledToolsets) {
+		tool := registryTools.GetResourceImportDocs(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 2, MaxCalls: 3, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("compare_provider_docs", enabledToolsets) {
+		tool := registryTools.CompareProviderDocs(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 4, MaxCalls: 5, Cacheable: true, Aggregating: true})
+	}
+
+	if toolsets.IsToolEnabled("get_related_resources", enabledToolsets) {
+		tool := registryTools.GetRelatedResources(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 2, MaxCalls: 3, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("get_provider_naming_conventions", enabledToolsets) {
+		tool := registryTools.GetProviderNamingConventions(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 2, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("get_provider_rate_limits", enabledToolsets) {
+		tool := registryTools.GetProviderRateLimits(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 3, MaxCalls: UnboundedCalls, Cacheable: true, Aggregating: true})
+	}
+
+	if toolsets.IsToolEnabled("get_provider_guide", enabledToolsets) {
+		tool := registryTools.GetProviderGuide(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 3, MaxCalls: UnboundedCalls, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("resolve_provider_name", enabledToolsets) {
+		tool := registryTools.ResolveProviderName(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: UnboundedCalls, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("generate_required_providers", enabledToolsets) {
+		tool := registryTools.GenerateRequiredProviders(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: UnboundedCalls, Cacheable: true, Aggregating: true})
+	}
+
+	if toolsets.IsToolEnabled("get_provider_docs_for_context", enabledToolsets) {
+		tool := registryTools.GetProviderDocsForContext(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 2, MaxCalls: 3, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("get_provider_functions", enabledToolsets) {
+		tool := registryTools.GetProviderFunctions(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 2, MaxCalls: UnboundedCalls, Cacheable: true, Aggregating: true})
 	}
 
 	// Registry toolset - Module tools
 	if toolsets.IsToolEnabled("search_modules", enabledToolsets) {
 		tool := registryTools.SearchModules(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 1, Cacheable: true})
 	}
 
 	if toolsets.IsToolEnabled("get_module_details", enabledToolsets) {
 		tool := registryTools.ModuleDetails(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 1, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("get_module_readme", enabledToolsets) {
+		tool := registryTools.GetModuleReadme(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 1, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("get_module_source", enabledToolsets) {
+		tool := registryTools.GetModuleSource(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 1, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("resolve_version_constraint", enabledToolsets) {
+		tool := registryTools.ResolveVersionConstraint(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 1, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("list_namespace_modules", enabledToolsets) {
+		tool := registryTools.ListNamespaceModules(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 1, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("search_registry", enabledToolsets) {
+		tool := registryTools.SearchRegistry(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 3, MaxCalls: 21, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("get_module_dependencies", enabledToolsets) {
+		tool := registryTools.GetModuleDependencies(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 1, Cacheable: true})
 	}
 
 	if toolsets.IsToolEnabled("get_latest_module_version", enabledToolsets) {
 		tool := registryTools.GetLatestModuleVersion(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 1, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("generate_module_variables", enabledToolsets) {
+		tool := registryTools.GenerateModuleVariables(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 1, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("get_module_outputs", enabledToolsets) {
+		tool := registryTools.GetModuleOutputs(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 1, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("validate_module_inputs", enabledToolsets) {
+		tool := registryTools.ValidateModuleInputs(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 1, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("get_module_input_validations", enabledToolsets) {
+		tool := registryTools.GetModuleInputValidations(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 1, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("compare_module_versions", enabledToolsets) {
+		tool := registryTools.CompareModuleVersions(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 2, MaxCalls: 2, Cacheable: true, Aggregating: true})
 	}
 
 	// Registry toolset - Policy tools
 	if toolsets.IsToolEnabled("search_policies", enabledToolsets) {
 		tool := registryTools.SearchPolicies(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 1, Cacheable: true})
 	}
 
 	if toolsets.IsToolEnabled("get_policy_details", enabledToolsets) {
 		tool := registryTools.PolicyDetails(logger)
-		hcServer.AddTool(tool.Tool, tool.Handler)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: UnboundedCalls, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("list_policies", enabledToolsets) {
+		tool := registryTools.ListPolicies(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 1, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("list_policy_enforcement_levels", enabledToolsets) {
+		tool := registryTools.ListPolicyEnforcementLevels(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 1, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("get_policy_set_metadata", enabledToolsets) {
+		tool := registryTools.GetPolicySetMetadata(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 1, MaxCalls: 1, Cacheable: true})
+	}
+
+	if toolsets.IsToolEnabled("download_policy_module", enabledToolsets) {
+		tool := registryTools.DownloadPolicyModule(logger)
+		addTool(hcServer, tool, ToolCost{Backend: publicRegistryBackend, MinCalls: 2, MaxCalls: 2, Cacheable: true})
+	}
+
+	// Introspection tools
+	if toolsets.IsToolEnabled("list_tool_costs", enabledToolsets) {
+		tool := ListToolCosts(logger)
+		addTool(hcServer, tool, ToolCost{Backend: "none"})
 	}
 }
@@ -7,15 +7,54 @@ import "strings"
 
 var ToolToToolset = map[string]string{
 	// Public Registry tools (providers, modules, policies)
-	"search_providers":            Registry,
-	"get_provider_details":        Registry,
-	"get_latest_provider_version": Registry,
-	"get_provider_capabilities":   Registry,
-	"search_modules":              Registry,
-	"get_module_details":          Registry,
-	"get_latest_module_version":   Registry,
-	"search_policies":             Registry,
-	"get_policy_details":          Registry,
+	"search_providers":                   Registry,
+	"get_provider_details":               Registry,
+	"get_provider_details_batch":         Registry,
+	"get_latest_provider_version":        Registry,
+	"get_provider_changelog":             Registry,
+	"get_provider_dependency_lock":       Registry,
+	"get_provider_terraform_requirement": Registry,
+	"get_provider_capabilities":          Registry,
+	"list_provider_resources":            Registry,
+	"search_provider_attributes":         Registry,
+	"list_provider_doc_categories":       Registry,
+	"get_provider_overview":              Registry,
+	"get_provider_config_template":       Registry,
+	"get_provider_config_example":        Registry,
+	"get_resource_nested_block":          Registry,
+	"get_resource_arguments":             Registry,
+	"get_resource_examples":              Registry,
+	"get_resource_import_docs":           Registry,
+	"compare_provider_docs":              Registry,
+	"get_related_resources":              Registry,
+	"get_provider_naming_conventions":    Registry,
+	"get_provider_rate_limits":           Registry,
+	"get_provider_guide":                 Registry,
+	"resolve_provider_name":              Registry,
+	"generate_required_providers":        Registry,
+	"get_provider_docs_for_context":      Registry,
+	"get_provider_functions":             Registry,
+	"search_modules":                     Registry,
+	"get_module_details":                 Registry,
+	"get_module_readme":                  Registry,
+	"get_module_source":                  Registry,
+	"resolve_version_constraint":         Registry,
+	"list_namespace_modules":             Registry,
+	"search_registry":                    Registry,
+	"get_policy_set_metadata":            Registry,
+	"get_module_dependencies":            Registry,
+	"get_latest_module_version":          Registry,
+	"generate_module_variables":          Registry,
+	"get_module_outputs":                 Registry,
+	"validate_module_inputs":             Registry,
+	"get_module_input_validations":       Registry,
+	"compare_module_versions":            Registry,
+	"search_policies":                    Registry,
+	"get_policy_details":                 Registry,
+	"list_policies":                      Registry,
+	"list_policy_enforcement_levels":     Registry,
+	"download_policy_module":             Registry,
+	"list_tool_costs":                    Registry,
 
 	// Private Registry tools (TFE/TFC private registry)
 	"search_private_modules":       RegistryPrivate,
@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// DryRunMetaKey is the _meta field of a tools/call request that asks to only validate the arguments
+const DryRunMetaKey = "dry_run"
+
+// IsDryRun reports whether a tool call only asks to validate its arguments
+func IsDryRun(request mcp.CallToolRequest) bool {
+	if request.Params.Meta == nil {
+		return false
+	}
+	dryRun, _ := request.Params.Meta.AdditionalFields[DryRunMetaKey].(bool)
+	return dryRun
+}
+
+// ArgumentError describes why a single tool argument was rejected
+type ArgumentError struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+// ArgumentErrors are all the problems found in the arguments of a tool call
+type ArgumentErrors []ArgumentError
+
+func (e ArgumentErrors) Error() string {
+	parts := make([]string, 0, len(e))
+	for _, argumentError := range e {
+		parts = append(parts, fmt.Sprintf("%s: %s", argumentError.Field, argumentError.Reason))
+	}
+	return "invalid arguments: " + strings.Join(parts, "; ")
+}
+
+// invalidArgumentsResult is the structured content of a tool result rejecting the arguments of a call
+type invalidArgumentsResult struct {
+	Error     string         `json:"error"`
+	Code      ErrorCode      `json:"code"`
+	Arguments ArgumentErrors `json:"arguments"`
+}
+
+// NewArgumentErrorResult creates an error tool result listing the rejected arguments, both as text and as
+// structured content ({"error": "invalid_arguments", "code": "INVALID_ARGUMENT", "arguments": [{"field": ...,
+// "reason": ...}]}) so orchestrators can tell which field to fix
+func NewArgumentErrorResult(errs ArgumentErrors) *mcp.CallToolResult {
+	var builder strings.Builder
+	builder.WriteString("Invalid arguments:\n")
+	for _, argumentError := range errs {
+		builder.WriteString(fmt.Sprintf("- %s: %s\n", argumentError.Field, argumentError.Reason))
+	}
+	result := mcp.NewToolResultError(strings.TrimSuffix(builder.String(), "\n"))
+	result.StructuredContent = invalidArgumentsResult{Error: "invalid_arguments", Code: ErrorCodeInvalidArgument, Arguments: errs}
+	return result
+}
+
+// ValidateArguments checks the arguments of a tool call against the input schema of the tool: required
+// arguments must be present, and every argument must match the type, enum and bounds of its property.
+// Whether an empty string is acceptable depends on the tool, so that and arguments the schema does not
+// declare are left to the handler.
+func ValidateArguments(schema mcp.ToolInputSchema, args map[string]any) ArgumentErrors {
+	var errs ArgumentErrors
+	for _, field := range schema.Required {
+		value, ok := args[field]
+		if !ok || value == nil {
+			errs = append(errs, ArgumentError{Field: field, Reason: "is required"})
+		}
+	}
+
+	fields := make([]string, 0, len(args))
+	for field := range args {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		property, ok := schema.Properties[field].(map[string]any)
+		if !ok || args[field] == nil {
+			continue
+		}
+		if reason := validateArgument(property, args[field]); reason != "" {
+			errs = append(errs, ArgumentError{Field: field, Reason: reason})
+		}
+	}
+	return errs
+}
+
+// validateArgument returns why value does not match the schema property, empty when it does
+func validateArgument(property map[string]any, value any) string {
+	switch property["type"] {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Sprintf("must be a string, got %s", jsonTypeName(value))
+		}
+		if enum := schemaEnum(property["enum"]); len(enum) > 0 && s != "" && !containsFold(enum, s) {
+			return fmt.Sprintf("must be one of %s, got '%s'", strings.Join(enum, ", "), s)
+		}
+	case "number", "integer":
+		n, ok := argumentNumber(value)
+		if !ok {
+			return fmt.Sprintf("must be a number, got %s", jsonTypeName(value))
+		}
+		if property["type"] == "integer" && n != math.Trunc(n) {
+			return fmt.Sprintf("must be an integer, got %v", n)
+		}
+		if minimum, ok := argumentNumber(property["minimum"]); ok && n < minimum {
+			return fmt.Sprintf("must be at least %v, got %v", minimum, n)
+		}
+		if maximum, ok := argumentNumber(property["maximum"]); ok && n > maximum {
+			return fmt.Sprintf("must be at most %v, got %v", maximum, n)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Sprintf("must be a boolean, got %s", jsonTypeName(value))
+		}
+	case "array":
+		// Some clients send arrays and objects JSON encoded, the handlers decode those themselves
+		if _, ok := value.([]any); !ok && !isString(value) {
+			return fmt.Sprintf("must be an array, got %s", jsonTypeName(value))
+		}
+	case "object":
+		if _, ok := value.(map[string]any); !ok && !isString(value) {
+			return fmt.Sprintf("must be an object, got %s", jsonTypeName(value))
+		}
+	}
+	return ""
+}
+
+// argumentNumber converts a numeric argument or schema bound, which can be a float64 when decoded from JSON
+// and an int when set in process
+func argumentNumber(value any) (float64, bool) {
+	switch n := value.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// schemaEnum returns the allowed values of a property, declared by mcp.Enum as []string
+func schemaEnum(enum any) []string {
+	switch values := enum.(type) {
+	case []string:
+		return values
+	case []any:
+		result := make([]string, 0, len(values))
+		for _, value := range values {
+			result = append(result, fmt.Sprint(value))
+		}
+		return result
+	}
+	return nil
+}
+
+func isString(value any) bool {
+	_, ok := value.(string)
+	return ok
+}
+
+// containsFold reports whether value is one of values, ignoring case as the handlers lowercase enum arguments
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonTypeName names the JSON type of a decoded argument for error messages
+func jsonTypeName(value any) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case float64, int, int64:
+		return "number"
+	case bool:
+		return "boolean"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !integration
+
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateArguments(t *testing.T) {
+	schema := mcp.NewTool("example",
+		mcp.WithString("provider_name", mcp.Required()),
+		mcp.WithString("provider_document_type", mcp.Enum("resources", "data-sources")),
+		mcp.WithNumber("page", mcp.Min(1), mcp.Max(10)),
+		mcp.WithBoolean("verify_checksums"),
+		mcp.WithArray("workspace_ids"),
+	).InputSchema
+
+	tests := []struct {
+		name     string
+		args     map[string]any
+		expected ArgumentErrors
+	}{
+		{
+			name: "valid",
+			args: map[string]any{"provider_name": "aws", "provider_document_type": "resources", "page": float64(2), "verify_checksums": true, "workspace_ids": []any{"ws-1"}},
+		},
+		{
+			name: "empty required string is left to the handler",
+			args: map[string]any{"provider_name": ""},
+		},
+		{
+			name: "JSON encoded arrays and case insensitive enums",
+			args: map[string]any{"provider_name": "aws", "provider_document_type": "Resources", "workspace_ids": `["ws-1"]`},
+		},
+		{
+			name: "undeclared arguments are ignored",
+			args: map[string]any{"provider_name": "aws", "unknown": 1},
+		},
+		{
+			name:     "missing required",
+			args:     map[string]any{},
+			expected: ArgumentErrors{{Field: "provider_name", Reason: "is required"}},
+		},
+		{
+			name: "malformed",
+			args: map[string]any{"provider_name": 42, "provider_document_type": "guides", "page": float64(0), "verify_checksums": "yes", "workspace_ids": float64(1)},
+			expected: ArgumentErrors{
+				{Field: "page", Reason: "must be at least 1, got 0"},
+				{Field: "provider_document_type", Reason: "must be one of resources, data-sources, got 'guides'"},
+				{Field: "provider_name", Reason: "must be a string, got number"},
+				{Field: "verify_checksums", Reason: "must be a boolean, got string"},
+				{Field: "workspace_ids", Reason: "must be an array, got number"},
+			},
+		},
+		{
+			name:     "above maximum",
+			args:     map[string]any{"provider_name": "aws", "page": 11},
+			expected: ArgumentErrors{{Field: "page", Reason: "must be at most 10, got 11"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ValidateArguments(schema, tt.args))
+		})
+	}
+}
+
+func TestNewArgumentErrorResult(t *testing.T) {
+	result := NewArgumentErrorResult(ArgumentErrors{{Field: "module_id", Reason: "is required"}})
+	require.True(t, result.IsError)
+	assert.Equal(t, "Invalid arguments:\n- module_id: is required", result.Content[0].(mcp.TextContent).Text)
+
+	out, err := json.Marshal(result.StructuredContent)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"error":"invalid_arguments","code":"INVALID_ARGUMENT","arguments":[{"field":"module_id","reason":"is required"}]}`, string(out))
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+)
+
+// changelogHeading matches the heading of a release in a CHANGELOG.md, e.g. "## 5.80.0 (December 12, 2024)",
+// "## [v1.2.0] - 2024-05-01" or "# 0.9.1"
+var changelogHeading = regexp.MustCompile(`^#{1,3}\s+\[?v?(\d+\.\d+\.\d+(?:-[0-9A-Za-z.-]+)?)\]?(.*)$`)
+
+// ChangelogEntry is the section of a changelog describing a single release
+type ChangelogEntry struct {
+	Version string `json:"version"`
+	Heading string `json:"heading"`
+	Body    string `json:"body"`
+}
+
+// ParseChangelog splits a markdown changelog into its release sections, in the order of the file
+func ParseChangelog(markdown string) []ChangelogEntry {
+	var entries []ChangelogEntry
+	var body []string
+	flush := func() {
+		if len(entries) > 0 {
+			entries[len(entries)-1].Body = strings.TrimSpace(strings.Join(body, "\n"))
+		}
+		body = nil
+	}
+	for _, line := range strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n") {
+		if match := changelogHeading.FindStringSubmatch(line); match != nil {
+			flush()
+			entries = append(entries, ChangelogEntry{Version: match[1], Heading: strings.TrimSpace(strings.TrimLeft(line, "#"))})
+			continue
+		}
+		if len(entries) > 0 {
+			body = append(body, line)
+		}
+	}
+	flush()
+	return entries
+}
+
+// ChangelogBetween returns the entries of the releases after from up to and including to, newest first. Unreleased
+// sections are only included when they are one of the versions asked for.
+func ChangelogBetween(entries []ChangelogEntry, from string, to string) ([]ChangelogEntry, error) {
+	lower, err := ParseVersion(from)
+	if err != nil {
+		return nil, err
+	}
+	upper, err := ParseVersion(to)
+	if err != nil {
+		return nil, err
+	}
+	if lower.GreaterThan(upper) {
+		return nil, fmt.Errorf("version %s is newer than %s", from, to)
+	}
+
+	type parsedEntry struct {
+		entry   ChangelogEntry
+		version *version.Version
+	}
+	var between []parsedEntry
+	for _, entry := range entries {
+		v, err := ParseVersion(entry.Version)
+		if err != nil || !v.GreaterThan(lower) || v.GreaterThan(upper) {
+			continue
+		}
+		if strings.Contains(strings.ToLower(entry.Heading), "unreleased") && !v.Equal(upper) {
+			continue
+		}
+		between = append(between, parsedEntry{entry: entry, version: v})
+	}
+	// Changelogs are not always kept in order
+	sort.SliceStable(between, func(i, j int) bool { return between[i].version.GreaterThan(between[j].version) })
+
+	result := make([]ChangelogEntry, 0, len(between))
+	for _, p := range between {
+		result = append(result, p.entry)
+	}
+	return result, nil
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !integration
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testChangelog = `# Changelog
+
+## 5.3.0 (Unreleased)
+
+FEATURES:
+
+* **New Resource:** ` + "`aws_example`" + `
+
+## 5.2.0 (March 1, 2024)
+
+BUG FIXES:
+
+* resource/aws_instance: Fix crash
+
+## [v5.1.0] - 2024-02-01
+
+* Added tags
+
+## 5.0.1 (January 5, 2024)
+
+* Docs fixes
+`
+
+func TestParseChangelog(t *testing.T) {
+	entries := ParseChangelog(testChangelog)
+	require.Len(t, entries, 4)
+	assert.Equal(t, "5.3.0", entries[0].Version)
+	assert.Equal(t, "5.3.0 (Unreleased)", entries[0].Heading)
+	assert.Equal(t, "5.1.0", entries[2].Version)
+	assert.Equal(t, "BUG FIXES:\n\n* resource/aws_instance: Fix crash", entries[1].Body)
+	assert.Equal(t, "* Docs fixes", entries[3].Body)
+
+	assert.Empty(t, ParseChangelog("# Changelog\n\nNothing released yet"))
+}
+
+func TestChangelogBetween(t *testing.T) {
+	entries := ParseChangelog(testChangelog)
+
+	between, err := ChangelogBetween(entries, "5.0.1", "5.2.0")
+	require.NoError(t, err)
+	require.Len(t, between, 2)
+	assert.Equal(t, "5.2.0", between[0].Version)
+	assert.Equal(t, "5.1.0", between[1].Version)
+
+	// Unreleased sections are left out unless asked for
+	between, err = ChangelogBetween(entries, "5.1.0", "6.0.0")
+	require.NoError(t, err)
+	require.Len(t, between, 1)
+	assert.Equal(t, "5.2.0", between[0].Version)
+
+	between, err = ChangelogBetween(entries, "v5.2", "5.3.0")
+	require.NoError(t, err)
+	require.Len(t, between, 1)
+	assert.Equal(t, "5.3.0", between[0].Version)
+
+	between, err = ChangelogBetween(entries, "5.2.0", "5.2.0")
+	require.NoError(t, err)
+	assert.Empty(t, between)
+
+	_, err = ChangelogBetween(entries, "5.2.0", "5.0.0")
+	assert.Error(t, err)
+	_, err = ChangelogBetween(entries, "latest", "5.0.0")
+	assert.Error(t, err)
+}
@@ -0,0 +1,764 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"encoding/json"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// DocArgument is a single argument or attribute parsed from a provider documentation page.
+type DocArgument struct {
+	Name        string `json:"name"`
+	Type        string `json:"type,omitempty"`      // e.g. "String", "Block List, Max: 1", empty when the doc does not state it
+	Qualifier   string `json:"qualifier,omitempty"` // "Required", "Optional", "Read-Only" or empty
+	Description string `json:"description"`
+}
+
+// DocBlock is a named nested block and the arguments documented for it.
+type DocBlock struct {
+	Name      string        `json:"name"`
+	Arguments []DocArgument `json:"arguments"`
+}
+
+var (
+	docHeadingRegex = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	// tfplugindocs generated docs: "### Nested Schema for `root_block_device`"
+	nestedSchemaHeadingRegex = regexp.MustCompile("^Nested Schema for `([^`]+)`")
+	// Hand written docs: "### root_block_device" or "### `root_block_device`"
+	blockNameHeadingRegex = regexp.MustCompile("^`?([a-z0-9_]+)`?$")
+	// Hand written docs: "The `root_block_device` block supports the following:"
+	blockIntroRegex = regexp.MustCompile("(?i)^(?:the\\s+)?`([a-z0-9_.]+)`\\s+(?:configuration\\s+|nested\\s+)?(?:block|object)s?\\s+(?:supports|contains|exports|has)")
+	// tfplugindocs section markers inside a nested schema: "Required:", "Optional:", "Read-Only:"
+	qualifierLineRegex = regexp.MustCompile(`^(Required|Optional|Read-Only):\s*$`)
+	// List item describing an argument: "* `name` - ..." or "- `name` (String) ..."
+	argumentLineRegex = regexp.MustCompile("^\\s*[*-]\\s+`([^`]+)`\\s*(.*)$")
+	// Type or qualifier in parentheses at the start of the argument text
+	leadingParenRegex  = regexp.MustCompile(`^\(([^)]*)\)\s*`)
+	nestedSchemaAnchor = regexp.MustCompile(`\s*\(see \[below for nested schema\]\([^)]*\)\)`)
+	htmlCommentRegex   = regexp.MustCompile(`(?s)<!--.*?-->`)
+	blankLinesRegex    = regexp.MustCompile(`\n{3,}`)
+)
+
+// StripFrontMatter removes a leading YAML front matter block ("---" delimited) from a markdown document.
+func StripFrontMatter(content string) string {
+	trimmed := strings.TrimLeft(content, "\ufeff \t\r\n")
+	if !strings.HasPrefix(trimmed, "---") {
+		return content
+	}
+	rest := trimmed[3:]
+	end := strings.Index(rest, "\n---")
+	if end == -1 {
+		return content
+	}
+	rest = rest[end+len("\n---"):]
+	// Skip the remainder of the closing delimiter line
+	if newline := strings.Index(rest, "\n"); newline != -1 {
+		rest = rest[newline+1:]
+	} else {
+		rest = ""
+	}
+	return rest
+}
+
+// CleanProviderDoc prepares provider documentation markdown for display: front matter and HTML
+// comments are removed and runs of blank lines are collapsed.
+func CleanProviderDoc(content string) string {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = StripFrontMatter(content)
+	content = htmlCommentRegex.ReplaceAllString(content, "")
+	content = blankLinesRegex.ReplaceAllString(content, "\n\n")
+	return strings.TrimSpace(content)
+}
+
+// ParseDocNestedBlocks extracts the nested blocks documented in a resource or data source page.
+// Both tfplugindocs "Nested Schema for" sections and hand written "The `x` block supports" sections
+// are recognised. Blocks are returned in the order they first appear.
+func ParseDocNestedBlocks(content string) []DocBlock {
+	var blocks []DocBlock
+	index := make(map[string]int)
+	current := -1
+	qualifier := ""
+
+	startBlock := func(name string) {
+		qualifier = ""
+		if i, ok := index[name]; ok {
+			current = i
+			return
+		}
+		blocks = append(blocks, DocBlock{Name: name})
+		index[name] = len(blocks) - 1
+		current = len(blocks) - 1
+	}
+
+	inCodeFence := false
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCodeFence = !inCodeFence
+			continue
+		}
+		if inCodeFence {
+			continue
+		}
+
+		if match := docHeadingRegex.FindStringSubmatch(trimmed); match != nil {
+			heading := match[2]
+			if nested := nestedSchemaHeadingRegex.FindStringSubmatch(heading); nested != nil {
+				startBlock(nested[1])
+			} else if name := blockNameHeadingRegex.FindStringSubmatch(heading); name != nil {
+				startBlock(name[1])
+			} else {
+				current = -1
+			}
+			continue
+		}
+
+		if intro := blockIntroRegex.FindStringSubmatch(trimmed); intro != nil {
+			startBlock(intro[1])
+			continue
+		}
+
+		if current < 0 {
+			continue
+		}
+
+		if match := qualifierLineRegex.FindStringSubmatch(trimmed); match != nil {
+			qualifier = match[1]
+			continue
+		}
+
+		if match := argumentLineRegex.FindStringSubmatch(line); match != nil {
+			argument := parseDocArgument(match[1], match[2])
+			if argument.Qualifier == "" {
+				argument.Qualifier = qualifier
+			}
+			blocks[current].Arguments = append(blocks[current].Arguments, argument)
+		}
+	}
+
+	// Drop headings that turned out not to describe any arguments
+	result := blocks[:0]
+	for _, block := range blocks {
+		if len(block.Arguments) > 0 {
+			result = append(result, block)
+		}
+	}
+	return result
+}
+
+// parseDocArgument splits the text following an argument name into type, qualifier and description
+func parseDocArgument(name string, rest string) DocArgument {
+	argument := DocArgument{Name: name}
+	rest = nestedSchemaAnchor.ReplaceAllString(rest, "")
+	rest = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(rest), "-"))
+
+	for {
+		match := leadingParenRegex.FindStringSubmatch(rest)
+		if match == nil {
+			break
+		}
+		value := strings.TrimSpace(match[1])
+		switch {
+		case strings.HasPrefix(value, "Required"):
+			argument.Qualifier = "Required"
+		case strings.HasPrefix(value, "Optional"):
+			argument.Qualifier = "Optional"
+		case strings.HasPrefix(value, "Deprecated"), strings.HasPrefix(value, "Sensitive"):
+			// Markers only, keep them in the description
+			argument.Description = strings.TrimSpace(argument.Description + " (" + value + ")")
+		default:
+			argument.Type = value
+		}
+		rest = strings.TrimSpace(rest[len(match[0]):])
+		rest = strings.TrimSpace(strings.TrimPrefix(rest, "-"))
+	}
+
+	argument.Description = strings.TrimSpace(strings.TrimSpace(argument.Description) + " " + rest)
+	return argument
+}
+
+// FindDocBlock looks up a nested block by name. The match is case-insensitive and also accepts the
+// last segment of a dotted tfplugindocs path, e.g. "ebs" matches "launch_template.ebs".
+func FindDocBlock(blocks []DocBlock, name string) (DocBlock, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, block := range blocks {
+		if strings.ToLower(block.Name) == name {
+			return block, true
+		}
+	}
+	for _, block := range blocks {
+		parts := strings.Split(strings.ToLower(block.Name), ".")
+		if parts[len(parts)-1] == name {
+			return block, true
+		}
+	}
+	return DocBlock{}, false
+}
+
+// Code block languages recognised by DetectCodeBlockLanguage
+const (
+	CodeLanguageHCL   = "hcl"
+	CodeLanguageShell = "shell"
+	CodeLanguageJSON  = "json"
+	CodeLanguageText  = "text"
+)
+
+// CodeBlock is a fenced code block extracted from a markdown document.
+type CodeBlock struct {
+	Language string `json:"language"`           // normalised language, one of the CodeLanguage constants
+	InfoTag  string `json:"info_tag,omitempty"` // the raw info string of the fence, may be empty
+	Heading  string `json:"heading,omitempty"`  // text of the closest markdown heading preceding the block
+	Code     string `json:"code"`
+}
+
+var (
+	codeFenceRegex   = regexp.MustCompile("^\\s*(```+|~~~+)\\s*([^\\s`]*)")
+	hclBlockRegex    = regexp.MustCompile(`(?m)^\s*(resource|data|provider|module|variable|output|locals|terraform|import|moved|removed|check|ephemeral|action|list)(\s+"[^"]*")*\s*\{`)
+	hclAttrRegex     = regexp.MustCompile(`(?m)^\s*[a-zA-Z_][a-zA-Z0-9_-]*\s*=\s*\S`)
+	shellPromptRegex = regexp.MustCompile(`(?m)^\s*([$%]\s+|#\s*!\s*/|(terraform|export|curl|aws|gcloud|az|kubectl|echo|cd|mkdir|chmod)\s)`)
+)
+
+// infoTagLanguages maps the info string of a code fence to a normalised language
+var infoTagLanguages = map[string]string{
+	"hcl":           CodeLanguageHCL,
+	"terraform":     CodeLanguageHCL,
+	"tf":            CodeLanguageHCL,
+	"hcl2":          CodeLanguageHCL,
+	"sh":            CodeLanguageShell,
+	"shell":         CodeLanguageShell,
+	"bash":          CodeLanguageShell,
+	"zsh":           CodeLanguageShell,
+	"console":       CodeLanguageShell,
+	"shell-session": CodeLanguageShell,
+	"json":          CodeLanguageJSON,
+	"text":          CodeLanguageText,
+	"txt":           CodeLanguageText,
+	"plaintext":     CodeLanguageText,
+}
+
+// ExtractCodeBlocks returns the fenced code blocks of a markdown document, labelled with their detected language.
+func ExtractCodeBlocks(content string) []CodeBlock {
+	var blocks []CodeBlock
+	var current *CodeBlock
+	var fence string
+	var code []string
+	heading := ""
+
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		if current == nil {
+			if match := docHeadingRegex.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+				heading = match[2]
+				continue
+			}
+			if match := codeFenceRegex.FindStringSubmatch(line); match != nil {
+				current = &CodeBlock{InfoTag: strings.ToLower(match[2]), Heading: heading}
+				fence = match[1]
+				code = nil
+			}
+			continue
+		}
+		if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+			current.Code = strings.Join(code, "\n")
+			current.Language = DetectCodeBlockLanguage(current.InfoTag, current.Code)
+			blocks = append(blocks, *current)
+			current = nil
+			continue
+		}
+		code = append(code, line)
+	}
+	return blocks
+}
+
+// DetectCodeBlockLanguage normalises the language of a code block. A known info string is trusted,
+// otherwise the language is inferred from the code itself.
+func DetectCodeBlockLanguage(infoTag string, code string) string {
+	if language, ok := infoTagLanguages[strings.ToLower(strings.TrimSpace(infoTag))]; ok {
+		// Shell fences frequently wrap HCL snippets in provider docs, trust the content in that case
+		if language == CodeLanguageShell && hclBlockRegex.MatchString(code) && !shellPromptRegex.MatchString(code) {
+			return CodeLanguageHCL
+		}
+		return language
+	}
+
+	trimmed := strings.TrimSpace(code)
+	switch {
+	case trimmed == "":
+		return CodeLanguageText
+	case (strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")) && json.Valid([]byte(trimmed)):
+		return CodeLanguageJSON
+	case hclBlockRegex.MatchString(code):
+		return CodeLanguageHCL
+	case shellPromptRegex.MatchString(code):
+		return CodeLanguageShell
+	case hclAttrRegex.MatchString(code):
+		return CodeLanguageHCL
+	}
+	return CodeLanguageText
+}
+
+// FilterCodeBlocks keeps only the code blocks in one of the given languages. An empty list keeps all blocks.
+func FilterCodeBlocks(blocks []CodeBlock, languages ...string) []CodeBlock {
+	if len(languages) == 0 {
+		return blocks
+	}
+	var filtered []CodeBlock
+	for _, block := range blocks {
+		if slices.Contains(languages, block.Language) {
+			filtered = append(filtered, block)
+		}
+	}
+	return filtered
+}
+
+// charsPerToken is the average number of characters per LLM token of English prose and code
+const charsPerToken = 4
+
+// EstimateTokens returns a rough estimate of the number of LLM tokens a text takes up, for budgeting
+// results. It counts one token per charsPerToken characters, rounded up.
+func EstimateTokens(text string) int {
+	return (utf8.RuneCountInString(text) + charsPerToken - 1) / charsPerToken
+}
+
+// SplitDocPages splits a markdown document into pages of at most pageSize characters. Pages end on a
+// line boundary, preferably before a heading once the page is at least half full, so sections are
+// rarely cut in the middle. Lines longer than pageSize are split on their own.
+func SplitDocPages(content string, pageSize int) []string {
+	if pageSize <= 0 || len(content) <= pageSize {
+		return []string{content}
+	}
+
+	var pages []string
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			pages = append(pages, current.String())
+			current.Reset()
+		}
+	}
+
+	for _, line := range strings.SplitAfter(content, "\n") {
+		isHeading := strings.HasPrefix(line, "#")
+		if current.Len() > 0 && (current.Len()+len(line) > pageSize || (isHeading && current.Len() >= pageSize/2)) {
+			flush()
+		}
+		for len(line) > pageSize {
+			cut := pageSize
+			for cut > 0 && !utf8.RuneStart(line[cut]) {
+				cut--
+			}
+			if cut == 0 {
+				cut = pageSize
+			}
+			pages = append(pages, line[:cut])
+			line = line[cut:]
+		}
+		current.WriteString(line)
+	}
+	flush()
+
+	return pages
+}
+
+var (
+	// Top-level reference sections: "## Argument Reference", "## Attributes Reference", "## Schema" (tfplugindocs)
+	argumentSectionRegex  = regexp.MustCompile(`(?i)^arguments? reference$`)
+	attributeSectionRegex = regexp.MustCompile(`(?i)^attributes? reference$`)
+	schemaSectionRegex    = regexp.MustCompile(`(?i)^schema$`)
+	// tfplugindocs qualifier headings inside "## Schema": "### Required", "### Optional", "### Read-Only"
+	qualifierHeadingRegex = regexp.MustCompile(`^(Required|Optional|Read-Only)$`)
+)
+
+// ParseDocArguments extracts the top-level arguments and attributes documented in a resource or data source page,
+// from the "Argument Reference" and "Attributes Reference" sections of hand written docs or the "Schema" section
+// of tfplugindocs generated docs. Attributes that only appear in the attributes section are marked "Read-Only".
+func ParseDocArguments(content string) []DocArgument {
+	var arguments []DocArgument
+	seen := make(map[string]bool)
+
+	inSection := false
+	sectionQualifier := ""
+	qualifier := ""
+	inCodeFence := false
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCodeFence = !inCodeFence
+			continue
+		}
+		if inCodeFence {
+			continue
+		}
+
+		if match := docHeadingRegex.FindStringSubmatch(trimmed); match != nil {
+			level, heading := len(match[1]), match[2]
+			switch {
+			case argumentSectionRegex.MatchString(heading), schemaSectionRegex.MatchString(heading):
+				inSection, sectionQualifier, qualifier = true, "", ""
+			case attributeSectionRegex.MatchString(heading):
+				inSection, sectionQualifier, qualifier = true, "Read-Only", ""
+			case inSection && qualifierHeadingRegex.MatchString(heading):
+				qualifier = heading
+			case level <= 2 || nestedSchemaHeadingRegex.MatchString(heading) || blockNameHeadingRegex.MatchString(heading):
+				// A new top-level section or a nested block ends the reference section
+				inSection = false
+			}
+			continue
+		}
+		if !inSection {
+			continue
+		}
+
+		// "The `x` block supports" starts the description of a nested block, whose arguments are not top-level
+		if blockIntroRegex.MatchString(trimmed) {
+			inSection = false
+			continue
+		}
+		if match := qualifierLineRegex.FindStringSubmatch(trimmed); match != nil {
+			qualifier = match[1]
+			continue
+		}
+
+		// Only unindented list items are top-level arguments, indented ones describe sub-arguments inline
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			continue
+		}
+		if match := argumentLineRegex.FindStringSubmatch(line); match != nil {
+			argument := parseDocArgument(match[1], match[2])
+			if argument.Qualifier == "" {
+				argument.Qualifier = qualifier
+			}
+			if argument.Qualifier == "" {
+				argument.Qualifier = sectionQualifier
+			}
+			if seen[argument.Name] {
+				continue
+			}
+			seen[argument.Name] = true
+			arguments = append(arguments, argument)
+		}
+	}
+
+	return arguments
+}
+
+// DocFieldChange is the difference of a single documented field between two versions of a doc page.
+type DocFieldChange struct {
+	Path string       `json:"path"` // argument name, prefixed with its nested block for block arguments, e.g. "root_block_device.volume_size"
+	Old  *DocArgument `json:"old,omitempty"`
+	New  *DocArgument `json:"new,omitempty"`
+}
+
+// Added reports whether the field only exists in the new version
+func (c DocFieldChange) Added() bool { return c.Old == nil && c.New != nil }
+
+// Removed reports whether the field only exists in the old version
+func (c DocFieldChange) Removed() bool { return c.Old != nil && c.New == nil }
+
+// DocFields returns every documented field of a doc page keyed by its path: the top-level arguments and
+// attributes by name and the arguments of nested blocks as "block.argument".
+func DocFields(content string) map[string]DocArgument {
+	fields := make(map[string]DocArgument)
+	for _, argument := range ParseDocArguments(content) {
+		fields[argument.Name] = argument
+	}
+	for _, block := range ParseDocNestedBlocks(content) {
+		for _, argument := range block.Arguments {
+			fields[block.Name+"."+argument.Name] = argument
+		}
+	}
+	return fields
+}
+
+// DiffDocFields compares the fields of two versions of a doc page and returns the added, removed and changed
+// fields sorted by path. A field is changed when its type, qualifier or description differ.
+func DiffDocFields(oldFields, newFields map[string]DocArgument) []DocFieldChange {
+	var changes []DocFieldChange
+	for path, oldField := range oldFields {
+		newField, ok := newFields[path]
+		switch {
+		case !ok:
+			changes = append(changes, DocFieldChange{Path: path, Old: &oldField})
+		case oldField != newField:
+			changes = append(changes, DocFieldChange{Path: path, Old: &oldField, New: &newField})
+		}
+	}
+	for path, newField := range newFields {
+		if _, ok := oldFields[path]; !ok {
+			changes = append(changes, DocFieldChange{Path: path, New: &newField})
+		}
+	}
+	slices.SortFunc(changes, func(a, b DocFieldChange) int { return strings.Compare(a.Path, b.Path) })
+	return changes
+}
+
+// VersionedDocFields are the documented fields of a doc page in one provider version.
+// Present is false when the page does not exist in that version.
+type VersionedDocFields struct {
+	Version string
+	Present bool
+	Fields  map[string]DocArgument
+}
+
+// MergedDocField is a documented field merged across provider versions, with the versions it changed in.
+// AddedIn, RemovedIn and DeprecatedIn are empty when the field was available (or not deprecated) throughout.
+type MergedDocField struct {
+	Path         string      `json:"path"`
+	Field        DocArgument `json:"field"`                   // as documented in the newest version that has the field
+	AddedIn      string      `json:"added_in,omitempty"`      // first version documenting the field, when an earlier version did not
+	RemovedIn    string      `json:"removed_in,omitempty"`    // first version no longer documenting the field
+	DeprecatedIn string      `json:"deprecated_in,omitempty"` // first version describing the field as deprecated
+}
+
+// VersionSpecific reports whether the field is not available unchanged across the whole version range
+func (f MergedDocField) VersionSpecific() bool {
+	return f.AddedIn != "" || f.RemovedIn != "" || f.DeprecatedIn != ""
+}
+
+// MergeDocFieldVersions merges the fields of a doc page across versions, ordered oldest first, into a single
+// list sorted by path. Versions in which the page does not exist are skipped.
+func MergeDocFieldVersions(versions []VersionedDocFields) []MergedDocField {
+	merged := make(map[string]*MergedDocField)
+	seen := make(map[string]bool)
+	previous := -1
+	for i, version := range versions {
+		if !version.Present {
+			continue
+		}
+		for path, field := range version.Fields {
+			entry, ok := merged[path]
+			if !ok {
+				entry = &MergedDocField{Path: path}
+				merged[path] = entry
+				if previous >= 0 {
+					entry.AddedIn = version.Version
+				}
+			}
+			entry.Field = field
+			entry.RemovedIn = ""
+			if entry.DeprecatedIn == "" && strings.Contains(strings.ToLower(field.Description), "deprecated") {
+				entry.DeprecatedIn = version.Version
+			}
+			seen[path] = true
+		}
+		for path, entry := range merged {
+			if _, ok := version.Fields[path]; !ok && seen[path] && entry.RemovedIn == "" {
+				entry.RemovedIn = version.Version
+			}
+		}
+		previous = i
+	}
+
+	fields := make([]MergedDocField, 0, len(merged))
+	for _, entry := range merged {
+		fields = append(fields, *entry)
+	}
+	slices.SortFunc(fields, func(a, b MergedDocField) int { return strings.Compare(a.Path, b.Path) })
+	return fields
+}
+
+// DocReference is a link from a provider doc page to the page of another resource or data source of the same provider.
+type DocReference struct {
+	Category string // "resources" or "data-sources"
+	Slug     string // the name without the provider prefix, e.g. "iam_role"
+	LinkText string
+	URL      string // the link target as written in the document
+}
+
+var (
+	markdownLinkRegex = regexp.MustCompile(`\[([^\]]*)\]\(\s*<?([^)\s>]+)>?(?:\s+"[^"]*")?\s*\)`)
+	// Legacy website links: "/docs/providers/aws/r/iam_role.html"
+	legacyDocLinkRegex = regexp.MustCompile(`^(?:https?://(?:www\.)?terraform\.io)?/docs/providers/([a-z0-9-]+)/(r|d)/([a-z0-9_]+)(?:\.html(?:\.markdown)?)?$`)
+	// Registry links: "https://registry.terraform.io/providers/hashicorp/aws/latest/docs/resources/iam_role"
+	registryDocLinkRegex = regexp.MustCompile(`^(?:https?://registry\.terraform\.io)?/providers/[a-z0-9-]+/([a-z0-9-]+)/[^/]+/docs/(resources|data-sources)/([a-z0-9_]+)$`)
+	// Relative links between pages: "../r/iam_role.html", "r/iam_role.html", "../data-sources/iam_role.md"
+	relativeDocLinkRegex = regexp.MustCompile(`^(?:\.\./|\./)?(r|d|resources|data-sources)/([a-z0-9_]+)(?:\.html(?:\.markdown)?|\.md)?$`)
+)
+
+// ExtractDocCrossReferences returns the resources and data sources of the given provider linked from a doc page,
+// in the order they first appear. Links to other providers and to external sites are ignored.
+func ExtractDocCrossReferences(content string, providerName string) []DocReference {
+	var references []DocReference
+	seen := make(map[string]bool)
+
+	inCodeFence := false
+	for _, line := range strings.Split(content, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCodeFence = !inCodeFence
+			continue
+		}
+		if inCodeFence {
+			continue
+		}
+
+		for _, match := range markdownLinkRegex.FindAllStringSubmatch(line, -1) {
+			target := match[2]
+			if anchor := strings.Index(target, "#"); anchor != -1 {
+				target = target[:anchor]
+			}
+			if query := strings.Index(target, "?"); query != -1 {
+				target = target[:query]
+			}
+			category, slug, ok := parseDocLinkTarget(strings.ToLower(target), providerName)
+			if !ok {
+				continue
+			}
+			key := category + "/" + slug
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			references = append(references, DocReference{
+				Category: category,
+				Slug:     slug,
+				LinkText: strings.Trim(match[1], "`* "),
+				URL:      match[2],
+			})
+		}
+	}
+
+	return references
+}
+
+// parseDocLinkTarget returns the category and slug of a link to a resource or data source page of the provider
+func parseDocLinkTarget(target string, providerName string) (string, string, bool) {
+	var category, slug string
+	if match := legacyDocLinkRegex.FindStringSubmatch(target); match != nil {
+		if match[1] != providerName {
+			return "", "", false
+		}
+		category, slug = match[2], match[3]
+	} else if match := registryDocLinkRegex.FindStringSubmatch(target); match != nil {
+		if match[1] != providerName {
+			return "", "", false
+		}
+		category, slug = match[2], match[3]
+	} else if match := relativeDocLinkRegex.FindStringSubmatch(target); match != nil {
+		category, slug = match[1], match[2]
+	} else {
+		return "", "", false
+	}
+
+	switch category {
+	case "r":
+		category = "resources"
+	case "d":
+		category = "data-sources"
+	}
+	return category, strings.TrimPrefix(slug, providerName+"_"), true
+}
+
+// DocDeprecation is a deprecation notice found in a provider doc page, for the documented resource as a whole or
+// for one of its arguments.
+type DocDeprecation struct {
+	Kind        string `json:"kind"` // "resource" or "argument"
+	Name        string `json:"name"`
+	Notice      string `json:"notice"`
+	Replacement string `json:"replacement,omitempty"` // the construct to use instead, when the notice names one
+}
+
+var (
+	// Callouts of the registry docs: "!> **WARNING:** ...", "~> **NOTE:** ...", "-> ..." and plain blockquotes
+	docCalloutRegex = regexp.MustCompile(`^(?:!>|~>|->|>)\s*(.*)$`)
+	// Bold lead of a callout: "**WARNING:**", "**Note**:", "**Deprecated**"
+	calloutLeadRegex = regexp.MustCompile(`^\*\*[A-Za-z ]+:?\*\*:?\s*`)
+	// A callout about an argument: "The `name` argument is deprecated"
+	deprecatedArgumentRegex = regexp.MustCompile("(?i)`([a-z0-9_.]+)`\\s+(?:argument|attribute|block|field)s?\\s+(?:is|are|has been|have been)\\s+deprecated")
+	// Guidance naming what to use instead: "Use `b` instead", "in favor of `b`", "replaced by the `b` resource"
+	deprecationReplacementRegex = regexp.MustCompile("(?i)(?:use|in favou?r of|replaced by|migrate to|superseded by)\\s+(?:the\\s+)?`([^`]+)`")
+)
+
+// ExtractDocDeprecations returns the deprecation notices of a doc page titled title: callouts and paragraphs
+// deprecating the resource, and arguments marked deprecated in the argument and attribute lists.
+func ExtractDocDeprecations(content string, title string) []DocDeprecation {
+	var deprecations []DocDeprecation
+	seen := make(map[string]bool)
+	add := func(kind string, name string, notice string) {
+		if seen[kind+"/"+name] {
+			return
+		}
+		seen[kind+"/"+name] = true
+		deprecation := DocDeprecation{Kind: kind, Name: name, Notice: notice}
+		if match := deprecationReplacementRegex.FindStringSubmatch(notice); match != nil {
+			deprecation.Replacement = match[1]
+		}
+		deprecations = append(deprecations, deprecation)
+	}
+
+	inCodeFence := false
+	for _, line := range strings.Split(StripFrontMatter(content), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCodeFence = !inCodeFence
+			continue
+		}
+		if inCodeFence || !strings.Contains(strings.ToLower(trimmed), "deprecat") {
+			continue
+		}
+
+		if match := argumentLineRegex.FindStringSubmatch(line); match != nil {
+			if isDeprecationNotice(match[2]) {
+				add("argument", match[1], strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(match[2]), "-")))
+			}
+			continue
+		}
+		notice := trimmed
+		if match := docCalloutRegex.FindStringSubmatch(trimmed); match != nil {
+			notice = calloutLeadRegex.ReplaceAllString(strings.TrimSpace(match[1]), "")
+		} else if docHeadingRegex.MatchString(trimmed) || !isDeprecationNotice(trimmed) {
+			continue
+		}
+		if match := deprecatedArgumentRegex.FindStringSubmatch(notice); match != nil {
+			add("argument", match[1], notice)
+		} else if isDeprecationNotice(notice) {
+			add("resource", title, notice)
+		}
+	}
+	return deprecations
+}
+
+// isDeprecationNotice reports whether text declares something deprecated, rather than merely mentioning a
+// deprecated construct as in "replaces the deprecated `x` argument"
+func isDeprecationNotice(text string) bool {
+	lower := strings.ToLower(text)
+	for _, marker := range []string{"deprecated)", "deprecated,", "**deprecated**", "deprecated:", "is deprecated", "are deprecated", "has been deprecated", "have been deprecated", "will be deprecated"} {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	return strings.HasPrefix(strings.TrimLeft(lower, "(*_ -"), "deprecated")
+}
+
+// ExtractDocSection returns the body of the section of a markdown document whose heading is title, ignoring case,
+// up to the next heading of the same or a higher level. Headings inside code fences, such as shell comments, do
+// not end the section. The second result reports whether the section was found.
+func ExtractDocSection(content string, title string) (string, bool) {
+	var section []string
+	level := 0
+	inCodeFence := false
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCodeFence = !inCodeFence
+		} else if match := docHeadingRegex.FindStringSubmatch(line); match != nil && !inCodeFence {
+			switch {
+			case level == 0 && strings.EqualFold(match[2], title):
+				level = len(match[1])
+				continue
+			case level > 0 && len(match[1]) <= level:
+				return strings.TrimSpace(strings.Join(section, "\n")), true
+			}
+		}
+		if level > 0 {
+			section = append(section, line)
+		}
+	}
+	if level == 0 {
+		return "", false
+	}
+	return strings.TrimSpace(strings.Join(section, "\n")), true
+}
@@ -0,0 +1,361 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !integration
+
+package utils
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const handWrittenResourceDoc = "---\n" +
+	"subcategory: \"EC2 (Elastic Compute Cloud)\"\n" +
+	"---\n\n" +
+	"# Resource: aws_instance\n\n" +
+	"## Example Usage\n\n" +
+	"```terraform\nresource \"aws_instance\" \"web\" {\n  root_block_device {\n    volume_size = 10\n  }\n}\n```\n\n" +
+	"## Argument Reference\n\n" +
+	"* `ami` - (Optional) AMI to use for the instance.\n" +
+	"* `root_block_device` - (Optional) Configuration block to customize details about the root block device.\n\n" +
+	"### root_block_device\n\n" +
+	"Each instance has a single root device.\n\n" +
+	"* `delete_on_termination` - (Optional) Whether the volume should be destroyed on instance termination. Defaults to `true`.\n" +
+	"* `volume_size` - (Optional) Size of the volume in gibibytes (GiB).\n\n" +
+	"### Timeouts\n\n" +
+	"* `create` - (Default `10m`)\n\n" +
+	"The `credit_specification` block supports the following:\n\n" +
+	"* `cpu_credits` - (Optional) Credit option for CPU usage.\n"
+
+const generatedResourceDoc = "## Schema\n\n" +
+	"### Required\n\n" +
+	"- `name` (String) The name.\n\n" +
+	"### Optional\n\n" +
+	"- `launch_template` (Block List, Max: 1) Launch template. (see [below for nested schema](#nestedblock--launch_template))\n\n" +
+	"<a id=\"nestedblock--launch_template\"></a>\n" +
+	"### Nested Schema for `launch_template`\n\n" +
+	"Required:\n\n" +
+	"- `id` (String) The ID of the template.\n\n" +
+	"Optional:\n\n" +
+	"- `version` (String) Template version.\n\n" +
+	"<a id=\"nestedblock--launch_template--ebs\"></a>\n" +
+	"### Nested Schema for `launch_template.ebs`\n\n" +
+	"Optional:\n\n" +
+	"- `encrypted` (Boolean, Deprecated) Whether to encrypt.\n"
+
+func TestCleanProviderDoc(t *testing.T) {
+	content := "---\r\nlayout: \"aws\"\r\npage_title: \"Provider: AWS\"\r\n---\r\n\r\n# AWS Provider\r\n\r\n<!-- internal note -->\r\n\r\n\r\n\r\nUse the provider block.\r\n"
+	assert.Equal(t, "# AWS Provider\n\nUse the provider block.", CleanProviderDoc(content))
+
+	// Documents without front matter are left untouched
+	assert.Equal(t, "# Title\n---\ntext", StripFrontMatter("# Title\n---\ntext"))
+}
+
+func TestParseDocNestedBlocksHandWritten(t *testing.T) {
+	blocks := ParseDocNestedBlocks(handWrittenResourceDoc)
+
+	names := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		names = append(names, block.Name)
+	}
+	assert.Equal(t, []string{"root_block_device", "credit_specification"}, names)
+
+	block, ok := FindDocBlock(blocks, "root_block_device")
+	require.True(t, ok)
+	require.Len(t, block.Arguments, 2)
+	assert.Equal(t, "delete_on_termination", block.Arguments[0].Name)
+	assert.Equal(t, "Optional", block.Arguments[0].Qualifier)
+	assert.Equal(t, "Whether the volume should be destroyed on instance termination. Defaults to `true`.", block.Arguments[0].Description)
+}
+
+func TestParseDocNestedBlocksGenerated(t *testing.T) {
+	blocks := ParseDocNestedBlocks(generatedResourceDoc)
+	require.Len(t, blocks, 2)
+
+	block, ok := FindDocBlock(blocks, "LAUNCH_TEMPLATE")
+	require.True(t, ok)
+	assert.Equal(t, []DocArgument{
+		{Name: "id", Type: "String", Qualifier: "Required", Description: "The ID of the template."},
+		{Name: "version", Type: "String", Qualifier: "Optional", Description: "Template version."},
+	}, block.Arguments)
+
+	// The last segment of a dotted path matches as well
+	block, ok = FindDocBlock(blocks, "ebs")
+	require.True(t, ok)
+	assert.Equal(t, "launch_template.ebs", block.Name)
+	assert.Equal(t, "Boolean, Deprecated", block.Arguments[0].Type)
+
+	_, ok = FindDocBlock(blocks, "missing")
+	assert.False(t, ok)
+}
+
+const mixedLanguageDoc = "# Resource: aws_instance\n\n" +
+	"```terraform\nresource \"aws_instance\" \"web\" {\n  ami = \"ami-123\"\n}\n```\n\n" +
+	"```hcl\nprovider \"aws\" {\n  region = \"us-east-1\"\n}\n```\n\n" +
+	"Import using the ID:\n\n" +
+	"```console\n% terraform import aws_instance.web i-12345678\n```\n\n" +
+	"```shell\nresource \"aws_eip\" \"lb\" {\n  instance = aws_instance.web.id\n}\n```\n\n" +
+	"```\n{\n  \"Version\": \"2012-10-17\"\n}\n```\n\n" +
+	"```\nimport {\n  to = aws_instance.web\n  id = \"i-12345678\"\n}\n```\n\n" +
+	"~~~\n$ export AWS_REGION=us-east-1\n~~~\n\n" +
+	"```\nsome plain output\n```\n"
+
+func TestExtractCodeBlocks(t *testing.T) {
+	blocks := ExtractCodeBlocks(mixedLanguageDoc)
+	require.Len(t, blocks, 8)
+
+	languages := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		languages = append(languages, block.Language)
+	}
+	assert.Equal(t, []string{
+		CodeLanguageHCL,   // terraform fence
+		CodeLanguageHCL,   // hcl fence
+		CodeLanguageShell, // console fence
+		CodeLanguageHCL,   // mislabelled shell fence containing HCL
+		CodeLanguageJSON,  // unlabelled JSON
+		CodeLanguageHCL,   // unlabelled import block
+		CodeLanguageShell, // tilde fence with a prompt
+		CodeLanguageText,  // unlabelled plain text
+	}, languages)
+
+	assert.Equal(t, "terraform", blocks[0].InfoTag)
+	assert.Equal(t, "Resource: aws_instance", blocks[0].Heading)
+	assert.Equal(t, "resource \"aws_instance\" \"web\" {\n  ami = \"ami-123\"\n}", blocks[0].Code)
+}
+
+func TestFilterCodeBlocks(t *testing.T) {
+	blocks := ExtractCodeBlocks(mixedLanguageDoc)
+
+	assert.Len(t, FilterCodeBlocks(blocks), len(blocks))
+	assert.Len(t, FilterCodeBlocks(blocks, CodeLanguageHCL), 4)
+	assert.Len(t, FilterCodeBlocks(blocks, CodeLanguageShell, CodeLanguageJSON), 3)
+}
+
+func TestEstimateTokens(t *testing.T) {
+	assert.Equal(t, 0, EstimateTokens(""))
+	assert.Equal(t, 1, EstimateTokens("abc"))
+	assert.Equal(t, 1, EstimateTokens("abcd"))
+	assert.Equal(t, 2, EstimateTokens("abcde"))
+	// Multi-byte characters count once
+	assert.Equal(t, 2, EstimateTokens(strings.Repeat("é", 8)))
+}
+
+func TestSplitDocPages(t *testing.T) {
+	t.Run("small document is a single page", func(t *testing.T) {
+		assert.Equal(t, []string{"# Title\n\nbody\n"}, SplitDocPages("# Title\n\nbody\n", 100))
+		assert.Equal(t, []string{"whole"}, SplitDocPages("whole", 0))
+	})
+
+	t.Run("pages rejoin to the original document", func(t *testing.T) {
+		var builder strings.Builder
+		for i := 0; i < 50; i++ {
+			builder.WriteString("## Section\n\nSome text describing an argument of the resource.\n\n")
+		}
+		content := builder.String()
+
+		pages := SplitDocPages(content, 300)
+		require.Greater(t, len(pages), 1)
+		for _, page := range pages {
+			assert.LessOrEqual(t, len(page), 300)
+		}
+		assert.Equal(t, content, strings.Join(pages, ""))
+	})
+
+	t.Run("breaks before a heading once half full", func(t *testing.T) {
+		content := strings.Repeat("a", 60) + "\n## Next\n" + strings.Repeat("b", 40) + "\n"
+		pages := SplitDocPages(content, 100)
+		require.Len(t, pages, 2)
+		assert.True(t, strings.HasPrefix(pages[1], "## Next"))
+	})
+
+	t.Run("long lines are split on rune boundaries", func(t *testing.T) {
+		content := strings.Repeat("é", 30)
+		pages := SplitDocPages(content, 7)
+		for _, page := range pages {
+			assert.True(t, utf8.ValidString(page))
+			assert.LessOrEqual(t, len(page), 7)
+		}
+		assert.Equal(t, content, strings.Join(pages, ""))
+	})
+}
+
+func TestParseDocArguments(t *testing.T) {
+	t.Run("hand written", func(t *testing.T) {
+		content := handWrittenResourceDoc + "\n## Attribute Reference\n\n" +
+			"* `id` - ID of the instance.\n" +
+			"* `ami` - Repeated in the attributes section.\n"
+		arguments := ParseDocArguments(content)
+
+		assert.Equal(t, []DocArgument{
+			{Name: "ami", Qualifier: "Optional", Description: "AMI to use for the instance."},
+			{Name: "root_block_device", Qualifier: "Optional", Description: "Configuration block to customize details about the root block device."},
+			{Name: "id", Qualifier: "Read-Only", Description: "ID of the instance."},
+		}, arguments)
+	})
+
+	t.Run("generated", func(t *testing.T) {
+		arguments := ParseDocArguments(generatedResourceDoc)
+		require.Len(t, arguments, 2)
+		assert.Equal(t, DocArgument{Name: "name", Type: "String", Qualifier: "Required", Description: "The name."}, arguments[0])
+		assert.Equal(t, "launch_template", arguments[1].Name)
+		assert.Equal(t, "Optional", arguments[1].Qualifier)
+	})
+}
+
+func TestDiffDocFields(t *testing.T) {
+	oldDoc := "## Argument Reference\n\n" +
+		"* `name` - (Required) The name.\n" +
+		"* `size` - (Optional) The size.\n" +
+		"* `legacy` - (Optional) Removed later.\n\n" +
+		"The `config` block supports:\n\n" +
+		"* `enabled` - (Optional) Whether it is enabled.\n"
+	newDoc := "## Argument Reference\n\n" +
+		"* `name` - (Required) The name.\n" +
+		"* `size` - (Required) The size.\n" +
+		"* `tags` - (Optional) Tags to assign.\n\n" +
+		"The `config` block supports:\n\n" +
+		"* `enabled` - (Optional) Whether it is enabled.\n" +
+		"* `mode` - (Optional) The mode.\n"
+
+	changes := DiffDocFields(DocFields(oldDoc), DocFields(newDoc))
+
+	paths := make([]string, 0, len(changes))
+	for _, change := range changes {
+		paths = append(paths, change.Path)
+	}
+	assert.Equal(t, []string{"config.mode", "legacy", "size", "tags"}, paths)
+
+	assert.True(t, changes[0].Added())
+	assert.True(t, changes[1].Removed())
+	assert.False(t, changes[2].Added() || changes[2].Removed())
+	assert.Equal(t, "Optional", changes[2].Old.Qualifier)
+	assert.Equal(t, "Required", changes[2].New.Qualifier)
+	assert.True(t, changes[3].Added())
+
+	assert.Empty(t, DiffDocFields(DocFields(oldDoc), DocFields(oldDoc)))
+}
+
+func TestMergeDocFieldVersions(t *testing.T) {
+	versions := []VersionedDocFields{
+		{Version: "4.0.0", Present: true, Fields: map[string]DocArgument{
+			"name":   {Name: "name", Qualifier: "Required"},
+			"legacy": {Name: "legacy", Qualifier: "Optional"},
+			"size":   {Name: "size", Qualifier: "Optional"},
+		}},
+		{Version: "4.5.0", Present: false},
+		{Version: "5.0.0", Present: true, Fields: map[string]DocArgument{
+			"name": {Name: "name", Qualifier: "Required"},
+			"size": {Name: "size", Qualifier: "Optional", Description: "Deprecated: use capacity instead."},
+			"tags": {Name: "tags", Qualifier: "Optional"},
+		}},
+		{Version: "5.1.0", Present: true, Fields: map[string]DocArgument{
+			"name": {Name: "name", Qualifier: "Required"},
+			"size": {Name: "size", Qualifier: "Optional", Description: "Deprecated: use capacity instead."},
+			"tags": {Name: "tags", Qualifier: "Optional"},
+		}},
+	}
+
+	fields := MergeDocFieldVersions(versions)
+	byPath := make(map[string]MergedDocField)
+	for _, field := range fields {
+		byPath[field.Path] = field
+	}
+	require.Len(t, fields, 4)
+	assert.Equal(t, "legacy", fields[0].Path)
+
+	assert.False(t, byPath["name"].VersionSpecific())
+	assert.Equal(t, "5.0.0", byPath["legacy"].RemovedIn)
+	assert.Equal(t, "5.0.0", byPath["tags"].AddedIn)
+	assert.Empty(t, byPath["tags"].RemovedIn)
+	assert.Equal(t, "5.0.0", byPath["size"].DeprecatedIn)
+	assert.Equal(t, "Deprecated: use capacity instead.", byPath["size"].Field.Description)
+}
+
+const minimalResourceDoc = "---\n" +
+	"page_title: \"aws_example\"\n" +
+	"---\n\n" +
+	"# Resource: aws_example\n\n" +
+	"Manages an example. This resource has no configurable arguments.\n\n" +
+	"## Example Usage\n\n" +
+	"```terraform\nresource \"aws_example\" \"this\" {}\n```\n\n" +
+	"* `note` - bullet outside of a reference section\n"
+
+func TestParseDocArgumentsWithoutArgumentReference(t *testing.T) {
+	assert.Empty(t, ParseDocArguments(minimalResourceDoc))
+	assert.Empty(t, ParseDocNestedBlocks(minimalResourceDoc))
+	assert.Empty(t, DocFields(minimalResourceDoc))
+}
+
+func TestExtractDocCrossReferences(t *testing.T) {
+	content := "# Resource: aws_instance\n\n" +
+		"Use an [`aws_iam_instance_profile`](/docs/providers/aws/r/iam_instance_profile.html) to attach a role.\n" +
+		"See also [aws_iam_role](https://registry.terraform.io/providers/hashicorp/aws/latest/docs/resources/iam_role#argument-reference) " +
+		"and the [AMI data source](../d/ami.html).\n" +
+		"Repeated link: [role](/docs/providers/aws/r/iam_role.html)\n" +
+		"Other provider: [google_compute_instance](/docs/providers/google/r/compute_instance.html)\n" +
+		"External: [AWS docs](https://docs.aws.amazon.com/ec2/)\n\n" +
+		"```terraform\n# [ignored](/docs/providers/aws/r/vpc.html)\n```\n"
+
+	references := ExtractDocCrossReferences(content, "aws")
+	assert.Equal(t, []DocReference{
+		{Category: "resources", Slug: "iam_instance_profile", LinkText: "aws_iam_instance_profile", URL: "/docs/providers/aws/r/iam_instance_profile.html"},
+		{Category: "resources", Slug: "iam_role", LinkText: "aws_iam_role", URL: "https://registry.terraform.io/providers/hashicorp/aws/latest/docs/resources/iam_role#argument-reference"},
+		{Category: "data-sources", Slug: "ami", LinkText: "AMI data source", URL: "../d/ami.html"},
+	}, references)
+
+	assert.Empty(t, ExtractDocCrossReferences(minimalResourceDoc, "aws"))
+}
+
+func TestExtractDocDeprecations(t *testing.T) {
+	content := "---\nsubcategory: \"S3\"\n---\n\n# Resource: aws_s3_bucket_object\n\n" +
+		"!> **WARNING:** This resource is deprecated. Use the `aws_s3_object` resource instead.\n\n" +
+		"~> **NOTE:** The `acl` argument is deprecated, use `aws_s3_object_acl` instead.\n\n" +
+		"## Example Usage\n\n```hcl\n# deprecated = true\n```\n\n" +
+		"## Argument Reference\n\n" +
+		"* `bucket` - (Required) Name of the bucket. Replaces the deprecated `bucket_name` argument.\n" +
+		"* `etag` - (Optional, **Deprecated**) Triggers updates when the value changes. Use `source_hash` instead.\n" +
+		"* `acl` - (Optional) Canned ACL to apply.\n\n" +
+		"## Schema\n\n- `legacy_mode` (Boolean, Deprecated) No longer has any effect.\n"
+
+	deprecations := ExtractDocDeprecations(content, "aws_s3_bucket_object")
+	assert.Equal(t, []DocDeprecation{
+		{Kind: "resource", Name: "aws_s3_bucket_object", Notice: "This resource is deprecated. Use the `aws_s3_object` resource instead.", Replacement: "aws_s3_object"},
+		{Kind: "argument", Name: "acl", Notice: "The `acl` argument is deprecated, use `aws_s3_object_acl` instead.", Replacement: "aws_s3_object_acl"},
+		{Kind: "argument", Name: "etag", Notice: "(Optional, **Deprecated**) Triggers updates when the value changes. Use `source_hash` instead.", Replacement: "source_hash"},
+		{Kind: "argument", Name: "legacy_mode", Notice: "(Boolean, Deprecated) No longer has any effect."},
+	}, deprecations)
+
+	assert.Empty(t, ExtractDocDeprecations("# Resource: aws_instance\n\nManages an instance.\n", "aws_instance"))
+}
+
+func TestExtractDocSection(t *testing.T) {
+	content := "# Resource: aws_instance\n\n" +
+		"## Argument Reference\n\n* `ami` - (Required) AMI to use.\n\n" +
+		"## Import\n\n" +
+		"In Terraform v1.5.0 and later, use an `import` block:\n\n" +
+		"```terraform\nimport {\n  to = aws_instance.web\n  id = \"i-12345678\"\n}\n```\n\n" +
+		"### Using the CLI\n\n" +
+		"```console\n# Import an instance by ID\n% terraform import aws_instance.web i-12345678\n```\n\n" +
+		"## Timeouts\n\n* `create` - (Default `10m`)\n"
+
+	section, found := ExtractDocSection(content, "import")
+	require.True(t, found)
+	assert.True(t, strings.HasPrefix(section, "In Terraform v1.5.0 and later"))
+	assert.Contains(t, section, "id = \"i-12345678\"")
+	assert.Contains(t, section, "### Using the CLI")
+	assert.Contains(t, section, "# Import an instance by ID\n% terraform import aws_instance.web i-12345678")
+	assert.NotContains(t, section, "Timeouts")
+
+	section, found = ExtractDocSection(content, "Timeouts")
+	require.True(t, found)
+	assert.Equal(t, "* `create` - (Default `10m`)", section)
+
+	_, found = ExtractDocSection(content, "Attributes Reference")
+	assert.False(t, found)
+}
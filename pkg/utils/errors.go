@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"net/http"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ErrorCode classifies why a tool call failed, so clients can decide whether to retry, fix the arguments or give up
+type ErrorCode string
+
+const (
+	// ErrorCodeNotFound means the requested module, provider, doc or workspace does not exist
+	ErrorCodeNotFound ErrorCode = "NOT_FOUND"
+	// ErrorCodeRateLimited means the registry or the server rate limit was hit, the call can be retried later
+	ErrorCodeRateLimited ErrorCode = "RATE_LIMITED"
+	// ErrorCodeInvalidArgument means the arguments of the call were rejected and must be fixed before retrying
+	ErrorCodeInvalidArgument ErrorCode = "INVALID_ARGUMENT"
+	// ErrorCodeUpstreamError means the registry or HCP Terraform failed or could not be reached
+	ErrorCodeUpstreamError ErrorCode = "UPSTREAM_ERROR"
+	// ErrorCodeTimeout means the call did not finish within the tool call timeout of the server, or a request it made
+	// timed out
+	ErrorCodeTimeout ErrorCode = "TIMEOUT"
+)
+
+// ErrorCodeForStatus maps the HTTP status of a failed upstream response to an error code
+func ErrorCodeForStatus(statusCode int) ErrorCode {
+	switch statusCode {
+	case http.StatusNotFound, http.StatusGone:
+		return ErrorCodeNotFound
+	case http.StatusTooManyRequests:
+		return ErrorCodeRateLimited
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrorCodeInvalidArgument
+	}
+	return ErrorCodeUpstreamError
+}
+
+// errorResult is the structured content of a failed tool result
+type errorResult struct {
+	Code    ErrorCode `json:"code"`
+	Message string    `json:"message"`
+}
+
+// NewErrorResult creates an error tool result with the message as text and {"code": ..., "message": ...} as
+// structured content
+func NewErrorResult(code ErrorCode, message string) *mcp.CallToolResult {
+	result := mcp.NewToolResultError(message)
+	result.StructuredContent = errorResult{Code: code, Message: message}
+	return result
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// moduleSegmentRegex matches a module namespace or name, e.g. "terraform-aws-modules" or "vpc"
+	moduleSegmentRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+	// moduleProviderRegex matches the target system of a module, e.g. "aws" or "azurerm"
+	moduleProviderRegex = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+)
+
+// ModuleID is a parsed public registry module address, namespace/name/provider with an optional version
+type ModuleID struct {
+	Namespace string
+	Name      string
+	Provider  string
+	Version   string // empty when the ID has no version segment
+}
+
+// String returns the ID in namespace/name/provider[/version] form
+func (m ModuleID) String() string {
+	id := fmt.Sprintf("%s/%s/%s", m.Namespace, m.Name, m.Provider)
+	if m.Version != "" {
+		id += "/" + m.Version
+	}
+	return id
+}
+
+// ParseModuleID parses and validates a module ID such as "terraform-aws-modules/vpc/aws/5.0.0", so a malformed
+// ID is reported clearly instead of as a registry error. The version segment is mandatory when requireVersion is
+// set and optional otherwise.
+func ParseModuleID(raw string, requireVersion bool) (ModuleID, error) {
+	expected := "namespace/name/provider/version (4 parts)"
+	if !requireVersion {
+		expected = "namespace/name/provider or namespace/name/provider/version"
+	}
+	invalid := func(reason string) (ModuleID, error) {
+		return ModuleID{}, fmt.Errorf("invalid module ID format '%s': %s. Expected format: %s", raw, reason, expected)
+	}
+
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return invalid("the module ID is empty")
+	}
+	parts := strings.Split(trimmed, "/")
+	for _, part := range parts {
+		if part == "" {
+			return invalid("it contains an empty segment, check for leading, trailing or doubled slashes")
+		}
+	}
+	switch {
+	case len(parts) < 3 || (requireVersion && len(parts) == 3):
+		return invalid(fmt.Sprintf("it has %d parts, missing the %s", len(parts), missingModuleIDSegments(len(parts), requireVersion)))
+	case len(parts) > 4:
+		return invalid(fmt.Sprintf("it has %d parts, too many slashes", len(parts)))
+	}
+
+	id := ModuleID{Namespace: parts[0], Name: parts[1], Provider: parts[2]}
+	if !moduleSegmentRegex.MatchString(id.Namespace) {
+		return invalid(fmt.Sprintf("namespace '%s' may only contain letters, digits, '-' and '_'", id.Namespace))
+	}
+	if !moduleSegmentRegex.MatchString(id.Name) {
+		return invalid(fmt.Sprintf("name '%s' may only contain letters, digits, '-' and '_'", id.Name))
+	}
+	if !moduleProviderRegex.MatchString(id.Provider) {
+		return invalid(fmt.Sprintf("provider '%s' may only contain letters and digits", id.Provider))
+	}
+	if len(parts) == 4 {
+		if !IsValidProviderVersionFormat(parts[3]) {
+			return invalid(fmt.Sprintf("version '%s' is not a semantic version such as 1.2.0", parts[3]))
+		}
+		id.Version = parts[3]
+	}
+	return id, nil
+}
+
+// missingModuleIDSegments names the segments after the first count, e.g. "provider and version"
+func missingModuleIDSegments(count int, requireVersion bool) string {
+	segments := []string{"namespace", "name", "provider"}
+	if requireVersion {
+		segments = append(segments, "version")
+	}
+	missing := segments[count:]
+	if len(missing) == 1 {
+		return missing[0]
+	}
+	return strings.Join(missing[:len(missing)-1], ", ") + " and " + missing[len(missing)-1]
+}
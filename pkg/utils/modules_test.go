@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !integration
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseModuleIDValid(t *testing.T) {
+	id, err := ParseModuleID("terraform-aws-modules/vpc/aws/5.0.0", true)
+	require.NoError(t, err)
+	assert.Equal(t, ModuleID{Namespace: "terraform-aws-modules", Name: "vpc", Provider: "aws", Version: "5.0.0"}, id)
+	assert.Equal(t, "terraform-aws-modules/vpc/aws/5.0.0", id.String())
+
+	id, err = ParseModuleID(" Azure/avm-res-storage_account/azurerm ", false)
+	require.NoError(t, err)
+	assert.Equal(t, ModuleID{Namespace: "Azure", Name: "avm-res-storage_account", Provider: "azurerm"}, id)
+	assert.Equal(t, "Azure/avm-res-storage_account/azurerm", id.String())
+
+	_, err = ParseModuleID("hashicorp/consul/aws/0.12.0-beta.1", false)
+	assert.NoError(t, err)
+}
+
+func TestParseModuleIDMissingSegments(t *testing.T) {
+	cases := map[string]string{
+		"":                     "the module ID is empty",
+		"hashicorp":            "missing the name, provider and version",
+		"hashicorp/consul":     "missing the provider and version",
+		"hashicorp/consul/aws": "missing the version",
+	}
+	for input, reason := range cases {
+		_, err := ParseModuleID(input, true)
+		require.Error(t, err, input)
+		assert.Contains(t, err.Error(), "invalid module ID format", input)
+		assert.Contains(t, err.Error(), reason, input)
+		assert.Contains(t, err.Error(), "Expected format: namespace/name/provider/version (4 parts)", input)
+	}
+
+	_, err := ParseModuleID("hashicorp/consul", false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing the provider")
+	assert.NotContains(t, err.Error(), "and version")
+}
+
+func TestParseModuleIDExtraSlashes(t *testing.T) {
+	for _, input := range []string{"/hashicorp/consul/aws/1.0.0", "hashicorp/consul/aws/1.0.0/", "hashicorp//consul/aws", "hashicorp/consul/aws//1.0.0"} {
+		_, err := ParseModuleID(input, false)
+		require.Error(t, err, input)
+		assert.Contains(t, err.Error(), "empty segment", input)
+	}
+
+	_, err := ParseModuleID("hashicorp/consul/aws/1.0.0/extra", false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "too many slashes")
+}
+
+func TestParseModuleIDInvalidSegments(t *testing.T) {
+	cases := map[string]string{
+		"hashi corp/consul/aws":       "namespace",
+		"hashicorp/con$ul/aws":        "name",
+		"hashicorp/consul/aws-cloud":  "provider",
+		"hashicorp/consul/aws/latest": "version 'latest'",
+		"hashicorp/consul/aws/1.0":    "version '1.0'",
+	}
+	for input, reason := range cases {
+		_, err := ParseModuleID(input, false)
+		require.Error(t, err, input)
+		assert.Contains(t, err.Error(), reason, input)
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ResultDataMetaKey is the metadata field carrying the typed data of a text result to the JSON output format. The
+// output format middleware removes it before the result is sent.
+const ResultDataMetaKey = "terraform-mcp-server/data"
+
+// NewToolResultData creates a text result carrying the typed value the text was formatted from. Markdown clients
+// receive the text, the JSON output format returns data instead.
+func NewToolResultData(text string, data any) *mcp.CallToolResult {
+	result := mcp.NewToolResultText(text)
+	result.Meta = &mcp.Meta{AdditionalFields: map[string]any{ResultDataMetaKey: data}}
+	return result
+}
+
+// TakeResultData removes the typed data from a result and returns it
+func TakeResultData(result *mcp.CallToolResult) (any, bool) {
+	if result.Meta == nil {
+		return nil, false
+	}
+	data, ok := result.Meta.AdditionalFields[ResultDataMetaKey]
+	if !ok {
+		return nil, false
+	}
+	delete(result.Meta.AdditionalFields, ResultDataMetaKey)
+	if len(result.Meta.AdditionalFields) == 0 && result.Meta.ProgressToken == nil {
+		result.Meta = nil
+	}
+	return data, true
+}
@@ -56,17 +56,50 @@ func ContainsSlug(sourceName string, slug string) (bool, error) {
 	return matched, nil
 }
 
-// IsValidProviderVersionFormat checks if the provider version format is valid.
-func IsValidProviderVersionFormat(version string) bool {
-	// Example regex for semantic versioning (e.g., "1.0.0", "1.0.0-beta").
-	semverRegex := `^v?(\d+\.\d+\.\d+(-[a-zA-Z0-9]+)?)$`
-	matched, _ := regexp.MatchString(semverRegex, version)
-	return matched
+// ScoreTitleMatch rates how well a document title matches a search query, from 0 (no relation) to 100 (exact match).
+// Both are compared case-insensitively with the provider prefix (e.g. "aws_") removed. An exact match ranks above
+// a title starting with the query, then a title containing it, then titles sharing some of the query's words.
+func ScoreTitleMatch(title string, query string, providerName string) int {
+	normalize := func(value string) string {
+		value = strings.ToLower(strings.TrimSpace(value))
+		value = strings.NewReplacer("-", "_", " ", "_").Replace(value)
+		return strings.TrimPrefix(value, strings.ToLower(providerName)+"_")
+	}
+	title, query = normalize(title), normalize(query)
+	if title == "" || query == "" {
+		return 0
+	}
+
+	switch {
+	case title == query:
+		return 100
+	case strings.HasPrefix(title, query+"_"):
+		return 80
+	case strings.HasPrefix(title, query):
+		return 70
+	case strings.Contains(title, query):
+		return 60
+	}
+
+	// Share of the distinct words of both that they have in common
+	titleWords := strings.Split(title, "_")
+	queryWords := strings.Split(query, "_")
+	shared, union := 0, len(titleWords)
+	for _, word := range queryWords {
+		if slices.Contains(titleWords, word) {
+			shared++
+		} else {
+			union++
+		}
+	}
+	return 50 * shared / union
 }
 
+// ProviderDocumentTypes are the categories of provider documentation, in the order they are presented
+var ProviderDocumentTypes = []string{"resources", "data-sources", "functions", "guides", "overview", "actions", "list-resources", "ephemeral-resources"}
+
 func IsValidProviderDocumentType(providerDocumentType string) bool {
-	validTypes := []string{"resources", "data-sources", "functions", "guides", "overview", "actions", "list-resources"}
-	return slices.Contains(validTypes, providerDocumentType)
+	return slices.Contains(ProviderDocumentTypes, providerDocumentType)
 }
 
 // LogAndReturnError logs the error with context and returns a formatted error.
@@ -79,7 +112,7 @@ func LogAndReturnError(logger *log.Logger, context string, err error) error {
 }
 
 func IsV2ProviderDocumentType(dataType string) bool {
-	v2Categories := []string{"guides", "functions", "overview", "actions", "list-resources"}
+	v2Categories := []string{"guides", "functions", "overview", "actions", "list-resources", "ephemeral-resources"}
 	return slices.Contains(v2Categories, dataType)
 }
 
@@ -70,7 +70,7 @@ func TestIsValidProviderVersionFormat(t *testing.T) {
 }
 
 func TestIsValidProviderDataType(t *testing.T) {
-	valid := []string{"resources", "data-sources", "functions", "guides", "overview", "actions", "list-resources"}
+	valid := []string{"resources", "data-sources", "functions", "guides", "overview", "actions", "list-resources", "ephemeral-resources"}
 	invalid := []string{"foo", "bar", ""}
 	for _, v := range valid {
 		if !IsValidProviderDocumentType(v) {
@@ -92,7 +92,7 @@ func TestLogAndReturnError_NilLogger(t *testing.T) {
 }
 
 func TestIsV2ProviderDataType(t *testing.T) {
-	valid := []string{"guides", "functions", "overview", "actions", "list-resources"}
+	valid := []string{"guides", "functions", "overview", "actions", "list-resources", "ephemeral-resources"}
 	invalid := []string{"resources", "data-sources", "foo"}
 	for _, v := range valid {
 		if !IsV2ProviderDocumentType(v) {
@@ -207,3 +207,19 @@ func TestExtractReadme(t *testing.T) {
 		})
 	}
 }
+
+func TestScoreTitleMatch(t *testing.T) {
+	assert.Equal(t, 100, ScoreTitleMatch("aws_instance", "instance", "aws"))
+	assert.Equal(t, 100, ScoreTitleMatch("instance", "aws_instance", "aws"))
+	assert.Equal(t, 80, ScoreTitleMatch("aws_instance_state", "instance", "aws"))
+	assert.Equal(t, 70, ScoreTitleMatch("aws_instances", "instance", "aws"))
+	assert.Equal(t, 60, ScoreTitleMatch("aws_ec2_instance_connect_endpoint", "instance", "aws"))
+	assert.Equal(t, 12, ScoreTitleMatch("aws_s3_bucket_policy", "bucket_acl", "aws"))
+	assert.Equal(t, 0, ScoreTitleMatch("aws_vpc", "instance", "aws"))
+	assert.Equal(t, 0, ScoreTitleMatch("aws_vpc", "", "aws"))
+
+	// Ranking order for a typical query
+	query := "s3_bucket"
+	assert.Greater(t, ScoreTitleMatch("aws_s3_bucket", query, "aws"), ScoreTitleMatch("aws_s3_bucket_policy", query, "aws"))
+	assert.Greater(t, ScoreTitleMatch("aws_s3_bucket_policy", query, "aws"), ScoreTitleMatch("aws_s3control_bucket", query, "aws"))
+}
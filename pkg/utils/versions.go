@@ -0,0 +1,257 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+)
+
+// ParseVersion parses a semantic version, accepting surrounding whitespace, a leading "v" and partial versions
+// such as "5" or "5.1", which are padded with zeros. Versions with more than three segments are rejected.
+func ParseVersion(raw string) (*version.Version, error) {
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(raw), "v"), "V")
+	if trimmed == "" {
+		return nil, fmt.Errorf("empty version")
+	}
+	v, err := version.NewSemver(trimmed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %q", raw)
+	}
+	if len(v.Segments()) > 3 {
+		return nil, fmt.Errorf("invalid version %q: expected at most 3 segments", raw)
+	}
+	return v, nil
+}
+
+// NormalizeVersion returns the canonical "x.y.z[-prerelease][+metadata]" form of a version, as the registry
+// publishes it, e.g. "v5.1" becomes "5.1.0".
+func NormalizeVersion(raw string) (string, error) {
+	v, err := ParseVersion(raw)
+	if err != nil {
+		return "", err
+	}
+	return v.String(), nil
+}
+
+// IsValidProviderVersionFormat checks if the provider version is a complete "x.y.z" version, optionally
+// prefixed with "v" and followed by a pre-release or build metadata.
+func IsValidProviderVersionFormat(raw string) bool {
+	if strings.TrimSpace(raw) != raw {
+		return false
+	}
+	core := strings.TrimPrefix(raw, "v")
+	if i := strings.IndexAny(core, "-+"); i != -1 {
+		core = core[:i]
+	}
+	if strings.Count(core, ".") != 2 {
+		return false
+	}
+	_, err := ParseVersion(raw)
+	return err == nil
+}
+
+// CompareVersions returns -1, 0 or 1 when a is older than, equal to or newer than b, following semantic
+// versioning precedence: pre-releases are older than their release and build metadata is ignored.
+func CompareVersions(a, b string) (int, error) {
+	va, err := ParseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := ParseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	return va.Compare(vb), nil
+}
+
+// SortVersions returns the valid versions of the list sorted oldest first by semantic version precedence,
+// rather than lexically ("5.10.0" sorts after "5.9.0"). The original strings are kept, invalid entries are dropped.
+func SortVersions(versions []string) []string {
+	type parsedVersion struct {
+		raw     string
+		version *version.Version
+	}
+	parsed := make([]parsedVersion, 0, len(versions))
+	for _, raw := range versions {
+		v, err := ParseVersion(raw)
+		if err != nil {
+			continue
+		}
+		parsed = append(parsed, parsedVersion{raw: raw, version: v})
+	}
+	sort.SliceStable(parsed, func(i, j int) bool { return parsed[i].version.LessThan(parsed[j].version) })
+
+	sorted := make([]string, 0, len(parsed))
+	for _, p := range parsed {
+		sorted = append(sorted, p.raw)
+	}
+	return sorted
+}
+
+// LatestVersion returns the highest version of the list, ignoring pre-releases unless includePrerelease is set.
+// Entries that are not valid versions are skipped.
+func LatestVersion(versions []string, includePrerelease bool) (string, error) {
+	var latest *version.Version
+	var latestRaw string
+	for _, raw := range versions {
+		v, err := ParseVersion(raw)
+		if err != nil {
+			continue
+		}
+		if v.Prerelease() != "" && !includePrerelease {
+			continue
+		}
+		if latest == nil || v.GreaterThan(latest) {
+			latest = v
+			latestRaw = raw
+		}
+	}
+	if latest == nil {
+		return "", fmt.Errorf("no published versions found")
+	}
+	return latestRaw, nil
+}
+
+// VersionsInRange returns the versions between from and to (inclusive) sorted oldest first, skipping pre-releases
+// that are not one of the bounds. Entries that are not valid versions are skipped.
+func VersionsInRange(versions []string, from string, to string) ([]string, error) {
+	lower, err := ParseVersion(from)
+	if err != nil {
+		return nil, err
+	}
+	upper, err := ParseVersion(to)
+	if err != nil {
+		return nil, err
+	}
+	if lower.GreaterThan(upper) {
+		return nil, fmt.Errorf("version %s is newer than %s", from, to)
+	}
+
+	var inRange []string
+	for _, raw := range versions {
+		v, err := ParseVersion(raw)
+		if err != nil || v.LessThan(lower) || v.GreaterThan(upper) {
+			continue
+		}
+		if v.Prerelease() != "" && !v.Equal(lower) && !v.Equal(upper) {
+			continue
+		}
+		inRange = append(inRange, raw)
+	}
+	if len(inRange) == 0 {
+		return nil, fmt.Errorf("no published versions between %s and %s", from, to)
+	}
+	return SortVersions(inRange), nil
+}
+
+// VersionMatchesConstraint reports whether a version satisfies a Terraform version constraint such as
+// "~> 5.0, != 5.3.0". As in Terraform, a pre-release only matches a constraint that names it exactly.
+func VersionMatchesConstraint(raw string, constraint string) (bool, error) {
+	v, err := ParseVersion(raw)
+	if err != nil {
+		return false, err
+	}
+	constraints, err := version.NewConstraint(constraint)
+	if err != nil {
+		return false, fmt.Errorf("invalid version constraint %q: %w", constraint, err)
+	}
+	return constraints.Check(v), nil
+}
+
+// ResolveVersionInput normalizes a version argument of a tool. Empty and "latest" are returned as "latest"
+// so the caller can look up the newest release, any other value must be a valid version.
+func ResolveVersionInput(raw string) (string, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(raw))
+	if trimmed == "" || trimmed == "latest" {
+		return "latest", nil
+	}
+	return NormalizeVersion(trimmed)
+}
+
+// MatchingVersions returns the versions satisfying a Terraform version constraint, newest first. Entries that are
+// not valid versions are skipped.
+func MatchingVersions(versions []string, constraint string) ([]string, error) {
+	constraints, err := version.NewConstraint(constraint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version constraint %q: %w", constraint, err)
+	}
+	var matching []string
+	for _, raw := range versions {
+		if v, err := ParseVersion(raw); err == nil && constraints.Check(v) {
+			matching = append(matching, raw)
+		}
+	}
+	sorted := SortVersions(matching)
+	slices.Reverse(sorted)
+	return sorted, nil
+}
+
+// constraintPart splits one part of a version constraint into its operator and version
+var constraintPart = regexp.MustCompile(`^(=|!=|>=|<=|>|<|~>)?\s*(\S+)$`)
+
+// ExplainVersionConstraint describes in words each comma separated part of a Terraform version constraint,
+// spelling out the range allowed by the pessimistic operator, e.g. "~> 5.1" allows ">= 5.1.0, < 6.0.0".
+func ExplainVersionConstraint(constraint string) ([]string, error) {
+	if _, err := version.NewConstraint(constraint); err != nil {
+		return nil, fmt.Errorf("invalid version constraint %q: %w", constraint, err)
+	}
+	var explanation []string
+	for _, part := range strings.Split(constraint, ",") {
+		part = strings.TrimSpace(part)
+		matches := constraintPart.FindStringSubmatch(part)
+		if matches == nil {
+			return nil, fmt.Errorf("invalid version constraint %q", constraint)
+		}
+		operator, raw := matches[1], matches[2]
+		v, err := version.NewVersion(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version constraint %q: %w", constraint, err)
+		}
+		switch operator {
+		case "", "=":
+			explanation = append(explanation, fmt.Sprintf("%s: exactly version %s", part, v))
+		case "!=":
+			explanation = append(explanation, fmt.Sprintf("%s: any version except %s", part, v))
+		case ">":
+			explanation = append(explanation, fmt.Sprintf("%s: versions newer than %s", part, v))
+		case ">=":
+			explanation = append(explanation, fmt.Sprintf("%s: version %s or newer", part, v))
+		case "<":
+			explanation = append(explanation, fmt.Sprintf("%s: versions older than %s", part, v))
+		case "<=":
+			explanation = append(explanation, fmt.Sprintf("%s: version %s or older", part, v))
+		case "~>":
+			explanation = append(explanation, explainPessimisticConstraint(part, raw, v))
+		}
+	}
+	return explanation, nil
+}
+
+// explainPessimisticConstraint describes a "~>" constraint, which only lets the rightmost given segment increase
+func explainPessimisticConstraint(part string, raw string, v *version.Version) string {
+	given := strings.Count(strings.SplitN(strings.SplitN(raw, "-", 2)[0], "+", 2)[0], ".") + 1
+	if given < 2 {
+		return fmt.Sprintf("%s: version %s or newer, only the major version is given so any newer version is allowed", part, v)
+	}
+	segments := v.Segments()
+	upper := make([]string, 3)
+	for i := range upper {
+		switch {
+		case i < given-2:
+			upper[i] = strconv.Itoa(segments[i])
+		case i == given-2:
+			upper[i] = strconv.Itoa(segments[i] + 1)
+		default:
+			upper[i] = "0"
+		}
+	}
+	return fmt.Sprintf("%s: version %s or newer but older than %s, only the rightmost given version segment may increase", part, v, strings.Join(upper, "."))
+}
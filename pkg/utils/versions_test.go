@@ -0,0 +1,209 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !integration
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeVersion(t *testing.T) {
+	cases := map[string]string{
+		"5.1.0":             "5.1.0",
+		" v5.1.0 ":          "5.1.0",
+		"V5.1.0":            "5.1.0",
+		"5.1":               "5.1.0",
+		"5":                 "5.0.0",
+		"6.0.0-beta1":       "6.0.0-beta1",
+		"6.0.0-rc.2":        "6.0.0-rc.2",
+		"1.2.3+build.5":     "1.2.3+build.5",
+		"v1.0-alpha.1+meta": "1.0.0-alpha.1+meta",
+		"0.13.0-alpha20200": "0.13.0-alpha20200",
+	}
+	for input, expected := range cases {
+		normalized, err := NormalizeVersion(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, normalized, input)
+	}
+
+	for _, input := range []string{"", "latest", "foo", "1.2.3.4", "1..2", "-1.0.0", "1.0.0beta"} {
+		_, err := NormalizeVersion(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestIsValidProviderVersionFormatPrerelease(t *testing.T) {
+	for _, v := range []string{"6.0.0-beta.1", "1.2.3+build.5", "6.0.0-rc1"} {
+		assert.True(t, IsValidProviderVersionFormat(v), v)
+	}
+	for _, v := range []string{" 1.0.0", "1.0-beta", "1.2.3.4"} {
+		assert.False(t, IsValidProviderVersionFormat(v), v)
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"5.9.0", "5.10.0", -1},
+		{"5.10.0", "5.9.0", 1},
+		{"5.1", "5.1.0", 0},
+		{"v5.1.0", "5.1.0", 0},
+		{"6.0.0-beta1", "6.0.0", -1},
+		{"6.0.0-beta.2", "6.0.0-beta.10", -1},
+		{"6.0.0-alpha", "6.0.0-beta", -1},
+		{"1.2.3+build.1", "1.2.3+build.2", 0},
+	}
+	for _, tc := range cases {
+		result, err := CompareVersions(tc.a, tc.b)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, result, "%s vs %s", tc.a, tc.b)
+	}
+
+	_, err := CompareVersions("5.0.0", "latest")
+	assert.Error(t, err)
+}
+
+func TestSortVersions(t *testing.T) {
+	versions := []string{"5.10.0", "5.9.0", "not-a-version", "5.10.0-rc1", "v4.67.0", "5.2"}
+	assert.Equal(t, []string{"v4.67.0", "5.2", "5.9.0", "5.10.0-rc1", "5.10.0"}, SortVersions(versions))
+	assert.Empty(t, SortVersions(nil))
+}
+
+func TestLatestVersion(t *testing.T) {
+	versions := []string{"5.9.0", "5.10.0", "6.0.0-beta1", "not-a-version", "5.10.0-rc1"}
+
+	latest, err := LatestVersion(versions, false)
+	require.NoError(t, err)
+	assert.Equal(t, "5.10.0", latest)
+
+	latest, err = LatestVersion(versions, true)
+	require.NoError(t, err)
+	assert.Equal(t, "6.0.0-beta1", latest)
+
+	_, err = LatestVersion([]string{"1.0.0-alpha"}, false)
+	assert.Error(t, err)
+
+	_, err = LatestVersion(nil, true)
+	assert.Error(t, err)
+}
+
+func TestVersionsInRange(t *testing.T) {
+	versions := []string{"5.10.0", "5.2.0", "5.9.0", "6.0.0-beta1", "4.67.0", "5.10.0-rc1", "not-a-version"}
+
+	inRange, err := VersionsInRange(versions, "5.2.0", "5.10.0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"5.2.0", "5.9.0", "5.10.0"}, inRange)
+
+	inRange, err = VersionsInRange(versions, "5.9.0", "6.0.0-beta1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"5.9.0", "5.10.0", "6.0.0-beta1"}, inRange)
+
+	// Partial bounds are padded
+	inRange, err = VersionsInRange(versions, "5.9", "5.10")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"5.9.0", "5.10.0"}, inRange)
+
+	_, err = VersionsInRange(versions, "5.10.0", "5.2.0")
+	assert.Error(t, err)
+
+	_, err = VersionsInRange(versions, "1.0.0", "2.0.0")
+	assert.Error(t, err)
+
+	_, err = VersionsInRange(versions, "foo", "2.0.0")
+	assert.Error(t, err)
+}
+
+func TestVersionMatchesConstraint(t *testing.T) {
+	cases := []struct {
+		version, constraint string
+		expected            bool
+	}{
+		{"5.10.0", "~> 5.0", true},
+		{"6.0.0", "~> 5.0", false},
+		{"5.1.3", "~> 5.1.0", true},
+		{"5.2.0", "~> 5.1.0", false},
+		{"5.3.0", ">= 5.0, != 5.3.0", false},
+		{"v5.4", ">= 5.0, != 5.3.0", true},
+		{"6.0.0-beta1", ">= 5.0", false},
+		{"6.0.0-beta1", "6.0.0-beta1", true},
+	}
+	for _, tc := range cases {
+		matches, err := VersionMatchesConstraint(tc.version, tc.constraint)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, matches, "%s against %q", tc.version, tc.constraint)
+	}
+
+	_, err := VersionMatchesConstraint("5.0.0", "~>> 5")
+	assert.Error(t, err)
+	_, err = VersionMatchesConstraint("five", ">= 5.0")
+	assert.Error(t, err)
+}
+
+func TestResolveVersionInput(t *testing.T) {
+	for input, expected := range map[string]string{"": "latest", " Latest ": "latest", "v5.1": "5.1.0", "6.0.0-beta1": "6.0.0-beta1"} {
+		resolved, err := ResolveVersionInput(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, resolved, input)
+	}
+	_, err := ResolveVersionInput("newest")
+	assert.Error(t, err)
+}
+
+func TestMatchingVersions(t *testing.T) {
+	versions := []string{"2.9.0", "3.0.0", "3.10.1", "3.2.0", "4.0.0-beta1", "4.0.0", "not-a-version"}
+
+	matching, err := MatchingVersions(versions, ">= 3.0, < 4.0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3.10.1", "3.2.0", "3.0.0"}, matching)
+
+	matching, err = MatchingVersions(versions, "~> 3.2.0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3.2.0"}, matching)
+
+	matching, err = MatchingVersions(versions, "~> 3.3.0")
+	require.NoError(t, err)
+	assert.Empty(t, matching)
+
+	matching, err = MatchingVersions(versions, "4.0.0-beta1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"4.0.0-beta1"}, matching)
+
+	_, err = MatchingVersions(versions, "about 3")
+	assert.Error(t, err)
+}
+
+func TestExplainVersionConstraint(t *testing.T) {
+	explanation, err := ExplainVersionConstraint(">= 3.0, < 4.0, != 3.5.1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		">= 3.0: version 3.0.0 or newer",
+		"< 4.0: versions older than 4.0.0",
+		"!= 3.5.1: any version except 3.5.1",
+	}, explanation)
+
+	explanation, err = ExplainVersionConstraint("~> 5.1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"~> 5.1: version 5.1.0 or newer but older than 6.0.0, only the rightmost given version segment may increase"}, explanation)
+
+	explanation, err = ExplainVersionConstraint("~>1.2.3")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"~>1.2.3: version 1.2.3 or newer but older than 1.3.0, only the rightmost given version segment may increase"}, explanation)
+
+	explanation, err = ExplainVersionConstraint("~> 2")
+	require.NoError(t, err)
+	assert.Contains(t, explanation[0], "any newer version is allowed")
+
+	explanation, err = ExplainVersionConstraint("1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.0.0: exactly version 1.0.0"}, explanation)
+
+	_, err = ExplainVersionConstraint(">= three")
+	assert.Error(t, err)
+}